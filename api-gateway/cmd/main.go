@@ -9,7 +9,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/gin-gonic/gin"
 	"github.com/lpernett/godotenv"
 )
 
@@ -65,6 +64,12 @@ func main() {
 			log.Printf("Consumer stopped with error: %v", err)
 		}
 	}()
+	topicRevokeToken := "auth.revoke_token"
+	go func() {
+		if err := serviceConfig.KafkaInstance.KafkaConsumer.Consume(ctx, topicRevokeToken, "api-gateway-group-4", apiGatewayService.AddTokRevToRedis); err != nil {
+			log.Printf("Consumer stopped with error: %v", err)
+		}
+	}()
 
 	// Test
 	//topic1 := "test_topic"
@@ -75,7 +80,10 @@ func main() {
 	//defer conn.Close()
 
 	// Setup router
-	engine := gin.New()
+	engine, err := router.NewEngine(envConfig)
+	if err != nil {
+		panic(err)
+	}
 	router.SetupRouter(engine, managerHandler, serviceConfig, envConfig)
 
 	//// Tạo channel chờ signal
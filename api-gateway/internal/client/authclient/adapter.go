@@ -7,17 +7,23 @@ import (
 
 func LoginInputToRequest(input *dto.LoginInput) (*authpb.LoginRequest, error) {
 	return &authpb.LoginRequest{
-		Username: input.Username,
-		Password: input.Password,
-		Role:     input.Role,
+		Username:    input.Username,
+		Password:    input.Password,
+		Role:        input.Role,
+		ClientIp:    input.ClientIP,
+		DeviceLabel: input.DeviceLabel,
+		UserAgent:   input.UserAgent,
 	}, nil
 }
 func LoginResponseToOutput(response *authpb.LoginResponse) (*dto.LoginOutput, error) {
 	return &dto.LoginOutput{
-		Message:      response.GetMessage(),
-		AccessToken:  response.GetAccessToken(),
-		RefreshToken: response.GetRefreshToken(),
-		Success:      response.GetSuccess(),
+		Message:              response.GetMessage(),
+		AccessToken:          response.GetAccessToken(),
+		RefreshToken:         response.GetRefreshToken(),
+		Success:              response.GetSuccess(),
+		SecondFactorRequired: response.GetSecondFactorRequired(),
+		EnrollmentRequired:   response.GetEnrollmentRequired(),
+		ChallengeToken:       response.GetChallengeToken(),
 	}, nil
 }
 
@@ -78,3 +84,292 @@ func RegisterSellerRolesResponseToOutput(response *authpb.RegisterSellerRolesRes
 		Success: response.GetSuccess(),
 	}, nil
 }
+
+func LogoutInputToRequest(input *dto.LogoutInput) (*authpb.LogoutRequest, error) {
+	return &authpb.LogoutRequest{
+		AccessToken: input.AccessToken,
+	}, nil
+}
+func LogoutResponseToOutput(response *authpb.LogoutResponse) (*dto.LogoutOutput, error) {
+	return &dto.LogoutOutput{
+		Message: response.GetMessage(),
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func LogoutAllInputToRequest(input *dto.LogoutAllInput) (*authpb.LogoutAllRequest, error) {
+	return &authpb.LogoutAllRequest{
+		AccessToken: input.AccessToken,
+	}, nil
+}
+func LogoutAllResponseToOutput(response *authpb.LogoutAllResponse) (*dto.LogoutAllOutput, error) {
+	return &dto.LogoutAllOutput{
+		Message: response.GetMessage(),
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func UnlockAccountInputToRequest(input *dto.UnlockAccountInput) (*authpb.UnlockAccountRequest, error) {
+	return &authpb.UnlockAccountRequest{
+		Username: input.Username,
+		Role:     input.Role,
+		ClientIp: input.ClientIP,
+	}, nil
+}
+func UnlockAccountResponseToOutput(response *authpb.UnlockAccountResponse) (*dto.UnlockAccountOutput, error) {
+	return &dto.UnlockAccountOutput{
+		Message: response.GetMessage(),
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func RequestPasswordResetInputToRequest(input *dto.RequestPasswordResetInput) (*authpb.RequestPasswordResetRequest, error) {
+	return &authpb.RequestPasswordResetRequest{
+		Username: input.Username,
+		Role:     input.Role,
+	}, nil
+}
+func RequestPasswordResetResponseToOutput(response *authpb.RequestPasswordResetResponse) (*dto.RequestPasswordResetOutput, error) {
+	return &dto.RequestPasswordResetOutput{
+		Message: response.GetMessage(),
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func ResetPasswordInputToRequest(input *dto.ResetPasswordInput) (*authpb.ResetPasswordRequest, error) {
+	return &authpb.ResetPasswordRequest{
+		Token:       input.Token,
+		NewPassword: input.NewPassword,
+	}, nil
+}
+func ResetPasswordResponseToOutput(response *authpb.ResetPasswordResponse) (*dto.ResetPasswordOutput, error) {
+	return &dto.ResetPasswordOutput{
+		Message: response.GetMessage(),
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func BeginTOTPEnrollmentInputToRequest(input *dto.BeginTOTPEnrollmentInput) (*authpb.BeginTOTPEnrollmentRequest, error) {
+	return &authpb.BeginTOTPEnrollmentRequest{
+		UserId:         input.UserID,
+		ChallengeToken: input.ChallengeToken,
+	}, nil
+}
+func BeginTOTPEnrollmentResponseToOutput(response *authpb.BeginTOTPEnrollmentResponse) (*dto.BeginTOTPEnrollmentOutput, error) {
+	return &dto.BeginTOTPEnrollmentOutput{
+		Message:         response.GetMessage(),
+		Success:         response.GetSuccess(),
+		Secret:          response.GetSecret(),
+		ProvisioningURI: response.GetProvisioningUri(),
+	}, nil
+}
+
+func ConfirmTOTPEnrollmentInputToRequest(input *dto.ConfirmTOTPEnrollmentInput) (*authpb.ConfirmTOTPEnrollmentRequest, error) {
+	return &authpb.ConfirmTOTPEnrollmentRequest{
+		UserId:         input.UserID,
+		ChallengeToken: input.ChallengeToken,
+		Code:           input.Code,
+		DeviceLabel:    input.DeviceLabel,
+		UserAgent:      input.UserAgent,
+		ClientIp:       input.ClientIP,
+	}, nil
+}
+func ConfirmTOTPEnrollmentResponseToOutput(response *authpb.ConfirmTOTPEnrollmentResponse) (*dto.ConfirmTOTPEnrollmentOutput, error) {
+	return &dto.ConfirmTOTPEnrollmentOutput{
+		Message:       response.GetMessage(),
+		Success:       response.GetSuccess(),
+		RecoveryCodes: response.GetRecoveryCodes(),
+		AccessToken:   response.GetAccessToken(),
+		RefreshToken:  response.GetRefreshToken(),
+	}, nil
+}
+
+func VerifySecondFactorInputToRequest(input *dto.VerifySecondFactorInput) (*authpb.VerifySecondFactorRequest, error) {
+	return &authpb.VerifySecondFactorRequest{
+		ChallengeToken: input.ChallengeToken,
+		Code:           input.Code,
+		DeviceLabel:    input.DeviceLabel,
+		UserAgent:      input.UserAgent,
+		ClientIp:       input.ClientIP,
+	}, nil
+}
+func VerifySecondFactorResponseToOutput(response *authpb.VerifySecondFactorResponse) (*dto.VerifySecondFactorOutput, error) {
+	return &dto.VerifySecondFactorOutput{
+		Message:      response.GetMessage(),
+		AccessToken:  response.GetAccessToken(),
+		RefreshToken: response.GetRefreshToken(),
+		Success:      response.GetSuccess(),
+	}, nil
+}
+
+func DisableTOTPInputToRequest(input *dto.DisableTOTPInput) (*authpb.DisableTOTPRequest, error) {
+	return &authpb.DisableTOTPRequest{
+		UserId: input.UserID,
+		Code:   input.Code,
+	}, nil
+}
+func DisableTOTPResponseToOutput(response *authpb.DisableTOTPResponse) (*dto.DisableTOTPOutput, error) {
+	return &dto.DisableTOTPOutput{
+		Message: response.GetMessage(),
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func BeginOIDCLoginInputToRequest(input *dto.BeginOIDCLoginInput) (*authpb.BeginOIDCLoginRequest, error) {
+	return &authpb.BeginOIDCLoginRequest{
+		Provider: input.Provider,
+		UserId:   input.UserID,
+	}, nil
+}
+func BeginOIDCLoginResponseToOutput(response *authpb.BeginOIDCLoginResponse) (*dto.BeginOIDCLoginOutput, error) {
+	return &dto.BeginOIDCLoginOutput{
+		Message:          response.GetMessage(),
+		Success:          response.GetSuccess(),
+		AuthorizationURL: response.GetAuthorizationUrl(),
+		State:            response.GetState(),
+	}, nil
+}
+
+func CompleteOIDCLoginInputToRequest(input *dto.CompleteOIDCLoginInput) (*authpb.CompleteOIDCLoginRequest, error) {
+	return &authpb.CompleteOIDCLoginRequest{
+		Provider:  input.Provider,
+		State:     input.State,
+		Code:      input.Code,
+		UserAgent: input.UserAgent,
+		ClientIp:  input.ClientIP,
+		UserId:    input.UserID,
+	}, nil
+}
+func CompleteOIDCLoginResponseToOutput(response *authpb.CompleteOIDCLoginResponse) (*dto.CompleteOIDCLoginOutput, error) {
+	return &dto.CompleteOIDCLoginOutput{
+		Message:              response.GetMessage(),
+		Success:              response.GetSuccess(),
+		AccessToken:          response.GetAccessToken(),
+		RefreshToken:         response.GetRefreshToken(),
+		SecondFactorRequired: response.GetSecondFactorRequired(),
+		EnrollmentRequired:   response.GetEnrollmentRequired(),
+		ChallengeToken:       response.GetChallengeToken(),
+		AccountCreated:       response.GetAccountCreated(),
+		Linked:               response.GetLinked(),
+	}, nil
+}
+
+func StoreRoleResponseToDTO(role *authpb.StoreRole) *dto.StoreRole {
+	if role == nil {
+		return nil
+	}
+	return &dto.StoreRole{
+		Name:        role.GetName(),
+		Description: role.GetDescription(),
+		Permissions: role.GetPermissions(),
+	}
+}
+
+func ListStoreRolesInputToRequest(input *dto.ListStoreRolesInput) (*authpb.ListStoreRolesRequest, error) {
+	return &authpb.ListStoreRolesRequest{
+		ActorId: input.ActorID,
+		StoreId: input.StoreID,
+	}, nil
+}
+func ListStoreRolesResponseToOutput(response *authpb.ListStoreRolesResponse) (*dto.ListStoreRolesOutput, error) {
+	roles := make([]*dto.StoreRole, 0, len(response.GetRoles()))
+	for _, role := range response.GetRoles() {
+		roles = append(roles, StoreRoleResponseToDTO(role))
+	}
+	return &dto.ListStoreRolesOutput{
+		Message:               response.GetMessage(),
+		Success:               response.GetSuccess(),
+		Roles:                 roles,
+		AssignablePermissions: response.GetAssignablePermissions(),
+	}, nil
+}
+
+func CreateStoreRoleInputToRequest(input *dto.CreateStoreRoleInput) (*authpb.CreateStoreRoleRequest, error) {
+	return &authpb.CreateStoreRoleRequest{
+		ActorId: input.ActorID,
+		StoreId: input.StoreID,
+		Role: &authpb.StoreRole{
+			Name:        input.Name,
+			Description: input.Description,
+			Permissions: input.Permissions,
+		},
+	}, nil
+}
+func CreateStoreRoleResponseToOutput(response *authpb.CreateStoreRoleResponse) (*dto.CreateStoreRoleOutput, error) {
+	return &dto.CreateStoreRoleOutput{
+		Message: response.GetMessage(),
+		Success: response.GetSuccess(),
+		Role:    StoreRoleResponseToDTO(response.GetRole()),
+	}, nil
+}
+
+func UpdateStoreRoleInputToRequest(input *dto.UpdateStoreRoleInput) (*authpb.UpdateStoreRoleRequest, error) {
+	return &authpb.UpdateStoreRoleRequest{
+		ActorId: input.ActorID,
+		StoreId: input.StoreID,
+		Role: &authpb.StoreRole{
+			Name:        input.Name,
+			Description: input.Description,
+			Permissions: input.Permissions,
+		},
+	}, nil
+}
+func UpdateStoreRoleResponseToOutput(response *authpb.UpdateStoreRoleResponse) (*dto.UpdateStoreRoleOutput, error) {
+	return &dto.UpdateStoreRoleOutput{
+		Message: response.GetMessage(),
+		Success: response.GetSuccess(),
+		Role:    StoreRoleResponseToDTO(response.GetRole()),
+	}, nil
+}
+
+func DeleteStoreRoleInputToRequest(input *dto.DeleteStoreRoleInput) (*authpb.DeleteStoreRoleRequest, error) {
+	return &authpb.DeleteStoreRoleRequest{
+		ActorId: input.ActorID,
+		StoreId: input.StoreID,
+		Name:    input.Name,
+	}, nil
+}
+func DeleteStoreRoleResponseToOutput(response *authpb.DeleteStoreRoleResponse) (*dto.DeleteStoreRoleOutput, error) {
+	return &dto.DeleteStoreRoleOutput{
+		Message: response.GetMessage(),
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func ListSessionsInputToRequest(input *dto.ListSessionsInput) (*authpb.ListSessionsRequest, error) {
+	return &authpb.ListSessionsRequest{
+		AccessToken: input.AccessToken,
+	}, nil
+}
+func ListSessionsResponseToOutput(response *authpb.ListSessionsResponse) (*dto.ListSessionsOutput, error) {
+	sessions := make([]*dto.Session, 0, len(response.GetSessions()))
+	for _, session := range response.GetSessions() {
+		sessions = append(sessions, &dto.Session{
+			ID:          session.GetId(),
+			DeviceLabel: session.GetDeviceLabel(),
+			UserAgent:   session.GetUserAgent(),
+			ClientIP:    session.GetClientIp(),
+			CreatedAt:   session.GetCreatedAt().AsTime(),
+			LastUsedAt:  session.GetLastUsedAt().AsTime(),
+			Current:     session.GetCurrent(),
+		})
+	}
+	return &dto.ListSessionsOutput{
+		Message:  response.GetMessage(),
+		Success:  response.GetSuccess(),
+		Sessions: sessions,
+	}, nil
+}
+
+func RevokeSessionInputToRequest(input *dto.RevokeSessionInput) (*authpb.RevokeSessionRequest, error) {
+	return &authpb.RevokeSessionRequest{
+		AccessToken: input.AccessToken,
+		SessionId:   input.SessionID,
+	}, nil
+}
+func RevokeSessionResponseToOutput(response *authpb.RevokeSessionResponse) (*dto.RevokeSessionOutput, error) {
+	return &dto.RevokeSessionOutput{
+		Message: response.GetMessage(),
+		Success: response.GetSuccess(),
+	}, nil
+}
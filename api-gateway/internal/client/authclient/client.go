@@ -260,3 +260,717 @@ func (s *AuthClient) validateClient() error {
 	s.Client = client
 	return nil
 }
+
+func (s *AuthClient) Logout(input *dto.LogoutInput) (*dto.LogoutOutput, error) {
+
+	// Check if AuthClient is not connected
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := LogoutInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse Logout input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("AuthClient: invalid request for Logout", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.Logout(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("AuthClient: Logout error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("AuthClient: Invalid response for Logout", zap.Error(err))
+		return nil, err
+	}
+	output, err := LogoutResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse Logout response to output error", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *AuthClient) LogoutAll(input *dto.LogoutAllInput) (*dto.LogoutAllOutput, error) {
+
+	// Check if AuthClient is not connected
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := LogoutAllInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse LogoutAll input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("AuthClient: invalid request for LogoutAll", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.LogoutAll(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("AuthClient: LogoutAll error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("AuthClient: Invalid response for LogoutAll", zap.Error(err))
+		return nil, err
+	}
+	output, err := LogoutAllResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse LogoutAll response to output error", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *AuthClient) UnlockAccount(input *dto.UnlockAccountInput) (*dto.UnlockAccountOutput, error) {
+
+	// Check if AuthClient is not connected
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := UnlockAccountInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse UnlockAccount input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("AuthClient: invalid request for UnlockAccount", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.UnlockAccount(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("AuthClient: UnlockAccount error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("AuthClient: Invalid response for UnlockAccount", zap.Error(err))
+		return nil, err
+	}
+	output, err := UnlockAccountResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse UnlockAccount response to output error", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *AuthClient) RequestPasswordReset(input *dto.RequestPasswordResetInput) (*dto.RequestPasswordResetOutput, error) {
+
+	// Check if AuthClient is not connected
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := RequestPasswordResetInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse RequestPasswordReset input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("AuthClient: invalid request for RequestPasswordReset", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.RequestPasswordReset(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("AuthClient: RequestPasswordReset error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("AuthClient: Invalid response for RequestPasswordReset", zap.Error(err))
+		return nil, err
+	}
+	output, err := RequestPasswordResetResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse RequestPasswordReset response to output error", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *AuthClient) ResetPassword(input *dto.ResetPasswordInput) (*dto.ResetPasswordOutput, error) {
+
+	// Check if AuthClient is not connected
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := ResetPasswordInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse ResetPassword input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("AuthClient: invalid request for ResetPassword", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.ResetPassword(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("AuthClient: ResetPassword error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("AuthClient: Invalid response for ResetPassword", zap.Error(err))
+		return nil, err
+	}
+	output, err := ResetPasswordResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse ResetPassword response to output error", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *AuthClient) BeginTOTPEnrollment(input *dto.BeginTOTPEnrollmentInput) (*dto.BeginTOTPEnrollmentOutput, error) {
+
+	// Check if AuthClient is not connected
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := BeginTOTPEnrollmentInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse BeginTOTPEnrollment input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("AuthClient: invalid request for BeginTOTPEnrollment", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.BeginTOTPEnrollment(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("AuthClient: BeginTOTPEnrollment error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("AuthClient: Invalid response for BeginTOTPEnrollment", zap.Error(err))
+		return nil, err
+	}
+	output, err := BeginTOTPEnrollmentResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse BeginTOTPEnrollment response to output error", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *AuthClient) ConfirmTOTPEnrollment(input *dto.ConfirmTOTPEnrollmentInput) (*dto.ConfirmTOTPEnrollmentOutput, error) {
+
+	// Check if AuthClient is not connected
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := ConfirmTOTPEnrollmentInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse ConfirmTOTPEnrollment input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("AuthClient: invalid request for ConfirmTOTPEnrollment", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.ConfirmTOTPEnrollment(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("AuthClient: ConfirmTOTPEnrollment error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("AuthClient: Invalid response for ConfirmTOTPEnrollment", zap.Error(err))
+		return nil, err
+	}
+	output, err := ConfirmTOTPEnrollmentResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse ConfirmTOTPEnrollment response to output error", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *AuthClient) VerifySecondFactor(input *dto.VerifySecondFactorInput) (*dto.VerifySecondFactorOutput, error) {
+
+	// Check if AuthClient is not connected
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := VerifySecondFactorInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse VerifySecondFactor input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("AuthClient: invalid request for VerifySecondFactor", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.VerifySecondFactor(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("AuthClient: VerifySecondFactor error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("AuthClient: Invalid response for VerifySecondFactor", zap.Error(err))
+		return nil, err
+	}
+	output, err := VerifySecondFactorResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse VerifySecondFactor response to output error", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *AuthClient) DisableTOTP(input *dto.DisableTOTPInput) (*dto.DisableTOTPOutput, error) {
+
+	// Check if AuthClient is not connected
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := DisableTOTPInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse DisableTOTP input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("AuthClient: invalid request for DisableTOTP", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.DisableTOTP(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("AuthClient: DisableTOTP error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("AuthClient: Invalid response for DisableTOTP", zap.Error(err))
+		return nil, err
+	}
+	output, err := DisableTOTPResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse DisableTOTP response to output error", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *AuthClient) BeginOIDCLogin(input *dto.BeginOIDCLoginInput) (*dto.BeginOIDCLoginOutput, error) {
+
+	// Check if AuthClient is not connected
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := BeginOIDCLoginInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse BeginOIDCLogin input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("AuthClient: invalid request for BeginOIDCLogin", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.BeginOIDCLogin(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("AuthClient: BeginOIDCLogin error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("AuthClient: Invalid response for BeginOIDCLogin", zap.Error(err))
+		return nil, err
+	}
+	output, err := BeginOIDCLoginResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse BeginOIDCLogin response to output error", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *AuthClient) CompleteOIDCLogin(input *dto.CompleteOIDCLoginInput) (*dto.CompleteOIDCLoginOutput, error) {
+
+	// Check if AuthClient is not connected
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := CompleteOIDCLoginInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse CompleteOIDCLogin input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("AuthClient: invalid request for CompleteOIDCLogin", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.CompleteOIDCLogin(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("AuthClient: CompleteOIDCLogin error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("AuthClient: Invalid response for CompleteOIDCLogin", zap.Error(err))
+		return nil, err
+	}
+	output, err := CompleteOIDCLoginResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse CompleteOIDCLogin response to output error", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *AuthClient) ListStoreRoles(input *dto.ListStoreRolesInput) (*dto.ListStoreRolesOutput, error) {
+
+	// Check if AuthClient is not connected
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := ListStoreRolesInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse ListStoreRoles input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("AuthClient: invalid request for ListStoreRoles", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.ListStoreRoles(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("AuthClient: ListStoreRoles error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("AuthClient: Invalid response for ListStoreRoles", zap.Error(err))
+		return nil, err
+	}
+	output, err := ListStoreRolesResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse ListStoreRoles response to output error", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *AuthClient) CreateStoreRole(input *dto.CreateStoreRoleInput) (*dto.CreateStoreRoleOutput, error) {
+
+	// Check if AuthClient is not connected
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := CreateStoreRoleInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse CreateStoreRole input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("AuthClient: invalid request for CreateStoreRole", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.CreateStoreRole(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("AuthClient: CreateStoreRole error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("AuthClient: Invalid response for CreateStoreRole", zap.Error(err))
+		return nil, err
+	}
+	output, err := CreateStoreRoleResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse CreateStoreRole response to output error", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *AuthClient) UpdateStoreRole(input *dto.UpdateStoreRoleInput) (*dto.UpdateStoreRoleOutput, error) {
+
+	// Check if AuthClient is not connected
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := UpdateStoreRoleInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse UpdateStoreRole input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("AuthClient: invalid request for UpdateStoreRole", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.UpdateStoreRole(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("AuthClient: UpdateStoreRole error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("AuthClient: Invalid response for UpdateStoreRole", zap.Error(err))
+		return nil, err
+	}
+	output, err := UpdateStoreRoleResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse UpdateStoreRole response to output error", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *AuthClient) DeleteStoreRole(input *dto.DeleteStoreRoleInput) (*dto.DeleteStoreRoleOutput, error) {
+
+	// Check if AuthClient is not connected
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := DeleteStoreRoleInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse DeleteStoreRole input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("AuthClient: invalid request for DeleteStoreRole", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.DeleteStoreRole(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("AuthClient: DeleteStoreRole error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("AuthClient: Invalid response for DeleteStoreRole", zap.Error(err))
+		return nil, err
+	}
+	output, err := DeleteStoreRoleResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse DeleteStoreRole response to output error", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *AuthClient) ListSessions(input *dto.ListSessionsInput) (*dto.ListSessionsOutput, error) {
+
+	// Check if AuthClient is not connected
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := ListSessionsInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse ListSessions input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("AuthClient: invalid request for ListSessions", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.ListSessions(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("AuthClient: ListSessions error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("AuthClient: Invalid response for ListSessions", zap.Error(err))
+		return nil, err
+	}
+	output, err := ListSessionsResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse ListSessions response to output error", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *AuthClient) RevokeSession(input *dto.RevokeSessionInput) (*dto.RevokeSessionOutput, error) {
+
+	// Check if AuthClient is not connected
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := RevokeSessionInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse RevokeSession input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("AuthClient: invalid request for RevokeSession", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.RevokeSession(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("AuthClient: RevokeSession error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("AuthClient: Invalid response for RevokeSession", zap.Error(err))
+		return nil, err
+	}
+	output, err := RevokeSessionResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("AuthClient: parse RevokeSession response to output error", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
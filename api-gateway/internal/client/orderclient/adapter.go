@@ -3,8 +3,19 @@ package orderclient
 import (
 	"api-gateway/pkg/dto"
 	orderpb "api-gateway/pkg/pb/orderservice"
+	"product-service/pkg/money"
 )
 
+func MoneyProtoToDTO(m *orderpb.Money) money.Money {
+	return money.New(m.GetAmount(), m.GetCurrency())
+}
+func MoneyDTOToProto(m money.Money) *orderpb.Money {
+	return &orderpb.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
 func OrderItemDTOToProto(orderItem *dto.OrderItem) (*orderpb.OrderItem, error) {
 	//if orderItem == nil {
 	//	return nil, nil
@@ -15,7 +26,7 @@ func OrderItemDTOToProto(orderItem *dto.OrderItem) (*orderpb.OrderItem, error) {
 		OrderId:   orderItem.OrderID,
 		ProductId: orderItem.ProductID,
 		Quantity:  orderItem.Quantity,
-		Price:     orderItem.Price,
+		Price:     MoneyDTOToProto(orderItem.Price),
 	}, nil
 }
 func OrderItemProtoToDTO(orderItem *orderpb.OrderItem) (*dto.OrderItem, error) {
@@ -28,7 +39,9 @@ func OrderItemProtoToDTO(orderItem *orderpb.OrderItem) (*dto.OrderItem, error) {
 		OrderID:   orderItem.GetOrderId(),
 		ProductID: orderItem.GetProductId(),
 		Quantity:  orderItem.GetQuantity(),
-		Price:     orderItem.GetPrice(),
+		Price:     MoneyProtoToDTO(orderItem.GetPrice()),
+
+		ExchangeRate: orderItem.GetExchangeRate(),
 	}, nil
 }
 
@@ -67,7 +80,8 @@ func OrderDTOToProto(order *dto.Order) (*orderpb.Order, error) {
 		Id:         order.ID,
 		BuyerId:    order.BuyerID,
 		Status:     order.Status,
-		TotalPrice: order.TotalPrice,
+		TotalPrice: MoneyDTOToProto(order.TotalPrice),
+		Currency:   order.Currency,
 		OrderItem:  orderItems,
 	}, nil
 }
@@ -83,8 +97,12 @@ func OrderProtoToDTO(order *orderpb.Order) (*dto.Order, error) {
 		ID:         order.GetId(),
 		BuyerID:    order.GetBuyerId(),
 		Status:     order.GetStatus(),
-		TotalPrice: order.GetTotalPrice(),
+		TotalPrice: MoneyProtoToDTO(order.GetTotalPrice()),
+		Currency:   order.GetCurrency(),
 		OrderItems: orderItems,
+
+		RateBase:       order.GetRateBase(),
+		RatesUpdatedAt: order.GetRatesUpdatedAt().AsTime(),
 	}, nil
 }
 
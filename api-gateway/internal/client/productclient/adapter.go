@@ -3,6 +3,7 @@ package productclient
 import (
 	"api-gateway/pkg/dto"
 	productpb "api-gateway/pkg/pb/productservice"
+	"product-service/pkg/money"
 
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -14,6 +15,16 @@ func StructToMap(s *structpb.Struct) (map[string]any, error) {
 	return s.AsMap(), nil
 }
 
+func MoneyProtoToDTO(m *productpb.Money) money.Money {
+	return money.New(m.GetAmount(), m.GetCurrency())
+}
+func MoneyDTOToProto(m money.Money) *productpb.Money {
+	return &productpb.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
 func ProductDTOToProto(product *dto.Product) (*productpb.Product, error) {
 	if product == nil {
 		return nil, nil
@@ -23,12 +34,17 @@ func ProductDTOToProto(product *dto.Product) (*productpb.Product, error) {
 		return nil, err
 	}
 	return &productpb.Product{
-		Id:         product.ID,
-		Name:       product.Name,
-		Price:      product.Price,
-		SellerId:   product.SellerID,
-		Inventory:  product.Inventory,
-		Attributes: attributes,
+		Id:                product.ID,
+		Name:              product.Name,
+		Price:             MoneyDTOToProto(product.Price),
+		SellerId:          product.SellerID,
+		Inventory:         product.Inventory,
+		Attributes:        attributes,
+		Sku:               product.SKU,
+		LowStockThreshold: product.LowStockThreshold,
+		CategoryId:        product.CategoryID,
+		Status:            product.Status,
+		Version:           product.Version,
 	}, nil
 }
 func ProductProtoToDTO(product *productpb.Product) (*dto.Product, error) {
@@ -40,12 +56,17 @@ func ProductProtoToDTO(product *productpb.Product) (*dto.Product, error) {
 		return nil, err
 	}
 	return &dto.Product{
-		ID:         product.GetId(),
-		Name:       product.GetName(),
-		Price:      product.GetPrice(),
-		SellerID:   product.GetSellerId(),
-		Inventory:  product.GetInventory(),
-		Attributes: attributes,
+		ID:                product.GetId(),
+		Name:              product.GetName(),
+		Price:             MoneyProtoToDTO(product.GetPrice()),
+		SellerID:          product.GetSellerId(),
+		Inventory:         product.GetInventory(),
+		Attributes:        attributes,
+		SKU:               product.GetSku(),
+		LowStockThreshold: product.GetLowStockThreshold(),
+		CategoryID:        product.GetCategoryId(),
+		Status:            product.GetStatus(),
+		Version:           product.GetVersion(),
 	}, nil
 }
 
@@ -78,17 +99,21 @@ func CreateProductInputToRequest(input *dto.CreateProductInput) (*productpb.Crea
 		return nil, err
 	}
 	return &productpb.CreateProductRequest{
-		Name:       input.Name,
-		Price:      input.Price,
-		SellerId:   input.SellerID,
-		Inventory:  input.Inventory,
-		Attributes: attributes,
+		Name:              input.Name,
+		Price:             MoneyDTOToProto(input.Price),
+		SellerId:          input.SellerID,
+		Inventory:         input.Inventory,
+		Attributes:        attributes,
+		Sku:               input.SKU,
+		LowStockThreshold: input.LowStockThreshold,
+		CategoryId:        input.CategoryID,
 	}, nil
 }
 func CreateProductResponseToOutput(res *productpb.CreateProductResponse) (*dto.CreateProductOutput, error) {
 	return &dto.CreateProductOutput{
-		Message: res.GetMessage(),
-		Success: res.GetSuccess(),
+		Message:     res.GetMessage(),
+		Success:     res.GetSuccess(),
+		FieldErrors: FieldErrorsProtoToDTO(res.GetFieldErrors()),
 	}, nil
 }
 
@@ -104,8 +129,9 @@ func UpdateProductInputToRequest(input *dto.UpdateProductInput) (*productpb.Upda
 }
 func UpdateProductResponseToOutput(res *productpb.UpdateProductResponse) (*dto.UpdateProductOutput, error) {
 	return &dto.UpdateProductOutput{
-		Message: res.GetMessage(),
-		Success: res.GetSuccess(),
+		Message:     res.GetMessage(),
+		Success:     res.GetSuccess(),
+		FieldErrors: FieldErrorsProtoToDTO(res.GetFieldErrors()),
 	}, nil
 }
 
@@ -128,7 +154,10 @@ func GetProductByIDResponseToOutput(res *productpb.GetProductByIDResponse) (*dto
 
 func GetProductsBySellerIDInputToRequest(input *dto.GetProductsBySellerIDInput) (*productpb.GetProductsBySellerIDRequest, error) {
 	return &productpb.GetProductsBySellerIDRequest{
-		SellerId: input.SellerID,
+		SellerId:  input.SellerID,
+		PageSize:  input.PageSize,
+		PageToken: input.PageToken,
+		Sort:      input.Sort,
 	}, nil
 }
 func GetProductsBySellerIDResponseToOutput(res *productpb.GetProductsBySellerIDResponse) (*dto.GetProductsBySellerIDOutput, error) {
@@ -137,16 +166,20 @@ func GetProductsBySellerIDResponseToOutput(res *productpb.GetProductsBySellerIDR
 		return nil, err
 	}
 	return &dto.GetProductsBySellerIDOutput{
-		Message:  res.GetMessage(),
-		Success:  res.GetSuccess(),
-		Products: products,
+		Message:         res.GetMessage(),
+		Success:         res.GetSuccess(),
+		Products:        products,
+		NextPageToken:   res.GetNextPageToken(),
+		TotalCount:      res.GetTotalCount(),
+		TotalIsEstimate: res.GetTotalIsEstimate(),
 	}, nil
 }
 
 func GetProductsInputToRequest(input *dto.GetProductsInput) (*productpb.GetProductsRequest, error) {
 	return &productpb.GetProductsRequest{
-		Page:     input.Page,
-		PageSize: input.PageSize,
+		PageSize:  input.PageSize,
+		PageToken: input.PageToken,
+		Sort:      input.Sort,
 	}, nil
 }
 func GetProductsResponseToOutput(res *productpb.GetProductsResponse) (*dto.GetProductsOutput, error) {
@@ -155,8 +188,484 @@ func GetProductsResponseToOutput(res *productpb.GetProductsResponse) (*dto.GetPr
 		return nil, err
 	}
 	return &dto.GetProductsOutput{
+		Message:         res.GetMessage(),
+		Success:         res.GetSuccess(),
+		Products:        products,
+		NextPageToken:   res.GetNextPageToken(),
+		TotalCount:      res.GetTotalCount(),
+		TotalIsEstimate: res.GetTotalIsEstimate(),
+	}, nil
+}
+
+func ImportJobProtoToDTO(job *productpb.ImportJob) *dto.ImportJob {
+	if job == nil {
+		return nil
+	}
+	var rowErrors []*dto.ImportRowError
+	for _, rowError := range job.GetErrors() {
+		rowErrors = append(rowErrors, &dto.ImportRowError{
+			Row:     rowError.GetRow(),
+			SKU:     rowError.GetSku(),
+			Field:   rowError.GetField(),
+			Message: rowError.GetMessage(),
+		})
+	}
+	return &dto.ImportJob{
+		ID:          job.GetId(),
+		SellerID:    job.GetSellerId(),
+		Format:      job.GetFormat(),
+		Status:      job.GetStatus(),
+		TotalRows:   job.GetTotalRows(),
+		SuccessRows: job.GetSuccessRows(),
+		FailedRows:  job.GetFailedRows(),
+		Errors:      rowErrors,
+		CreatedAt:   job.GetCreatedAt().AsTime(),
+		UpdatedAt:   job.GetUpdatedAt().AsTime(),
+	}
+}
+
+func ImportProductsInputToRequest(input *dto.ImportProductsInput) (*productpb.ImportProductsRequest, error) {
+	return &productpb.ImportProductsRequest{
+		SellerId: input.SellerID,
+		Format:   input.Format,
+		Data:     input.Data,
+	}, nil
+}
+func ImportProductsResponseToOutput(res *productpb.ImportProductsResponse) (*dto.ImportProductsOutput, error) {
+	return &dto.ImportProductsOutput{
+		Message: res.GetMessage(),
+		Success: res.GetSuccess(),
+		JobID:   res.GetJobId(),
+	}, nil
+}
+
+func GetImportJobInputToRequest(input *dto.GetImportJobInput) (*productpb.GetImportJobRequest, error) {
+	return &productpb.GetImportJobRequest{
+		JobId:    input.JobID,
+		SellerId: input.SellerID,
+	}, nil
+}
+func GetImportJobResponseToOutput(res *productpb.GetImportJobResponse) (*dto.GetImportJobOutput, error) {
+	return &dto.GetImportJobOutput{
+		Message: res.GetMessage(),
+		Success: res.GetSuccess(),
+		Job:     ImportJobProtoToDTO(res.GetJob()),
+	}, nil
+}
+
+func ExportProductsInputToRequest(input *dto.ExportProductsInput) (*productpb.ExportProductsRequest, error) {
+	return &productpb.ExportProductsRequest{
+		SellerId: input.SellerID,
+		Format:   input.Format,
+	}, nil
+}
+
+func InventoryMovementProtoToDTO(movement *productpb.InventoryMovement) *dto.InventoryMovement {
+	if movement == nil {
+		return nil
+	}
+	return &dto.InventoryMovement{
+		ID:             movement.GetId(),
+		ProductID:      movement.GetProductId(),
+		SellerID:       movement.GetSellerId(),
+		Type:           movement.GetType(),
+		ReferenceID:    movement.GetReferenceId(),
+		Quantity:       movement.GetQuantity(),
+		InventoryAfter: movement.GetInventoryAfter(),
+		Reason:         movement.GetReason(),
+		ActorID:        movement.GetActorId(),
+		CreatedAt:      movement.GetCreatedAt().AsTime(),
+	}
+}
+
+func AdjustInventoryInputToRequest(input *dto.AdjustInventoryInput) (*productpb.AdjustInventoryRequest, error) {
+	return &productpb.AdjustInventoryRequest{
+		ProductId: input.ProductID,
+		UserId:    input.UserID,
+		Quantity:  input.Quantity,
+		Reason:    input.Reason,
+	}, nil
+}
+func AdjustInventoryResponseToOutput(res *productpb.AdjustInventoryResponse) (*dto.AdjustInventoryOutput, error) {
+	return &dto.AdjustInventoryOutput{
+		Message:   res.GetMessage(),
+		Success:   res.GetSuccess(),
+		Inventory: res.GetInventory(),
+	}, nil
+}
+
+func GetInventoryMovementsInputToRequest(input *dto.GetInventoryMovementsInput) (*productpb.GetInventoryMovementsRequest, error) {
+	return &productpb.GetInventoryMovementsRequest{
+		SellerId:  input.SellerID,
+		ProductId: input.ProductID,
+		Type:      input.Type,
+		Page:      input.Page,
+		PageSize:  input.PageSize,
+	}, nil
+}
+func GetInventoryMovementsResponseToOutput(res *productpb.GetInventoryMovementsResponse) (*dto.GetInventoryMovementsOutput, error) {
+	var movements []*dto.InventoryMovement
+	for _, movement := range res.GetMovements() {
+		movements = append(movements, InventoryMovementProtoToDTO(movement))
+	}
+	return &dto.GetInventoryMovementsOutput{
+		Message:   res.GetMessage(),
+		Success:   res.GetSuccess(),
+		Movements: movements,
+	}, nil
+}
+
+func DeleteProductInputToRequest(input *dto.DeleteProductInput) (*productpb.DeleteProductRequest, error) {
+	return &productpb.DeleteProductRequest{
+		Id:     input.ID,
+		UserId: input.UserID,
+	}, nil
+}
+func DeleteProductResponseToOutput(res *productpb.DeleteProductResponse) (*dto.DeleteProductOutput, error) {
+	return &dto.DeleteProductOutput{
+		Message: res.GetMessage(),
+		Success: res.GetSuccess(),
+	}, nil
+}
+
+func ArchiveProductInputToRequest(input *dto.ArchiveProductInput) (*productpb.ArchiveProductRequest, error) {
+	return &productpb.ArchiveProductRequest{
+		Id:     input.ID,
+		UserId: input.UserID,
+	}, nil
+}
+func ArchiveProductResponseToOutput(res *productpb.ArchiveProductResponse) (*dto.ArchiveProductOutput, error) {
+	return &dto.ArchiveProductOutput{
+		Message: res.GetMessage(),
+		Success: res.GetSuccess(),
+	}, nil
+}
+
+func RestoreProductInputToRequest(input *dto.RestoreProductInput) (*productpb.RestoreProductRequest, error) {
+	return &productpb.RestoreProductRequest{
+		Id:     input.ID,
+		UserId: input.UserID,
+	}, nil
+}
+func RestoreProductResponseToOutput(res *productpb.RestoreProductResponse) (*dto.RestoreProductOutput, error) {
+	return &dto.RestoreProductOutput{
+		Message: res.GetMessage(),
+		Success: res.GetSuccess(),
+	}, nil
+}
+
+func FieldErrorsProtoToDTO(fieldErrors []*productpb.FieldError) []*dto.FieldError {
+	var fieldErrorsDTO []*dto.FieldError
+	for _, fieldError := range fieldErrors {
+		fieldErrorsDTO = append(fieldErrorsDTO, &dto.FieldError{
+			Field:   fieldError.GetField(),
+			Message: fieldError.GetMessage(),
+		})
+	}
+	return fieldErrorsDTO
+}
+
+func CategoryProtoToDTO(category *productpb.Category) *dto.Category {
+	if category == nil {
+		return nil
+	}
+	return &dto.Category{
+		ID:         category.GetId(),
+		Name:       category.GetName(),
+		Attributes: AttributeDefinitionsProtoToDTO(category.GetAttributes()),
+		CreatedAt:  category.GetCreatedAt().AsTime(),
+		UpdatedAt:  category.GetUpdatedAt().AsTime(),
+	}
+}
+
+func AttributeDefinitionsProtoToDTO(attributes []*productpb.AttributeDefinition) []*dto.AttributeDefinition {
+	var attributesDTO = make([]*dto.AttributeDefinition, 0, len(attributes))
+	for _, attribute := range attributes {
+		attributesDTO = append(attributesDTO, &dto.AttributeDefinition{
+			Name:          attribute.GetName(),
+			Type:          attribute.GetType(),
+			AllowedValues: attribute.GetAllowedValues(),
+			Required:      attribute.GetRequired(),
+		})
+	}
+	return attributesDTO
+}
+func AttributeDefinitionsDTOToProto(attributes []*dto.AttributeDefinition) []*productpb.AttributeDefinition {
+	var attributesProto []*productpb.AttributeDefinition
+	for _, attribute := range attributes {
+		attributesProto = append(attributesProto, &productpb.AttributeDefinition{
+			Name:          attribute.Name,
+			Type:          attribute.Type,
+			AllowedValues: attribute.AllowedValues,
+			Required:      attribute.Required,
+		})
+	}
+	return attributesProto
+}
+
+func CreateCategoryInputToRequest(input *dto.CreateCategoryInput) (*productpb.CreateCategoryRequest, error) {
+	return &productpb.CreateCategoryRequest{
+		Name:       input.Name,
+		Attributes: AttributeDefinitionsDTOToProto(input.Attributes),
+	}, nil
+}
+func CreateCategoryResponseToOutput(res *productpb.CreateCategoryResponse) (*dto.CreateCategoryOutput, error) {
+	return &dto.CreateCategoryOutput{
+		Message:     res.GetMessage(),
+		Success:     res.GetSuccess(),
+		Category:    CategoryProtoToDTO(res.GetCategory()),
+		FieldErrors: FieldErrorsProtoToDTO(res.GetFieldErrors()),
+	}, nil
+}
+
+func UpdateCategoryInputToRequest(input *dto.UpdateCategoryInput) (*productpb.UpdateCategoryRequest, error) {
+	return &productpb.UpdateCategoryRequest{
+		Id:         input.ID,
+		Name:       input.Name,
+		Attributes: AttributeDefinitionsDTOToProto(input.Attributes),
+	}, nil
+}
+func UpdateCategoryResponseToOutput(res *productpb.UpdateCategoryResponse) (*dto.UpdateCategoryOutput, error) {
+	return &dto.UpdateCategoryOutput{
+		Message:     res.GetMessage(),
+		Success:     res.GetSuccess(),
+		Category:    CategoryProtoToDTO(res.GetCategory()),
+		FieldErrors: FieldErrorsProtoToDTO(res.GetFieldErrors()),
+	}, nil
+}
+
+func DeleteCategoryInputToRequest(input *dto.DeleteCategoryInput) (*productpb.DeleteCategoryRequest, error) {
+	return &productpb.DeleteCategoryRequest{
+		Id: input.ID,
+	}, nil
+}
+func DeleteCategoryResponseToOutput(res *productpb.DeleteCategoryResponse) (*dto.DeleteCategoryOutput, error) {
+	return &dto.DeleteCategoryOutput{
+		Message: res.GetMessage(),
+		Success: res.GetSuccess(),
+	}, nil
+}
+
+func GetCategoryByIDInputToRequest(input *dto.GetCategoryByIDInput) (*productpb.GetCategoryByIDRequest, error) {
+	return &productpb.GetCategoryByIDRequest{
+		Id: input.ID,
+	}, nil
+}
+func GetCategoryByIDResponseToOutput(res *productpb.GetCategoryByIDResponse) (*dto.GetCategoryByIDOutput, error) {
+	return &dto.GetCategoryByIDOutput{
+		Message:  res.GetMessage(),
+		Success:  res.GetSuccess(),
+		Category: CategoryProtoToDTO(res.GetCategory()),
+	}, nil
+}
+
+func GetCategoriesInputToRequest(input *dto.GetCategoriesInput) (*productpb.GetCategoriesRequest, error) {
+	return &productpb.GetCategoriesRequest{}, nil
+}
+func GetCategoriesResponseToOutput(res *productpb.GetCategoriesResponse) (*dto.GetCategoriesOutput, error) {
+	var categories = make([]*dto.Category, 0, len(res.GetCategories()))
+	for _, category := range res.GetCategories() {
+		categories = append(categories, CategoryProtoToDTO(category))
+	}
+	return &dto.GetCategoriesOutput{
+		Message:    res.GetMessage(),
+		Success:    res.GetSuccess(),
+		Categories: categories,
+	}, nil
+}
+
+func GetRelatedProductsInputToRequest(input *dto.GetRelatedProductsInput) (*productpb.GetRelatedProductsRequest, error) {
+	return &productpb.GetRelatedProductsRequest{
+		ProductId: input.ProductID,
+		Limit:     input.Limit,
+	}, nil
+}
+func GetRelatedProductsResponseToOutput(res *productpb.GetRelatedProductsResponse) (*dto.GetRelatedProductsOutput, error) {
+	products, err := ProductsDTOToProto(res.GetProducts())
+	if err != nil {
+		return nil, err
+	}
+	return &dto.GetRelatedProductsOutput{
+		Message:  res.GetMessage(),
+		Success:  res.GetSuccess(),
+		Products: products,
+	}, nil
+}
+
+func QuestionProtoToDTO(question *productpb.ProductQuestion) *dto.ProductQuestion {
+	if question == nil {
+		return nil
+	}
+	var answers = make([]*dto.ProductAnswer, 0, len(question.GetAnswers()))
+	for _, answer := range question.GetAnswers() {
+		answers = append(answers, AnswerProtoToDTO(answer))
+	}
+	return &dto.ProductQuestion{
+		ID:        question.GetId(),
+		ProductID: question.GetProductId(),
+		BuyerID:   question.GetBuyerId(),
+		Body:      question.GetBody(),
+		Answers:   answers,
+		CreatedAt: question.GetCreatedAt().AsTime(),
+	}
+}
+
+func AnswerProtoToDTO(answer *productpb.ProductAnswer) *dto.ProductAnswer {
+	if answer == nil {
+		return nil
+	}
+	return &dto.ProductAnswer{
+		ID:          answer.GetId(),
+		QuestionID:  answer.GetQuestionId(),
+		ResponderID: answer.GetResponderId(),
+		Body:        answer.GetBody(),
+		CreatedAt:   answer.GetCreatedAt().AsTime(),
+	}
+}
+
+func AskQuestionInputToRequest(input *dto.AskQuestionInput) (*productpb.AskQuestionRequest, error) {
+	return &productpb.AskQuestionRequest{
+		ProductId: input.ProductID,
+		BuyerId:   input.BuyerID,
+		Body:      input.Body,
+	}, nil
+}
+func AskQuestionResponseToOutput(res *productpb.AskQuestionResponse) (*dto.AskQuestionOutput, error) {
+	return &dto.AskQuestionOutput{
+		Message:  res.GetMessage(),
+		Success:  res.GetSuccess(),
+		Question: QuestionProtoToDTO(res.GetQuestion()),
+	}, nil
+}
+
+func AnswerQuestionInputToRequest(input *dto.AnswerQuestionInput) (*productpb.AnswerQuestionRequest, error) {
+	return &productpb.AnswerQuestionRequest{
+		QuestionId: input.QuestionID,
+		UserId:     input.UserID,
+		Body:       input.Body,
+	}, nil
+}
+func AnswerQuestionResponseToOutput(res *productpb.AnswerQuestionResponse) (*dto.AnswerQuestionOutput, error) {
+	return &dto.AnswerQuestionOutput{
+		Message: res.GetMessage(),
+		Success: res.GetSuccess(),
+		Answer:  AnswerProtoToDTO(res.GetAnswer()),
+	}, nil
+}
+
+func GetProductQuestionsInputToRequest(input *dto.GetProductQuestionsInput) (*productpb.GetProductQuestionsRequest, error) {
+	return &productpb.GetProductQuestionsRequest{
+		ProductId: input.ProductID,
+		Page:      input.Page,
+		PageSize:  input.PageSize,
+	}, nil
+}
+func GetProductQuestionsResponseToOutput(res *productpb.GetProductQuestionsResponse) (*dto.GetProductQuestionsOutput, error) {
+	var questions = make([]*dto.ProductQuestion, 0, len(res.GetQuestions()))
+	for _, question := range res.GetQuestions() {
+		questions = append(questions, QuestionProtoToDTO(question))
+	}
+	return &dto.GetProductQuestionsOutput{
+		Message:   res.GetMessage(),
+		Success:   res.GetSuccess(),
+		Questions: questions,
+		Total:     res.GetTotal(),
+	}, nil
+}
+
+func FlagQAInputToRequest(input *dto.FlagQAInput) (*productpb.FlagQARequest, error) {
+	return &productpb.FlagQARequest{
+		TargetType: input.TargetType,
+		TargetId:   input.TargetID,
+		UserId:     input.UserID,
+		Reason:     input.Reason,
+	}, nil
+}
+func FlagQAResponseToOutput(res *productpb.FlagQAResponse) (*dto.FlagQAOutput, error) {
+	return &dto.FlagQAOutput{
+		Message: res.GetMessage(),
+		Success: res.GetSuccess(),
+	}, nil
+}
+
+func ModerateQAInputToRequest(input *dto.ModerateQAInput) (*productpb.ModerateQARequest, error) {
+	return &productpb.ModerateQARequest{
+		TargetType: input.TargetType,
+		TargetId:   input.TargetID,
+		Status:     input.Status,
+	}, nil
+}
+func ModerateQAResponseToOutput(res *productpb.ModerateQAResponse) (*dto.ModerateQAOutput, error) {
+	return &dto.ModerateQAOutput{
+		Message: res.GetMessage(),
+		Success: res.GetSuccess(),
+	}, nil
+}
+
+func GetFlaggedQAInputToRequest(input *dto.GetFlaggedQAInput) (*productpb.GetFlaggedQARequest, error) {
+	return &productpb.GetFlaggedQARequest{
+		TargetType: input.TargetType,
+		Page:       input.Page,
+		PageSize:   input.PageSize,
+	}, nil
+}
+func GetFlaggedQAResponseToOutput(res *productpb.GetFlaggedQAResponse) (*dto.GetFlaggedQAOutput, error) {
+	items := make([]*dto.FlaggedQA, 0, len(res.GetItems()))
+	for _, item := range res.GetItems() {
+		items = append(items, &dto.FlaggedQA{
+			TargetType: item.GetTargetType(),
+			TargetID:   item.GetTargetId(),
+			ProductID:  item.GetProductId(),
+			QuestionID: item.GetQuestionId(),
+			AuthorID:   item.GetAuthorId(),
+			Body:       item.GetBody(),
+			FlagCount:  item.GetFlagCount(),
+			CreatedAt:  item.GetCreatedAt().AsTime(),
+		})
+	}
+	return &dto.GetFlaggedQAOutput{
+		Message: res.GetMessage(),
+		Success: res.GetSuccess(),
+		Items:   items,
+		Total:   res.GetTotal(),
+	}, nil
+}
+
+func GetExchangeRatesInputToRequest(input *dto.GetExchangeRatesInput) (*productpb.GetExchangeRatesRequest, error) {
+	return &productpb.GetExchangeRatesRequest{}, nil
+}
+func GetExchangeRatesResponseToOutput(res *productpb.GetExchangeRatesResponse) (*dto.GetExchangeRatesOutput, error) {
+	rates := make(map[string]float64, len(res.GetRates()))
+	for _, rate := range res.GetRates() {
+		rates[rate.GetCurrency()] = rate.GetRate()
+	}
+	return &dto.GetExchangeRatesOutput{
+		Message:   res.GetMessage(),
+		Success:   res.GetSuccess(),
+		Base:      res.GetBase(),
+		Rates:     rates,
+		UpdatedAt: res.GetUpdatedAt().AsTime(),
+	}, nil
+}
+
+func SearchProductsInputToRequest(input *dto.SearchProductsInput) (*productpb.SearchProductsRequest, error) {
+	return &productpb.SearchProductsRequest{
+		Query:      input.Query,
+		SellerId:   input.SellerID,
+		CategoryId: input.CategoryID,
+		Page:       input.Page,
+		PageSize:   input.PageSize,
+	}, nil
+}
+func SearchProductsResponseToOutput(res *productpb.SearchProductsResponse) (*dto.SearchProductsOutput, error) {
+	products, err := ProductsProtoToDTO(res.GetProducts())
+	if err != nil {
+		return nil, err
+	}
+	return &dto.SearchProductsOutput{
 		Message:  res.GetMessage(),
 		Success:  res.GetSuccess(),
 		Products: products,
+		Total:    res.GetTotal(),
 	}, nil
 }
@@ -7,6 +7,9 @@ import (
 	productpb "api-gateway/pkg/pb/productservice"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"sync"
 	"time"
 
 	"buf.build/go/protovalidate"
@@ -18,8 +21,19 @@ type ProductClient struct {
 	Client        productpb.ProductServiceClient
 	ClientManager *client.ClientManager
 	Logger        *zap.Logger
+
+	// Exchange rates cached for exchangeRatesTTL, used to convert prices
+	ratesMu        sync.Mutex
+	rates          *dto.GetExchangeRatesOutput
+	ratesFetchedAt time.Time
 }
 
+// ErrUnsupportedCurrency is returned when prices are converted to or from a currency without exchange rate
+var ErrUnsupportedCurrency = errors.New("currency is not supported")
+
+// exchangeRatesTTL is how long exchange rates are cached, ProductService refreshes them far less often
+const exchangeRatesTTL = 5 * time.Minute
+
 // NewProductClient create ProductClient
 func NewProductClient(client productpb.ProductServiceClient, clientManager *client.ClientManager, logger *zap.Logger) *ProductClient {
 	return &ProductClient{
@@ -229,6 +243,129 @@ func (s *ProductClient) GetProducts(input *dto.GetProductsInput) (*dto.GetProduc
 	return output, nil
 }
 
+func (s *ProductClient) ImportProducts(input *dto.ImportProductsInput) (*dto.ImportProductsOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := ImportProductsInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse ImportProducts input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for ImportProducts", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.ImportProducts(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: ImportProducts error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for ImportProducts", zap.Error(err))
+		return nil, err
+	}
+	output, err := ImportProductsResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for ImportProducts", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *ProductClient) GetImportJob(input *dto.GetImportJobInput) (*dto.GetImportJobOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := GetImportJobInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse GetImportJob input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for GetImportJob", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.GetImportJob(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: GetImportJob error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for GetImportJob", zap.Error(err))
+		return nil, err
+	}
+	output, err := GetImportJobResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for GetImportJob", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+// ExportProducts pass each chunk of the exported file to write, in order
+func (s *ProductClient) ExportProducts(input *dto.ExportProductsInput, write func(data []byte) error) error {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := ExportProductsInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse ExportProducts input to request error", zap.Error(err))
+		return err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for ExportProducts", zap.Error(err))
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	stream, err := s.Client.ExportProducts(ctx, req)
+	if err != nil {
+		s.Logger.Warn("ProductClient: ExportProducts error", zap.Error(err))
+		return err
+	}
+
+	// Receive chunks until the server closes the stream
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			s.Logger.Warn("ProductClient: ExportProducts receive error", zap.Error(err))
+			return err
+		}
+		if err := write(chunk.GetData()); err != nil {
+			s.Logger.Warn("ProductClient: ExportProducts write error", zap.Error(err))
+			return err
+		}
+	}
+}
+
 // Validate client
 
 func (s *ProductClient) validateClient() error {
@@ -245,3 +382,810 @@ func (s *ProductClient) validateClient() error {
 	s.Client = client
 	return nil
 }
+
+func (s *ProductClient) AdjustInventory(input *dto.AdjustInventoryInput) (*dto.AdjustInventoryOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := AdjustInventoryInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse AdjustInventory input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for AdjustInventory", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.AdjustInventory(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: AdjustInventory error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for AdjustInventory", zap.Error(err))
+		return nil, err
+	}
+	output, err := AdjustInventoryResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for AdjustInventory", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *ProductClient) GetInventoryMovements(input *dto.GetInventoryMovementsInput) (*dto.GetInventoryMovementsOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := GetInventoryMovementsInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse GetInventoryMovements input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for GetInventoryMovements", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.GetInventoryMovements(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: GetInventoryMovements error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for GetInventoryMovements", zap.Error(err))
+		return nil, err
+	}
+	output, err := GetInventoryMovementsResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for GetInventoryMovements", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *ProductClient) DeleteProduct(input *dto.DeleteProductInput) (*dto.DeleteProductOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := DeleteProductInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse DeleteProduct input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for DeleteProduct", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.DeleteProduct(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: DeleteProduct error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for DeleteProduct", zap.Error(err))
+		return nil, err
+	}
+	output, err := DeleteProductResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for DeleteProduct", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *ProductClient) ArchiveProduct(input *dto.ArchiveProductInput) (*dto.ArchiveProductOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := ArchiveProductInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse ArchiveProduct input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for ArchiveProduct", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.ArchiveProduct(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: ArchiveProduct error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for ArchiveProduct", zap.Error(err))
+		return nil, err
+	}
+	output, err := ArchiveProductResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for ArchiveProduct", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *ProductClient) RestoreProduct(input *dto.RestoreProductInput) (*dto.RestoreProductOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := RestoreProductInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse RestoreProduct input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for RestoreProduct", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.RestoreProduct(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: RestoreProduct error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for RestoreProduct", zap.Error(err))
+		return nil, err
+	}
+	output, err := RestoreProductResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for RestoreProduct", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *ProductClient) CreateCategory(input *dto.CreateCategoryInput) (*dto.CreateCategoryOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := CreateCategoryInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse CreateCategory input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for CreateCategory", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.CreateCategory(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: CreateCategory error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for CreateCategory", zap.Error(err))
+		return nil, err
+	}
+	output, err := CreateCategoryResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for CreateCategory", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *ProductClient) UpdateCategory(input *dto.UpdateCategoryInput) (*dto.UpdateCategoryOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := UpdateCategoryInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse UpdateCategory input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for UpdateCategory", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.UpdateCategory(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: UpdateCategory error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for UpdateCategory", zap.Error(err))
+		return nil, err
+	}
+	output, err := UpdateCategoryResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for UpdateCategory", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *ProductClient) DeleteCategory(input *dto.DeleteCategoryInput) (*dto.DeleteCategoryOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := DeleteCategoryInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse DeleteCategory input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for DeleteCategory", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.DeleteCategory(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: DeleteCategory error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for DeleteCategory", zap.Error(err))
+		return nil, err
+	}
+	output, err := DeleteCategoryResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for DeleteCategory", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *ProductClient) GetCategoryByID(input *dto.GetCategoryByIDInput) (*dto.GetCategoryByIDOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := GetCategoryByIDInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse GetCategoryByID input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for GetCategoryByID", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.GetCategoryByID(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: GetCategoryByID error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for GetCategoryByID", zap.Error(err))
+		return nil, err
+	}
+	output, err := GetCategoryByIDResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for GetCategoryByID", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *ProductClient) GetCategories(input *dto.GetCategoriesInput) (*dto.GetCategoriesOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := GetCategoriesInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse GetCategories input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for GetCategories", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.GetCategories(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: GetCategories error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for GetCategories", zap.Error(err))
+		return nil, err
+	}
+	output, err := GetCategoriesResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for GetCategories", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *ProductClient) GetRelatedProducts(input *dto.GetRelatedProductsInput) (*dto.GetRelatedProductsOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := GetRelatedProductsInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse GetRelatedProducts input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for GetRelatedProducts", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.GetRelatedProducts(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: GetRelatedProducts error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for GetRelatedProducts", zap.Error(err))
+		return nil, err
+	}
+	output, err := GetRelatedProductsResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for GetRelatedProducts", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *ProductClient) AskQuestion(input *dto.AskQuestionInput) (*dto.AskQuestionOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := AskQuestionInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse AskQuestion input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for AskQuestion", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.AskQuestion(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: AskQuestion error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for AskQuestion", zap.Error(err))
+		return nil, err
+	}
+	output, err := AskQuestionResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for AskQuestion", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *ProductClient) AnswerQuestion(input *dto.AnswerQuestionInput) (*dto.AnswerQuestionOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := AnswerQuestionInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse AnswerQuestion input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for AnswerQuestion", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.AnswerQuestion(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: AnswerQuestion error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for AnswerQuestion", zap.Error(err))
+		return nil, err
+	}
+	output, err := AnswerQuestionResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for AnswerQuestion", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *ProductClient) GetProductQuestions(input *dto.GetProductQuestionsInput) (*dto.GetProductQuestionsOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := GetProductQuestionsInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse GetProductQuestions input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for GetProductQuestions", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.GetProductQuestions(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: GetProductQuestions error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for GetProductQuestions", zap.Error(err))
+		return nil, err
+	}
+	output, err := GetProductQuestionsResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for GetProductQuestions", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *ProductClient) FlagQA(input *dto.FlagQAInput) (*dto.FlagQAOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := FlagQAInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse FlagQA input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for FlagQA", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.FlagQA(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: FlagQA error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for FlagQA", zap.Error(err))
+		return nil, err
+	}
+	output, err := FlagQAResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for FlagQA", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *ProductClient) ModerateQA(input *dto.ModerateQAInput) (*dto.ModerateQAOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := ModerateQAInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse ModerateQA input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for ModerateQA", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.ModerateQA(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: ModerateQA error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for ModerateQA", zap.Error(err))
+		return nil, err
+	}
+	output, err := ModerateQAResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for ModerateQA", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *ProductClient) GetFlaggedQA(input *dto.GetFlaggedQAInput) (*dto.GetFlaggedQAOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := GetFlaggedQAInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse GetFlaggedQA input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for GetFlaggedQA", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.GetFlaggedQA(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: GetFlaggedQA error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for GetFlaggedQA", zap.Error(err))
+		return nil, err
+	}
+	output, err := GetFlaggedQAResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for GetFlaggedQA", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *ProductClient) GetExchangeRates(input *dto.GetExchangeRatesInput) (*dto.GetExchangeRatesOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := GetExchangeRatesInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse GetExchangeRates input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for GetExchangeRates", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.GetExchangeRates(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: GetExchangeRates error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for GetExchangeRates", zap.Error(err))
+		return nil, err
+	}
+	output, err := GetExchangeRatesResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for GetExchangeRates", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+// GetCachedExchangeRates get exchange rates, from ProductService at most once every exchangeRatesTTL
+func (s *ProductClient) GetCachedExchangeRates() (*dto.GetExchangeRatesOutput, error) {
+	s.ratesMu.Lock()
+	defer s.ratesMu.Unlock()
+	if s.rates != nil && time.Since(s.ratesFetchedAt) < exchangeRatesTTL {
+		return s.rates, nil
+	}
+
+	output, err := s.GetExchangeRates(&dto.GetExchangeRatesInput{})
+	if err != nil {
+		return nil, err
+	}
+	if !output.Success {
+		return nil, errors.New(output.Message)
+	}
+	s.rates = output
+	s.ratesFetchedAt = time.Now()
+	return output, nil
+}
+
+// ConvertPrices set ConvertedPrice of products to their price in currency, rounded to the minor unit of currency
+func (s *ProductClient) ConvertPrices(currency string, products ...*dto.Product) error {
+	rates, err := s.GetCachedExchangeRates()
+	if err != nil {
+		return err
+	}
+	toRate, ok := rates.Rates[currency]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnsupportedCurrency, currency)
+	}
+	for _, product := range products {
+		if product == nil {
+			continue
+		}
+		fromRate, ok := rates.Rates[product.Price.Currency]
+		if !ok {
+			return fmt.Errorf("%w: %s of product %d", ErrUnsupportedCurrency, product.Price.Currency, product.ID)
+		}
+		converted, err := product.Price.Convert(currency, toRate/fromRate)
+		if err != nil {
+			return err
+		}
+		product.ConvertedPrice = &converted
+	}
+	return nil
+}
+
+func (s *ProductClient) SearchProducts(input *dto.SearchProductsInput) (*dto.SearchProductsOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := SearchProductsInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("ProductClient: parse SearchProducts input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("ProductClient: invalid request for SearchProducts", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.SearchProducts(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("ProductClient: SearchProducts error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("ProductClient: invalid response for SearchProducts", zap.Error(err))
+		return nil, err
+	}
+	output, err := SearchProductsResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("ProductClient: invalid response for SearchProducts", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
@@ -3,6 +3,7 @@ package userclient
 import (
 	"api-gateway/pkg/dto"
 	userpb "api-gateway/pkg/pb/userservice"
+	"product-service/pkg/money"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -191,3 +192,118 @@ func DelSelByIDResponseToOutput(res *userpb.DelSellerByIDResponse) (*dto.DelSelB
 		Success: res.GetSuccess(),
 	}, nil
 }
+
+// For Wishlist
+
+func WishlistItemsProtoToDTO(items []*userpb.WishlistItem) ([]*dto.WishlistItem, error) {
+	itemsDTO := make([]*dto.WishlistItem, 0, len(items))
+	for _, item := range items {
+		itemDTO := &dto.WishlistItem{
+			ProductID: item.GetProductId(),
+			AddedAt:   item.GetAddedAt().AsTime(),
+			Available: item.GetAvailable(),
+		}
+		if product := item.GetProduct(); product != nil {
+			itemDTO.Product = &dto.WishlistProduct{
+				ID:       product.GetId(),
+				Name:     product.GetName(),
+				Price:    money.New(product.GetPrice().GetAmount(), product.GetPrice().GetCurrency()),
+				SellerID: product.GetSellerId(),
+				Status:   product.GetStatus(),
+			}
+		}
+		itemsDTO = append(itemsDTO, itemDTO)
+	}
+	return itemsDTO, nil
+}
+
+func AddWisIteInputToRequest(input *dto.AddWishlistItemInput) (*userpb.AddWishlistItemRequest, error) {
+	return &userpb.AddWishlistItemRequest{
+		UserId:    input.UserID,
+		ProductId: input.ProductID,
+	}, nil
+}
+func AddWisIteResponseToOutput(res *userpb.AddWishlistItemResponse) (*dto.AddWishlistItemOutput, error) {
+	return &dto.AddWishlistItemOutput{
+		Message: res.GetMessage(),
+		Success: res.GetSuccess(),
+	}, nil
+}
+
+func RemWisIteInputToRequest(input *dto.RemoveWishlistItemInput) (*userpb.RemoveWishlistItemRequest, error) {
+	return &userpb.RemoveWishlistItemRequest{
+		UserId:    input.UserID,
+		ProductId: input.ProductID,
+	}, nil
+}
+func RemWisIteResponseToOutput(res *userpb.RemoveWishlistItemResponse) (*dto.RemoveWishlistItemOutput, error) {
+	return &dto.RemoveWishlistItemOutput{
+		Message: res.GetMessage(),
+		Success: res.GetSuccess(),
+	}, nil
+}
+
+func GetWisInputToRequest(input *dto.GetWishlistInput) (*userpb.GetWishlistRequest, error) {
+	return &userpb.GetWishlistRequest{
+		UserId:   input.UserID,
+		Page:     input.Page,
+		PageSize: input.PageSize,
+	}, nil
+}
+func GetWisResponseToOutput(res *userpb.GetWishlistResponse) (*dto.GetWishlistOutput, error) {
+	items, err := WishlistItemsProtoToDTO(res.GetItems())
+	if err != nil {
+		return nil, err
+	}
+	return &dto.GetWishlistOutput{
+		Message: res.GetMessage(),
+		Success: res.GetSuccess(),
+		Items:   items,
+		Total:   res.GetTotal(),
+	}, nil
+}
+
+func ShaWisInputToRequest(input *dto.ShareWishlistInput) (*userpb.ShareWishlistRequest, error) {
+	return &userpb.ShareWishlistRequest{
+		UserId: input.UserID,
+	}, nil
+}
+func ShaWisResponseToOutput(res *userpb.ShareWishlistResponse) (*dto.ShareWishlistOutput, error) {
+	return &dto.ShareWishlistOutput{
+		Message: res.GetMessage(),
+		Success: res.GetSuccess(),
+		Token:   res.GetToken(),
+	}, nil
+}
+
+func UnsWisInputToRequest(input *dto.UnshareWishlistInput) (*userpb.UnshareWishlistRequest, error) {
+	return &userpb.UnshareWishlistRequest{
+		UserId: input.UserID,
+	}, nil
+}
+func UnsWisResponseToOutput(res *userpb.UnshareWishlistResponse) (*dto.UnshareWishlistOutput, error) {
+	return &dto.UnshareWishlistOutput{
+		Message: res.GetMessage(),
+		Success: res.GetSuccess(),
+	}, nil
+}
+
+func GetShaWisInputToRequest(input *dto.GetSharedWishlistInput) (*userpb.GetSharedWishlistRequest, error) {
+	return &userpb.GetSharedWishlistRequest{
+		Token:    input.Token,
+		Page:     input.Page,
+		PageSize: input.PageSize,
+	}, nil
+}
+func GetShaWisResponseToOutput(res *userpb.GetSharedWishlistResponse) (*dto.GetSharedWishlistOutput, error) {
+	items, err := WishlistItemsProtoToDTO(res.GetItems())
+	if err != nil {
+		return nil, err
+	}
+	return &dto.GetSharedWishlistOutput{
+		Message: res.GetMessage(),
+		Success: res.GetSuccess(),
+		Items:   items,
+		Total:   res.GetTotal(),
+	}, nil
+}
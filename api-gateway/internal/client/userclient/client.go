@@ -355,6 +355,248 @@ func (s *UserClient) DelSellerByID(input *dto.DelSelByIDInput) (*dto.DelSelByIDO
 	return output, nil
 }
 
+// For wishlist
+
+func (s *UserClient) AddWishlistItem(input *dto.AddWishlistItemInput) (*dto.AddWishlistItemOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := AddWisIteInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("UserServer: parse AddWishlistItem input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("UserServer: invalid request for AddWishlistItem", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.AddWishlistItem(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("UserServer: AddWishlistItem error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("UserServer: invalid response for AddWishlistItem", zap.Error(err))
+		return nil, err
+	}
+	output, err := AddWisIteResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("UserServer: invalid response for AddWishlistItem", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *UserClient) RemoveWishlistItem(input *dto.RemoveWishlistItemInput) (*dto.RemoveWishlistItemOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := RemWisIteInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("UserServer: parse RemoveWishlistItem input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("UserServer: invalid request for RemoveWishlistItem", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.RemoveWishlistItem(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("UserServer: RemoveWishlistItem error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("UserServer: invalid response for RemoveWishlistItem", zap.Error(err))
+		return nil, err
+	}
+	output, err := RemWisIteResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("UserServer: invalid response for RemoveWishlistItem", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *UserClient) GetWishlist(input *dto.GetWishlistInput) (*dto.GetWishlistOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := GetWisInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("UserServer: parse GetWishlist input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("UserServer: invalid request for GetWishlist", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.GetWishlist(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("UserServer: GetWishlist error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("UserServer: invalid response for GetWishlist", zap.Error(err))
+		return nil, err
+	}
+	output, err := GetWisResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("UserServer: invalid response for GetWishlist", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *UserClient) ShareWishlist(input *dto.ShareWishlistInput) (*dto.ShareWishlistOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := ShaWisInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("UserServer: parse ShareWishlist input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("UserServer: invalid request for ShareWishlist", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.ShareWishlist(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("UserServer: ShareWishlist error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("UserServer: invalid response for ShareWishlist", zap.Error(err))
+		return nil, err
+	}
+	output, err := ShaWisResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("UserServer: invalid response for ShareWishlist", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *UserClient) UnshareWishlist(input *dto.UnshareWishlistInput) (*dto.UnshareWishlistOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := UnsWisInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("UserServer: parse UnshareWishlist input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("UserServer: invalid request for UnshareWishlist", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.UnshareWishlist(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("UserServer: UnshareWishlist error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("UserServer: invalid response for UnshareWishlist", zap.Error(err))
+		return nil, err
+	}
+	output, err := UnsWisResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("UserServer: invalid response for UnshareWishlist", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
+func (s *UserClient) GetSharedWishlist(input *dto.GetSharedWishlistInput) (*dto.GetSharedWishlistOutput, error) {
+	if s.Client == nil {
+		if err := s.validateClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parse to ServerRequest and validate
+	req, err := GetShaWisInputToRequest(input)
+	if err != nil {
+		s.Logger.Warn("UserServer: parse GetSharedWishlist input to request error", zap.Error(err))
+		return nil, err
+	}
+	if err := protovalidate.Validate(req); err != nil {
+		s.Logger.Warn("UserServer: invalid request for GetSharedWishlist", zap.Error(err))
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := s.Client.GetSharedWishlist(ctx, req)
+
+	// Get response, validate and parse to output
+	if err != nil {
+		s.Logger.Warn("UserServer: GetSharedWishlist error", zap.Error(err))
+		return nil, err
+	}
+	if err = protovalidate.Validate(res); err != nil {
+		s.Logger.Warn("UserServer: invalid response for GetSharedWishlist", zap.Error(err))
+		return nil, err
+	}
+	output, err := GetShaWisResponseToOutput(res)
+	if err != nil {
+		s.Logger.Warn("UserServer: invalid response for GetSharedWishlist", zap.Error(err))
+		return nil, err
+	}
+
+	// Return valid output
+	return output, nil
+}
+
 // Validate client
 
 func (s *UserClient) validateClient() error {
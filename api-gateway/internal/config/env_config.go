@@ -1,31 +1,40 @@
 package config
 
 import (
-	"errors"
 	"os"
+	"strings"
 )
 
 type EnvConfig struct {
-	JWTSecret string
+	JWKSURL        string
+	TrustedProxies []string
 }
 
-// InitJWTSecret load env about jwt
-func InitJWTSecret() (string, error) {
-	jwtSecret := os.Getenv("JWT_SECRET")
-	if jwtSecret == "" {
-		return "", errors.New("JWT secret not set")
+// InitJWKSURL load env about the JWKS endpoint of auth-service
+func InitJWKSURL() string {
+	jwksURL := os.Getenv("JWKS_URL")
+	if jwksURL == "" {
+		return "http://auth-service:8081/.well-known/jwks.json"
 	}
-	return jwtSecret, nil
+	return jwksURL
 }
 
-// NewEnvConfig load env config
-func NewEnvConfig() (*EnvConfig, error) {
-	jwtSecret, err := InitJWTSecret()
-	if err != nil {
-		return nil, err
+// InitTrustedProxies load env about the comma separated IPs or CIDRs of the proxies in front of the gateway,
+// X-Forwarded-For is only read from them and without any the client IP is the address of the connection
+func InitTrustedProxies() []string {
+	var proxies []string
+	for _, proxy := range strings.Split(os.Getenv("TRUSTED_PROXIES"), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			proxies = append(proxies, proxy)
+		}
 	}
+	return proxies
+}
 
+// NewEnvConfig load env config
+func NewEnvConfig() (*EnvConfig, error) {
 	return &EnvConfig{
-		JWTSecret: jwtSecret,
+		JWKSURL:        InitJWKSURL(),
+		TrustedProxies: InitTrustedProxies(),
 	}, nil
 }
@@ -3,12 +3,53 @@ package handler
 import (
 	"api-gateway/internal/client/authclient"
 	"api-gateway/pkg/dto"
+	"crypto/subtle"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+const (
+	// oidcStateCookie binds an OIDC login to the browser that began it, the callback only accepts its state
+	oidcStateCookie = "oidc_state"
+	oidcStatePath   = "/auth/oidc"
+	oidcStateMaxAge = 600 // seconds, the lifetime of a login state in auth-service
+
+	// maxUserAgentLength is the longest user agent auth-service keeps for a session, in characters
+	maxUserAgentLength = 512
+)
+
+// clientUserAgent return the user agent of the request as valid UTF-8 cut to maxUserAgentLength,
+// auth-service refuses longer ones and a login must not fail for its user agent
+func clientUserAgent(c *gin.Context) string {
+	userAgent := []rune(strings.ToValidUTF8(c.Request.UserAgent(), ""))
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = userAgent[:maxUserAgentLength]
+	}
+	return string(userAgent)
+}
+
+// setOIDCStateCookie keep state in a cookie sent only to the OIDC routes, and clear it for an empty state
+func setOIDCStateCookie(c *gin.Context, state string) {
+	maxAge := oidcStateMaxAge
+	if state == "" {
+		maxAge = -1
+	}
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    state,
+		Path:     oidcStatePath,
+		MaxAge:   maxAge,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
 // AuthHandler : handler for AuthClient
 type AuthHandler struct {
 	Service *authclient.AuthClient
@@ -33,6 +74,8 @@ func NewAuthHandler(service *authclient.AuthClient, logger *zap.Logger) *AuthHan
 // @Param input body dto.LoginInput true "Username and password to Login"
 // @Success 200 {object} dto.LoginOutput
 // @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /auth/login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
@@ -45,11 +88,20 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	// Get response and parse to json
+	req.ClientIP, req.UserAgent = c.ClientIP(), clientUserAgent(c)
+
+	// Get response and parse to json, throttled and wrong credentials keep their status
 	res, err := h.Service.Login(&req)
 	if err != nil {
 		h.Logger.Warn("AuthHandler: Login warn", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		code := http.StatusInternalServerError
+		switch status.Code(err) {
+		case codes.ResourceExhausted:
+			code = http.StatusTooManyRequests
+		case codes.Unauthenticated:
+			code = http.StatusUnauthorized
+		}
+		c.JSON(code, dto.ErrorResponse{Error: GetErrorString(err.Error())})
 		return
 	}
 	c.JSON(http.StatusOK, res)
@@ -81,7 +133,11 @@ func (h *AuthHandler) Register(c *gin.Context) {
 	res, err := h.Service.Register(&req)
 	if err != nil {
 		h.Logger.Warn("AuthHandler Register warn", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		code := http.StatusInternalServerError
+		if status.Code(err) == codes.InvalidArgument {
+			code = http.StatusBadRequest
+		}
+		c.JSON(code, GetErrorResponse(err))
 		return
 	}
 	c.JSON(http.StatusOK, res)
@@ -113,7 +169,11 @@ func (h *AuthHandler) ChangePassword(c *gin.Context) {
 	res, err := h.Service.ChangePassword(&req)
 	if err != nil {
 		h.Logger.Warn("AuthHandler Change Password warn", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		code := http.StatusInternalServerError
+		if status.Code(err) == codes.InvalidArgument {
+			code = http.StatusBadRequest
+		}
+		c.JSON(code, GetErrorResponse(err))
 		return
 	}
 	c.JSON(http.StatusOK, res)
@@ -188,8 +248,717 @@ func (h *AuthHandler) RegisterSellerRoles(c *gin.Context) {
 	res, err := h.Service.RegisterSellerRoles(&req)
 	if err != nil {
 		h.Logger.Warn("AuthHandler RegisterSellerRoles warn", zap.Error(err))
+		code := http.StatusInternalServerError
+		if status.Code(err) == codes.InvalidArgument {
+			code = http.StatusBadRequest
+		}
+		c.JSON(code, GetErrorResponse(err))
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// Logout is responsible for parse logout gin.context request
+// Logout godoc
+// @Summary Logout
+// @Description Revoke the access token and the refresh tokens of its login
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.LogoutOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /auth/logout [post]
+func (h *AuthHandler) Logout(c *gin.Context) {
+
+	// Get the access token checked by AuthMiddleware
+	accessToken, ok := c.Get("accessToken")
+	if !ok {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString("not valid access token")})
+		return
+	}
+	req := dto.LogoutInput{AccessToken: accessToken.(string)}
+
+	// Get response and parse to json
+	res, err := h.Service.Logout(&req)
+	if err != nil {
+		h.Logger.Warn("AuthHandler logout warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// LogoutAll is responsible for parse logout all gin.context request
+// LogoutAll godoc
+// @Summary LogoutAll
+// @Description Revoke every access and refresh token of the user
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.LogoutAllOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /auth/logout-all [post]
+func (h *AuthHandler) LogoutAll(c *gin.Context) {
+
+	// Get the access token checked by AuthMiddleware
+	accessToken, ok := c.Get("accessToken")
+	if !ok {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString("not valid access token")})
+		return
+	}
+	req := dto.LogoutAllInput{AccessToken: accessToken.(string)}
+
+	// Get response and parse to json
+	res, err := h.Service.LogoutAll(&req)
+	if err != nil {
+		h.Logger.Warn("AuthHandler logout all warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// UnlockAccount is responsible for parse unlock account gin.context request
+// UnlockAccount godoc
+// @Summary UnlockAccount
+// @Description Clear the failed logins and lockout of an account, of an IP, or of both
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param input body dto.UnlockAccountInput true "Account by username and role, and/or client IP to unlock"
+// @Success 200 {object} dto.UnlockAccountOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /auth/unlock-account [post]
+func (h *AuthHandler) UnlockAccount(c *gin.Context) {
+
+	// Parse from gin.context json to request dto
+	var req dto.UnlockAccountInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Logger.Warn("AuthHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+
+	// Get response and parse to json
+	res, err := h.Service.UnlockAccount(&req)
+	if err != nil {
+		h.Logger.Warn("AuthHandler unlock account warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// RequestPasswordReset is responsible for parse request password reset gin.context request
+// RequestPasswordReset godoc
+// @Summary RequestPasswordReset
+// @Description Send a single use password reset token to the owner of the account
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param input body dto.RequestPasswordResetInput true "Account to reset the password of"
+// @Success 200 {object} dto.RequestPasswordResetOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /auth/forgot-password [post]
+func (h *AuthHandler) RequestPasswordReset(c *gin.Context) {
+
+	// Parse from gin.context json to request dto
+	var req dto.RequestPasswordResetInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Logger.Warn("AuthHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+
+	// Get response and parse to json
+	res, err := h.Service.RequestPasswordReset(&req)
+	if err != nil {
+		h.Logger.Warn("AuthHandler request password reset warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// ResetPassword is responsible for parse reset password gin.context request
+// ResetPassword godoc
+// @Summary ResetPassword
+// @Description Set a new password with a password reset token, every session of the account is logged out
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param input body dto.ResetPasswordInput true "Reset token and new password"
+// @Success 200 {object} dto.ResetPasswordOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /auth/reset-password [post]
+func (h *AuthHandler) ResetPassword(c *gin.Context) {
+
+	// Parse from gin.context json to request dto
+	var req dto.ResetPasswordInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Logger.Warn("AuthHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+
+	// Get response and parse to json
+	res, err := h.Service.ResetPassword(&req)
+	if err != nil {
+		h.Logger.Warn("AuthHandler reset password warn", zap.Error(err))
+		code := http.StatusInternalServerError
+		if status.Code(err) == codes.InvalidArgument {
+			code = http.StatusBadRequest
+		}
+		c.JSON(code, GetErrorResponse(err))
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// BeginTOTPEnrollment is responsible for parse begin TOTP enrollment gin.context request
+// BeginTOTPEnrollment godoc
+// @Summary BeginTOTPEnrollment
+// @Description Generate a TOTP secret to add to an authenticator app, for the logged in user or with the challenge token of a login
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param input body dto.BeginTOTPEnrollmentInput false "Challenge token of a login that requires enrollment"
+// @Success 200 {object} dto.BeginTOTPEnrollmentOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /auth/2fa/enroll [post]
+// @Router /auth/login/2fa/enroll [post]
+func (h *AuthHandler) BeginTOTPEnrollment(c *gin.Context) {
+
+	// Parse from gin.context json to request dto, the body is empty for a logged in user
+	var req dto.BeginTOTPEnrollmentInput
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.Logger.Warn("AuthHandler invalid request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+			return
+		}
+	}
+	if userID, err := getUserID(c); err == nil {
+		req.UserID, req.ChallengeToken = userID, ""
+	} else if req.ChallengeToken == "" {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString("challenge_token is required")})
+		return
+	}
+
+	// Get response and parse to json
+	res, err := h.Service.BeginTOTPEnrollment(&req)
+	if err != nil {
+		h.Logger.Warn("AuthHandler begin TOTP enrollment warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// ConfirmTOTPEnrollment is responsible for parse confirm TOTP enrollment gin.context request
+// ConfirmTOTPEnrollment godoc
+// @Summary ConfirmTOTPEnrollment
+// @Description Turn on two-factor authentication with a first code, and return single use recovery codes.
+// @Description With the challenge token of a login, the tokens of the login are returned as well
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param input body dto.ConfirmTOTPEnrollmentInput true "Code of the authenticator app, and challenge token of a login"
+// @Success 200 {object} dto.ConfirmTOTPEnrollmentOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /auth/2fa/confirm [post]
+// @Router /auth/login/2fa/confirm [post]
+func (h *AuthHandler) ConfirmTOTPEnrollment(c *gin.Context) {
+
+	// Parse from gin.context json to request dto
+	var req dto.ConfirmTOTPEnrollmentInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Logger.Warn("AuthHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	if userID, err := getUserID(c); err == nil {
+		req.UserID, req.ChallengeToken = userID, ""
+	} else if req.ChallengeToken == "" {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString("challenge_token is required")})
+		return
+	}
+	req.ClientIP, req.UserAgent = c.ClientIP(), clientUserAgent(c)
+
+	// Get response and parse to json
+	res, err := h.Service.ConfirmTOTPEnrollment(&req)
+	if err != nil {
+		h.Logger.Warn("AuthHandler confirm TOTP enrollment warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// VerifySecondFactor is responsible for parse verify second factor gin.context request
+// VerifySecondFactor godoc
+// @Summary VerifySecondFactor
+// @Description Finish a login with the challenge token and a TOTP or recovery code
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param input body dto.VerifySecondFactorInput true "Challenge token of the login and TOTP or recovery code"
+// @Success 200 {object} dto.VerifySecondFactorOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /auth/login/2fa/verify [post]
+func (h *AuthHandler) VerifySecondFactor(c *gin.Context) {
+
+	// Parse from gin.context json to request dto
+	var req dto.VerifySecondFactorInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Logger.Warn("AuthHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.ClientIP, req.UserAgent = c.ClientIP(), clientUserAgent(c)
+
+	// Get response and parse to json, a locked second factor keeps its status
+	res, err := h.Service.VerifySecondFactor(&req)
+	if err != nil {
+		h.Logger.Warn("AuthHandler verify second factor warn", zap.Error(err))
+		code := http.StatusInternalServerError
+		if status.Code(err) == codes.ResourceExhausted {
+			code = http.StatusTooManyRequests
+		}
+		c.JSON(code, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// DisableTOTP is responsible for parse disable TOTP gin.context request
+// DisableTOTP godoc
+// @Summary DisableTOTP
+// @Description Turn off two-factor authentication with a TOTP or recovery code, not allowed for roles that require it
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param input body dto.DisableTOTPInput true "TOTP or recovery code"
+// @Success 200 {object} dto.DisableTOTPOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /auth/2fa/disable [post]
+func (h *AuthHandler) DisableTOTP(c *gin.Context) {
+
+	// Parse from gin.context json to request dto
+	var req dto.DisableTOTPInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Logger.Warn("AuthHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString("not valid user_id")})
+		return
+	}
+	req.UserID = userID
+
+	// Get response and parse to json
+	res, err := h.Service.DisableTOTP(&req)
+	if err != nil {
+		h.Logger.Warn("AuthHandler disable TOTP warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// BeginOIDCLogin is responsible for parse begin OIDC login gin.context request
+// BeginOIDCLogin godoc
+// @Summary BeginOIDCLogin
+// @Description Redirect to the login page of an identity provider, which redirects back to the callback
+// @Tags auth
+// @Param provider path string true "Configured name of the identity provider"
+// @Success 302
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /auth/oidc/{provider}/login [get]
+func (h *AuthHandler) BeginOIDCLogin(c *gin.Context) {
+	req := dto.BeginOIDCLoginInput{Provider: c.Param("provider")}
+
+	// Get response and redirect the user agent to the provider
+	res, err := h.Service.BeginOIDCLogin(&req)
+	if err != nil {
+		h.Logger.Warn("AuthHandler begin OIDC login warn", zap.Error(err))
+		code := http.StatusInternalServerError
+		if status.Code(err) == codes.InvalidArgument {
+			code = http.StatusBadRequest
+		}
+		c.JSON(code, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	setOIDCStateCookie(c, res.State)
+	c.Redirect(http.StatusFound, res.AuthorizationURL)
+}
+
+// LinkOIDCIdentity is responsible for parse link OIDC identity gin.context request
+// LinkOIDCIdentity godoc
+// @Summary LinkOIDCIdentity
+// @Description Return the login URL of an identity provider, the identity logged in there is linked to the user by the callback.
+// @Description The same browser must then open the URL, the state cookie set here binds the callback to the user.
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Param provider path string true "Configured name of the identity provider"
+// @Success 200 {object} dto.BeginOIDCLoginOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /auth/oidc/{provider}/link [post]
+func (h *AuthHandler) LinkOIDCIdentity(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString("not valid user_id")})
+		return
+	}
+	req := dto.BeginOIDCLoginInput{Provider: c.Param("provider"), UserID: userID}
+
+	// Get response and parse to json
+	res, err := h.Service.BeginOIDCLogin(&req)
+	if err != nil {
+		h.Logger.Warn("AuthHandler link OIDC identity warn", zap.Error(err))
+		code := http.StatusInternalServerError
+		if status.Code(err) == codes.InvalidArgument {
+			code = http.StatusBadRequest
+		}
+		c.JSON(code, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	setOIDCStateCookie(c, res.State)
+	c.JSON(http.StatusOK, res)
+}
+
+// CompleteOIDCLogin is responsible for parse complete OIDC login gin.context request
+// CompleteOIDCLogin godoc
+// @Summary CompleteOIDCLogin
+// @Description Callback of identity providers. Return tokens like login, a first login creates a buyer account.
+// @Description Only the browser that began the login or the link is accepted, by its state cookie. A link needs no access token,
+// @Description one of another user is refused.
+// @Tags auth
+// @Produce json
+// @Param provider path string true "Configured name of the identity provider"
+// @Param state query string true "State returned by the provider"
+// @Param code query string true "Authorization code returned by the provider"
+// @Security BearerAuth
+// @Success 200 {object} dto.CompleteOIDCLoginOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /auth/oidc/{provider}/callback [get]
+func (h *AuthHandler) CompleteOIDCLogin(c *gin.Context) {
+
+	// The provider redirects with error instead of code when the login was refused
+	if providerError := c.Query("error"); providerError != "" {
+		h.Logger.Warn("AuthHandler identity provider refused login", zap.String("error", providerError))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString("identity provider returned " + providerError)})
+		return
+	}
+
+	// Parse from gin.context query to request dto
+	var req dto.CompleteOIDCLoginInput
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.Logger.Warn("AuthHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.Provider = c.Param("provider")
+	req.ClientIP, req.UserAgent = c.ClientIP(), clientUserAgent(c)
+
+	// The state must be the one given to this browser, so that a login URL sent to someone else is refused
+	cookieState, err := c.Cookie(oidcStateCookie)
+	if err != nil || subtle.ConstantTimeCompare([]byte(cookieState), []byte(req.State)) != 1 {
+		h.Logger.Warn("AuthHandler OIDC state does not match the browser", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Login state does not belong to this browser"})
+		return
+	}
+	setOIDCStateCookie(c, "")
+	if userID, err := getUserID(c); err == nil {
+		req.UserID = userID
+	}
+
+	// Get response and parse to json, invalid logins and identities linked elsewhere keep their status
+	res, err := h.Service.CompleteOIDCLogin(&req)
+	if err != nil {
+		h.Logger.Warn("AuthHandler complete OIDC login warn", zap.Error(err))
+		code := http.StatusInternalServerError
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			code = http.StatusBadRequest
+		case codes.Unauthenticated:
+			code = http.StatusUnauthorized
+		case codes.AlreadyExists:
+			code = http.StatusConflict
+		}
+		c.JSON(code, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// storeRoleStatus return the HTTP status of an error of the store role RPCs
+func storeRoleStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.FailedPrecondition:
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
+
+// ListStoreRoles is responsible for parse list store roles gin.context request
+// ListStoreRoles godoc
+// @Summary ListStoreRoles
+// @Description List the custom roles of the store of the user, and the permissions they can grant
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Param store_id query int false "Store of the roles, required for admins"
+// @Success 200 {object} dto.ListStoreRolesOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /auth/store-roles [get]
+func (h *AuthHandler) ListStoreRoles(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString("not valid user_id")})
+		return
+	}
+
+	// Parse from gin.context query to request dto
+	var req dto.ListStoreRolesInput
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.Logger.Warn("AuthHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.ActorID = userID
+
+	// Get response and parse to json
+	res, err := h.Service.ListStoreRoles(&req)
+	if err != nil {
+		h.Logger.Warn("AuthHandler list store roles warn", zap.Error(err))
+		c.JSON(storeRoleStatus(err), dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// CreateStoreRole is responsible for parse create store role gin.context request
+// CreateStoreRole godoc
+// @Summary CreateStoreRole
+// @Description Create a custom role of the store of the user, staff can then be registered with it
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param input body dto.CreateStoreRoleInput true "Role name, description and permissions, store_id is required for admins"
+// @Success 200 {object} dto.CreateStoreRoleOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /auth/store-roles [post]
+func (h *AuthHandler) CreateStoreRole(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString("not valid user_id")})
+		return
+	}
+
+	// Parse from gin.context json to request dto
+	var req dto.CreateStoreRoleInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Logger.Warn("AuthHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.ActorID = userID
+
+	// Get response and parse to json
+	res, err := h.Service.CreateStoreRole(&req)
+	if err != nil {
+		h.Logger.Warn("AuthHandler create store role warn", zap.Error(err))
+		c.JSON(storeRoleStatus(err), dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// UpdateStoreRole is responsible for parse update store role gin.context request
+// UpdateStoreRole godoc
+// @Summary UpdateStoreRole
+// @Description Replace the description and permissions of a custom role, staff with the role get them on token refresh
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param name path string true "Name of the role"
+// @Param input body dto.UpdateStoreRoleInput true "Role description and permissions, store_id is required for admins"
+// @Success 200 {object} dto.UpdateStoreRoleOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /auth/store-roles/{name} [put]
+func (h *AuthHandler) UpdateStoreRole(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString("not valid user_id")})
+		return
+	}
+
+	// Parse from gin.context json to request dto
+	var req dto.UpdateStoreRoleInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Logger.Warn("AuthHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.ActorID = userID
+	req.Name = c.Param("name")
+
+	// Get response and parse to json
+	res, err := h.Service.UpdateStoreRole(&req)
+	if err != nil {
+		h.Logger.Warn("AuthHandler update store role warn", zap.Error(err))
+		c.JSON(storeRoleStatus(err), dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// DeleteStoreRole is responsible for parse delete store role gin.context request
+// DeleteStoreRole godoc
+// @Summary DeleteStoreRole
+// @Description Delete a custom role that no account has
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Param name path string true "Name of the role"
+// @Param store_id query int false "Store of the role, required for admins"
+// @Success 200 {object} dto.DeleteStoreRoleOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /auth/store-roles/{name} [delete]
+func (h *AuthHandler) DeleteStoreRole(c *gin.Context) {
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString("not valid user_id")})
+		return
+	}
+
+	// Parse from gin.context query to request dto
+	var req dto.DeleteStoreRoleInput
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.Logger.Warn("AuthHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.ActorID = userID
+	req.Name = c.Param("name")
+
+	// Get response and parse to json
+	res, err := h.Service.DeleteStoreRole(&req)
+	if err != nil {
+		h.Logger.Warn("AuthHandler delete store role warn", zap.Error(err))
+		c.JSON(storeRoleStatus(err), dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// ListSessions is responsible for parse list sessions gin.context request
+// ListSessions godoc
+// @Summary ListSessions
+// @Description List the active logins of the user with their device, user agent, IP and last use
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.ListSessionsOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /auth/sessions [get]
+func (h *AuthHandler) ListSessions(c *gin.Context) {
+
+	// Get the access token checked by AuthMiddleware
+	accessToken, ok := c.Get("accessToken")
+	if !ok {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString("not valid access token")})
+		return
+	}
+	req := dto.ListSessionsInput{AccessToken: accessToken.(string)}
+
+	// Get response and parse to json
+	res, err := h.Service.ListSessions(&req)
+	if err != nil {
+		h.Logger.Warn("AuthHandler list sessions warn", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
 		return
 	}
 	c.JSON(http.StatusOK, res)
 }
+
+// RevokeSession is responsible for parse revoke session gin.context request
+// RevokeSession godoc
+// @Summary RevokeSession
+// @Description Log out one session of the user, its access and refresh tokens are revoked
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Session ID"
+// @Success 200 {object} dto.RevokeSessionOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /auth/sessions/{id} [delete]
+func (h *AuthHandler) RevokeSession(c *gin.Context) {
+
+	// Get the access token checked by AuthMiddleware
+	accessToken, ok := c.Get("accessToken")
+	if !ok {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString("not valid access token")})
+		return
+	}
+	req := dto.RevokeSessionInput{AccessToken: accessToken.(string), SessionID: c.Param("id")}
+
+	// Get response and parse to json
+	res, err := h.Service.RevokeSession(&req)
+	if err != nil {
+		h.Logger.Warn("AuthHandler revoke session warn", zap.Error(err))
+		code := http.StatusInternalServerError
+		if status.Code(err) == codes.NotFound {
+			code = http.StatusNotFound
+		}
+		c.JSON(code, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
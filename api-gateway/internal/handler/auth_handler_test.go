@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+func TestSetOIDCStateCookie(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	setOIDCStateCookie(c, "state-1")
+
+	cookies := w.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("got %d cookies, want 1", len(cookies))
+	}
+	cookie := cookies[0]
+	if cookie.Name != oidcStateCookie || cookie.Value != "state-1" || cookie.Path != oidcStatePath {
+		t.Errorf("cookie = %s=%s path %s", cookie.Name, cookie.Value, cookie.Path)
+	}
+	if !cookie.Secure || !cookie.HttpOnly || cookie.SameSite != http.SameSiteLaxMode {
+		t.Errorf("cookie is not Secure, HttpOnly and SameSite=Lax: %s", cookie.String())
+	}
+	if cookie.MaxAge != oidcStateMaxAge {
+		t.Errorf("cookie MaxAge = %d, want %d", cookie.MaxAge, oidcStateMaxAge)
+	}
+}
+
+func TestCompleteOIDCLoginRejectsStateOfAnotherBrowser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h := &AuthHandler{Logger: zap.NewNop()}
+	router := gin.New()
+	router.GET("/auth/oidc/:provider/callback", h.CompleteOIDCLogin)
+
+	tests := []struct {
+		name   string
+		cookie *http.Cookie
+	}{
+		{"no cookie", nil},
+		{"cookie of another login", &http.Cookie{Name: oidcStateCookie, Value: "state-2"}},
+		{"empty cookie", &http.Cookie{Name: oidcStateCookie, Value: ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/auth/oidc/mock/callback?state=state-1&code=code-1", nil)
+			if tt.cookie != nil {
+				req.AddCookie(tt.cookie)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("status = %d, want %d", w.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestClientUserAgent(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name      string
+		userAgent string
+		want      string
+	}{
+		{"short", "curl/8.8.0", "curl/8.8.0"},
+		{"empty", "", ""},
+		{"at limit", strings.Repeat("a", maxUserAgentLength), strings.Repeat("a", maxUserAgentLength)},
+		{"over limit", strings.Repeat("a", maxUserAgentLength+100), strings.Repeat("a", maxUserAgentLength)},
+		{"cut in characters", strings.Repeat("é", maxUserAgentLength+1), strings.Repeat("é", maxUserAgentLength)},
+		{"invalid utf-8", "agent\xff/1.0", "agent/1.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodPost, "/auth/login", nil)
+			c.Request.Header.Set("User-Agent", tt.userAgent)
+			got := clientUserAgent(c)
+			if got != tt.want {
+				t.Errorf("clientUserAgent() = %q, want %q", got, tt.want)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("clientUserAgent() = %q is not valid UTF-8", got)
+			}
+		})
+	}
+}
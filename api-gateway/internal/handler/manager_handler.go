@@ -6,11 +6,15 @@ import (
 	"api-gateway/internal/client/orderclient"
 	"api-gateway/internal/client/productclient"
 	"api-gateway/internal/client/userclient"
+	"api-gateway/pkg/dto"
+	"errors"
 	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
 )
 
 // ManagerHandler save handlers for all client gRPC
@@ -75,6 +79,21 @@ func GetErrorString(s string) string {
 	return strings.ToUpper(string(str[0])) + str[1:]
 }
 
+// GetErrorResponse return the ErrorResponse of an error of a service, with the field errors it carries as BadRequest details
+func GetErrorResponse(err error) dto.ErrorResponse {
+	res := dto.ErrorResponse{Error: GetErrorString(err.Error())}
+	for _, detail := range status.Convert(err).Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, violation := range badRequest.GetFieldViolations() {
+			res.Fields = append(res.Fields, &dto.FieldError{Field: violation.GetField(), Message: violation.GetDescription()})
+		}
+	}
+	return res
+}
+
 func getQueryInt(c *gin.Context, key string, defaultVal int) (int, error) {
 	valStr := c.Query(key)
 	if valStr == "" {
@@ -86,3 +105,15 @@ func getQueryInt(c *gin.Context, key string, defaultVal int) (int, error) {
 	}
 	return val, nil
 }
+
+func getUserID(c *gin.Context) (uint64, error) {
+	userIDInterface, exists := c.Get("userID")
+	if !exists {
+		return 0, errors.New("user ID not found in context")
+	}
+	userID, ok := userIDInterface.(uint64)
+	if !ok {
+		return 0, errors.New("user ID format is incorrect")
+	}
+	return userID, nil
+}
@@ -3,13 +3,29 @@ package handler
 import (
 	"api-gateway/internal/client/productclient"
 	"api-gateway/pkg/dto"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// maxImportFileSize keeps the import file under the default 4 MiB gRPC message limit of ProductService
+const maxImportFileSize = 3 << 20
+
+// exportContentTypes is the Content-Type of each export format
+var exportContentTypes = map[string]string{
+	"csv":   "text/csv",
+	"jsonl": "application/x-ndjson",
+}
+
 // ProductHandler : handler for ProductClient
 type ProductHandler struct {
 	Service *productclient.ProductClient
@@ -24,6 +40,36 @@ func NewProductHandler(service *productclient.ProductClient, logger *zap.Logger)
 	}
 }
 
+// convertPrices add prices converted to the currency query parameter to products, nothing is done without it.
+// false is returned when the error response was already written.
+func (h *ProductHandler) convertPrices(c *gin.Context, products ...*dto.Product) bool {
+	currency := strings.ToUpper(c.Query("currency"))
+	if currency == "" {
+		return true
+	}
+	if err := h.Service.ConvertPrices(currency, products...); err != nil {
+		h.Logger.Warn("ProductHandler: ConvertPrices warn", zap.Error(err))
+		if errors.Is(err, productclient.ErrUnsupportedCurrency) {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		}
+		return false
+	}
+	return true
+}
+
+// getPageSize get page_size query, it writes 400 and returns false when it is invalid
+func (h *ProductHandler) getPageSize(c *gin.Context) (uint64, bool) {
+	pageSize, err := getQueryInt(c, "page_size", 20)
+	if err != nil || pageSize < 1 || pageSize > 100 {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid page_size"})
+		return 0, false
+	}
+	return uint64(pageSize), true
+}
+
 // CreateProduct is responsible for parse create product gin.context request
 // CreateProduct godoc
 // @Summary CreateProduct
@@ -35,6 +81,7 @@ func NewProductHandler(service *productclient.ProductClient, logger *zap.Logger)
 // @Security BearerAuth
 // @Success 200 {object} dto.CreateProductOutput
 // @Failure 400 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.CreateProductOutput
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /products [post]
 func (h *ProductHandler) CreateProduct(c *gin.Context) {
@@ -47,6 +94,15 @@ func (h *ProductHandler) CreateProduct(c *gin.Context) {
 		return
 	}
 
+	// Get SellerID, the product is owned by the store of the seller
+	sellerID, err := getUserID(c)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.SellerID = sellerID
+
 	// Get response and parse to json
 	res, err := h.Service.CreateProduct(&req)
 	if err != nil {
@@ -54,6 +110,10 @@ func (h *ProductHandler) CreateProduct(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
 		return
 	}
+	if len(res.FieldErrors) > 0 {
+		c.JSON(http.StatusUnprocessableEntity, res)
+		return
+	}
 	c.JSON(http.StatusOK, res)
 }
 
@@ -69,6 +129,7 @@ func (h *ProductHandler) CreateProduct(c *gin.Context) {
 // @Param id path integer true "Product ID"
 // @Success 200 {object} dto.UpdateProductOutput
 // @Failure 400 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.UpdateProductOutput
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /products/{id} [put]
 func (h *ProductHandler) UpdateProduct(c *gin.Context) {
@@ -87,8 +148,18 @@ func (h *ProductHandler) UpdateProduct(c *gin.Context) {
 	if err != nil {
 		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	if req.Product == nil {
+		req.Product = &dto.Product{}
 	}
 	req.Product.ID = idUint
+	req.UserId, err = getUserID(c)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
 
 	// Get response and parse to json
 	res, err := h.Service.UpdateProduct(&req)
@@ -97,6 +168,142 @@ func (h *ProductHandler) UpdateProduct(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
 		return
 	}
+	if len(res.FieldErrors) > 0 {
+		c.JSON(http.StatusUnprocessableEntity, res)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// DeleteProduct is responsible for parse delete product gin.context request
+// DeleteProduct godoc
+// @Summary DeleteProduct
+// @Description Delete product, it is hidden from listings but still returned for old orders
+// @Tags product
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Product ID"
+// @Success 200 {object} dto.DeleteProductOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /products/{id} [delete]
+func (h *ProductHandler) DeleteProduct(c *gin.Context) {
+
+	// Parse from gin.context param to request dto
+	var req dto.DeleteProductInput
+
+	// Get ID
+	idStr := c.Param("id")
+	idUint, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.ID = idUint
+	req.UserID, err = getUserID(c)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+
+	// Get response and parse to json
+	res, err := h.Service.DeleteProduct(&req)
+	if err != nil {
+		h.Logger.Warn("ProductHandler: DeleteProduct warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// ArchiveProduct is responsible for parse archive product gin.context request
+// ArchiveProduct godoc
+// @Summary ArchiveProduct
+// @Description Archive product, it is hidden from listings and can not be ordered
+// @Tags product
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Product ID"
+// @Success 200 {object} dto.ArchiveProductOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /products/{id}/archive [post]
+func (h *ProductHandler) ArchiveProduct(c *gin.Context) {
+
+	// Parse from gin.context param to request dto
+	var req dto.ArchiveProductInput
+
+	// Get ID
+	idStr := c.Param("id")
+	idUint, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.ID = idUint
+	req.UserID, err = getUserID(c)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+
+	// Get response and parse to json
+	res, err := h.Service.ArchiveProduct(&req)
+	if err != nil {
+		h.Logger.Warn("ProductHandler: ArchiveProduct warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// RestoreProduct is responsible for parse restore product gin.context request
+// RestoreProduct godoc
+// @Summary RestoreProduct
+// @Description Restore an archived or deleted product
+// @Tags product
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Product ID"
+// @Success 200 {object} dto.RestoreProductOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /products/{id}/restore [post]
+func (h *ProductHandler) RestoreProduct(c *gin.Context) {
+
+	// Parse from gin.context param to request dto
+	var req dto.RestoreProductInput
+
+	// Get ID
+	idStr := c.Param("id")
+	idUint, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.ID = idUint
+	req.UserID, err = getUserID(c)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+
+	// Get response and parse to json
+	res, err := h.Service.RestoreProduct(&req)
+	if err != nil {
+		h.Logger.Warn("ProductHandler: RestoreProduct warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
 	c.JSON(http.StatusOK, res)
 }
 
@@ -109,6 +316,7 @@ func (h *ProductHandler) UpdateProduct(c *gin.Context) {
 // @Produce json
 // @Security BearerAuth
 // @Param id path integer true "Product ID"
+// @Param currency query string false "ISO 4217 currency to also show prices in, e.g. USD"
 // @Success 200 {object} dto.GetProductByIDOutput
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
@@ -139,6 +347,9 @@ func (h *ProductHandler) GetProductByID(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
 		return
 	}
+	if !h.convertPrices(c, res.Product) {
+		return
+	}
 	c.JSON(http.StatusOK, res)
 }
 
@@ -151,6 +362,10 @@ func (h *ProductHandler) GetProductByID(c *gin.Context) {
 // @Produce json
 // @Security BearerAuth
 // @Param seller_id path integer true "Seller ID"
+// @Param page_size query integer false "Page size, default 20"
+// @Param page_token query string false "next_page_token of the previous page"
+// @Param sort query string false "NEWEST (default), OLDEST, PRICE_ASC, PRICE_DESC or NAME_ASC, price sorts order by currency code then by price within a currency"
+// @Param currency query string false "ISO 4217 currency to also show prices in, e.g. USD"
 // @Success 200 {object} dto.GetProductsBySellerIDOutput
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
@@ -171,8 +386,16 @@ func (h *ProductHandler) GetProductsBySellerID(c *gin.Context) {
 	if err != nil {
 		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
 	}
 	req.SellerID = sellerIdUint
+	pageSize, ok := h.getPageSize(c)
+	if !ok {
+		return
+	}
+	req.PageSize = pageSize
+	req.PageToken = c.Query("page_token")
+	req.Sort = strings.ToUpper(c.Query("sort"))
 
 	// Get response and parse to json
 	res, err := h.Service.GetProductsBySellerID(&req)
@@ -181,6 +404,9 @@ func (h *ProductHandler) GetProductsBySellerID(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
 		return
 	}
+	if !h.convertPrices(c, res.Products...) {
+		return
+	}
 	c.JSON(http.StatusOK, res)
 }
 
@@ -192,8 +418,10 @@ func (h *ProductHandler) GetProductsBySellerID(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Param page query integer true "Page number"
-// @Param page_size query integer true "Page size"
+// @Param page_size query integer false "Page size, default 20"
+// @Param page_token query string false "next_page_token of the previous page"
+// @Param sort query string false "NEWEST (default), OLDEST, PRICE_ASC, PRICE_DESC or NAME_ASC, price sorts order by currency code then by price within a currency"
+// @Param currency query string false "ISO 4217 currency to also show prices in, e.g. USD"
 // @Success 200 {object} dto.GetProductsOutput
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
@@ -209,23 +437,989 @@ func (h *ProductHandler) GetProducts(c *gin.Context) {
 	//}
 
 	// Get query
+	pageSize, ok := h.getPageSize(c)
+	if !ok {
+		return
+	}
+	req.PageSize = pageSize
+	req.PageToken = c.Query("page_token")
+	req.Sort = strings.ToUpper(c.Query("sort"))
+
+	// Get response and parse to json
+	res, err := h.Service.GetProducts(&req)
+	if err != nil {
+		h.Logger.Warn("ProductHandler: GetProducts warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	if !h.convertPrices(c, res.Products...) {
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// SearchProducts is responsible for parse search products gin.context request
+// SearchProducts godoc
+// @Summary SearchProducts
+// @Description Search active products by name, SKU and attribute values, best match first
+// @Tags product
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param q query string false "Search text, empty matches every product"
+// @Param seller_id query integer false "Only products of the seller"
+// @Param category_id query integer false "Only products of the category"
+// @Param page query integer false "Page, default 1, at most 100"
+// @Param page_size query integer false "Page size, default 20"
+// @Param currency query string false "ISO 4217 currency to also show prices in, e.g. USD"
+// @Success 200 {object} dto.SearchProductsOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /products/search [get]
+func (h *ProductHandler) SearchProducts(c *gin.Context) {
+
+	// Parse from gin.context query to request dto
+	var req dto.SearchProductsInput
+	sellerID, err := getQueryInt(c, "seller_id", 0)
+	if err != nil || sellerID < 0 {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid seller_id"})
+		return
+	}
+	categoryID, err := getQueryInt(c, "category_id", 0)
+	if err != nil || categoryID < 0 {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid category_id"})
+		return
+	}
 	page, err := getQueryInt(c, "page", 1)
+	if err != nil || page < 1 || page > 100 {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid page"})
+		return
+	}
+	pageSize, ok := h.getPageSize(c)
+	if !ok {
+		return
+	}
+	req.Query = c.Query("q")
+	req.SellerID = uint64(sellerID)
+	req.CategoryID = uint64(categoryID)
+	req.Page = uint64(page)
+	req.PageSize = pageSize
+
+	// Get response and parse to json
+	res, err := h.Service.SearchProducts(&req)
+	if err != nil {
+		h.Logger.Warn("ProductHandler: SearchProducts warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	if !h.convertPrices(c, res.Products...) {
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// ImportProducts is responsible for parse import products gin.context request
+// ImportProducts godoc
+// @Summary ImportProducts
+// @Description Upload a CSV or JSON Lines file of products, rows are upserted by SKU among the products of the store in a background job
+// @Tags product
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "CSV (sku,name,price,inventory,attributes) or JSON Lines file"
+// @Param format formData string false "csv or jsonl, default from file extension"
+// @Success 200 {object} dto.ImportProductsOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /products/import [post]
+func (h *ProductHandler) ImportProducts(c *gin.Context) {
+
+	// Parse from gin.context form to request dto
+	var req dto.ImportProductsInput
+	fileHeader, err := c.FormFile("file")
 	if err != nil {
 		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
 	}
-	pageSize, err := getQueryInt(c, "page_size", 10)
+	if fileHeader.Size > maxImportFileSize {
+		h.Logger.Warn("ProductHandler invalid request, import file too large", zap.Int64("size", fileHeader.Size))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: fmt.Sprintf("File must be at most %d bytes", maxImportFileSize)})
+		return
+	}
+	file, err := fileHeader.Open()
 	if err != nil {
 		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	defer file.Close()
+	req.Data, err = io.ReadAll(file)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.Format = c.PostForm("format")
+	if req.Format == "" {
+		req.Format = strings.TrimPrefix(strings.ToLower(filepath.Ext(fileHeader.Filename)), ".")
+	}
+
+	// Get SellerID
+	req.SellerID, err = getUserID(c)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
 	}
-	req.Page = uint64(page)
-	req.PageSize = uint64(pageSize)
 
 	// Get response and parse to json
-	res, err := h.Service.GetProducts(&req)
+	res, err := h.Service.ImportProducts(&req)
 	if err != nil {
-		h.Logger.Warn("ProductHandler: GetProducts warn", zap.Error(err))
+		h.Logger.Warn("ProductHandler: ImportProducts warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetImportJob is responsible for parse get import job gin.context request
+// GetImportJob godoc
+// @Summary GetImportJob
+// @Description Get status and row errors of a product import job
+// @Tags product
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param job_id path integer true "Import job ID"
+// @Success 200 {object} dto.GetImportJobOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /products/import/{job_id} [get]
+func (h *ProductHandler) GetImportJob(c *gin.Context) {
+
+	// Parse from gin.context json to request dto
+	var req dto.GetImportJobInput
+
+	// Get ID
+	jobIDStr := c.Param("job_id")
+	jobIDUint, err := strconv.ParseUint(jobIDStr, 10, 64)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.JobID = jobIDUint
+	req.SellerID, err = getUserID(c)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+
+	// Get response and parse to json
+	res, err := h.Service.GetImportJob(&req)
+	if err != nil {
+		h.Logger.Warn("ProductHandler: GetImportJob warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// ExportProducts is responsible for parse export products gin.context request
+// ExportProducts godoc
+// @Summary ExportProducts
+// @Description Download all products of the store of the seller as CSV or JSON Lines, in the import format
+// @Tags product
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Security BearerAuth
+// @Param format query string false "csv or jsonl, default csv"
+// @Success 200 {file} file
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /products/export [get]
+func (h *ProductHandler) ExportProducts(c *gin.Context) {
+
+	// Parse from gin.context query to request dto
+	var req dto.ExportProductsInput
+	req.Format = c.DefaultQuery("format", "csv")
+	contentType, ok := exportContentTypes[req.Format]
+	if !ok {
+		h.Logger.Warn("ProductHandler invalid request, unsupported export format", zap.String("format", req.Format))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Format must be csv or jsonl"})
+		return
+	}
+	sellerID, err := getUserID(c)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.SellerID = sellerID
+
+	// Stream each chunk to the response, headers are sent with the first chunk
+	started := false
+	write := func(data []byte) error {
+		if !started {
+			started = true
+			c.Header("Content-Type", contentType)
+			c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=products.%s", req.Format))
+			c.Status(http.StatusOK)
+		}
+		if _, err := c.Writer.Write(data); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	}
+	if err := h.Service.ExportProducts(&req, write); err != nil {
+		h.Logger.Warn("ProductHandler: ExportProducts warn", zap.Error(err))
+		if started {
+			return
+		}
+		code := http.StatusInternalServerError
+		if status.Code(err) == codes.PermissionDenied {
+			code = http.StatusForbidden
+		}
+		c.JSON(code, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	if !started {
+		_ = write(nil)
+	}
+}
+
+// AdjustInventory is responsible for parse adjust inventory gin.context request
+// AdjustInventory godoc
+// @Summary AdjustInventory
+// @Description Add (positive quantity) or remove (negative quantity) stock of a product, recorded in the inventory ledger with its reason
+// @Tags product
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Product ID"
+// @Param request body dto.AdjustInventoryInput true "Quantity and reason of the adjustment"
+// @Success 200 {object} dto.AdjustInventoryOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /products/{id}/inventory/adjust [post]
+func (h *ProductHandler) AdjustInventory(c *gin.Context) {
+
+	// Parse from gin.context json to request dto
+	var req dto.AdjustInventoryInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+
+	// Get ID
+	idStr := c.Param("id")
+	idUint, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.ProductID = idUint
+	req.UserID, err = getUserID(c)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+
+	// Get response and parse to json
+	res, err := h.Service.AdjustInventory(&req)
+	if err != nil {
+		h.Logger.Warn("ProductHandler: AdjustInventory warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetInventoryMovements is responsible for parse get inventory movements gin.context request
+// GetInventoryMovements godoc
+// @Summary GetInventoryMovements
+// @Description Get inventory ledger of the products of the store of the user, newest first
+// @Tags product
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param product_id query integer false "Only movements of this product"
+// @Param type query string false "INITIAL_STOCK, PRODUCT_UPDATE, ORDER_RESERVATION, ORDER_CANCELLATION, ORDER_RETURN, MANUAL_ADJUSTMENT or IMPORT"
+// @Param page query integer false "Page, default 1"
+// @Param page_size query integer false "Page size, default 20"
+// @Success 200 {object} dto.GetInventoryMovementsOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /products/inventory/movements [get]
+func (h *ProductHandler) GetInventoryMovements(c *gin.Context) {
+
+	// Parse from gin.context query to request dto
+	var req dto.GetInventoryMovementsInput
+	productID, err := getQueryInt(c, "product_id", 0)
+	if err != nil || productID < 0 {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid product_id"})
+		return
+	}
+	page, err := getQueryInt(c, "page", 1)
+	if err != nil || page < 1 {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid page"})
+		return
+	}
+	pageSize, err := getQueryInt(c, "page_size", 20)
+	if err != nil || pageSize < 1 {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid page_size"})
+		return
+	}
+	req.ProductID = uint64(productID)
+	req.Type = c.Query("type")
+	req.Page = uint64(page)
+	req.PageSize = uint64(pageSize)
+	req.SellerID, err = getUserID(c)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+
+	// Get response and parse to json
+	res, err := h.Service.GetInventoryMovements(&req)
+	if err != nil {
+		h.Logger.Warn("ProductHandler: GetInventoryMovements warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// CreateCategory is responsible for parse create category gin.context request
+// CreateCategory godoc
+// @Summary CreateCategory
+// @Description Create category with the attribute schema of its products, admin only
+// @Tags category
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateCategoryInput true "Category DTO to create"
+// @Security BearerAuth
+// @Success 200 {object} dto.CreateCategoryOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.CreateCategoryOutput
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /admin/categories [post]
+func (h *ProductHandler) CreateCategory(c *gin.Context) {
+
+	// Parse from gin.context json to request dto
+	var req dto.CreateCategoryInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+
+	// Get response and parse to json
+	res, err := h.Service.CreateCategory(&req)
+	if err != nil {
+		h.Logger.Warn("ProductHandler: CreateCategory warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	if len(res.FieldErrors) > 0 {
+		c.JSON(http.StatusUnprocessableEntity, res)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// UpdateCategory is responsible for parse update category gin.context request
+// UpdateCategory godoc
+// @Summary UpdateCategory
+// @Description Rename category and replace its attribute schema, admin only. Existing products are validated on their next change
+// @Tags category
+// @Accept json
+// @Produce json
+// @Param request body dto.UpdateCategoryInput true "Category DTO to update"
+// @Security BearerAuth
+// @Param id path integer true "Category ID"
+// @Success 200 {object} dto.UpdateCategoryOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.UpdateCategoryOutput
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /admin/categories/{id} [put]
+func (h *ProductHandler) UpdateCategory(c *gin.Context) {
+
+	// Parse from gin.context json to request dto
+	var req dto.UpdateCategoryInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+
+	// Get ID
+	idStr := c.Param("id")
+	idUint, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.ID = idUint
+
+	// Get response and parse to json
+	res, err := h.Service.UpdateCategory(&req)
+	if err != nil {
+		h.Logger.Warn("ProductHandler: UpdateCategory warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	if len(res.FieldErrors) > 0 {
+		c.JSON(http.StatusUnprocessableEntity, res)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// DeleteCategory is responsible for parse delete category gin.context request
+// DeleteCategory godoc
+// @Summary DeleteCategory
+// @Description Delete a category without products, admin only
+// @Tags category
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Category ID"
+// @Success 200 {object} dto.DeleteCategoryOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /admin/categories/{id} [delete]
+func (h *ProductHandler) DeleteCategory(c *gin.Context) {
+
+	// Parse from gin.context json to request dto
+	var req dto.DeleteCategoryInput
+
+	// Get ID
+	idStr := c.Param("id")
+	idUint, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.ID = idUint
+
+	// Get response and parse to json
+	res, err := h.Service.DeleteCategory(&req)
+	if err != nil {
+		h.Logger.Warn("ProductHandler: DeleteCategory warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetCategoryByID is responsible for parse get category by id gin.context request
+// GetCategoryByID godoc
+// @Summary GetCategoryByID
+// @Description Get category with its attribute schema
+// @Tags category
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Category ID"
+// @Success 200 {object} dto.GetCategoryByIDOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /categories/{id} [get]
+func (h *ProductHandler) GetCategoryByID(c *gin.Context) {
+
+	// Parse from gin.context json to request dto
+	var req dto.GetCategoryByIDInput
+
+	// Get ID
+	idStr := c.Param("id")
+	idUint, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.ID = idUint
+
+	// Get response and parse to json
+	res, err := h.Service.GetCategoryByID(&req)
+	if err != nil {
+		h.Logger.Warn("ProductHandler: GetCategoryByID warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetCategories is responsible for parse get categories gin.context request
+// GetCategories godoc
+// @Summary GetCategories
+// @Description Get all categories with their attribute schemas
+// @Tags category
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.GetCategoriesOutput
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /categories [get]
+func (h *ProductHandler) GetCategories(c *gin.Context) {
+
+	// Get response and parse to json
+	res, err := h.Service.GetCategories(&dto.GetCategoriesInput{})
+	if err != nil {
+		h.Logger.Warn("ProductHandler: GetCategories warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetExchangeRates is responsible for parse get exchange rates gin.context request
+// GetExchangeRates godoc
+// @Summary GetExchangeRates
+// @Description Get exchange rates used for product prices and orders, as units of each currency for one unit of base
+// @Tags product
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.GetExchangeRatesOutput
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /exchange-rates [get]
+func (h *ProductHandler) GetExchangeRates(c *gin.Context) {
+
+	// Get response and parse to json
+	res, err := h.Service.GetCachedExchangeRates()
+	if err != nil {
+		h.Logger.Warn("ProductHandler: GetExchangeRates warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetRelatedProducts is responsible for parse get related products gin.context request
+// GetRelatedProducts godoc
+// @Summary GetRelatedProducts
+// @Description Customers also bought: products often ordered together with the product, then popular products of its category
+// @Tags product
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Product ID"
+// @Param limit query integer false "Max products, default 10, at most 50"
+// @Param currency query string false "ISO 4217 currency to also show prices in, e.g. USD"
+// @Success 200 {object} dto.GetRelatedProductsOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /products/{id}/also-bought [get]
+func (h *ProductHandler) GetRelatedProducts(c *gin.Context) {
+
+	// Parse from gin.context query to request dto
+	var req dto.GetRelatedProductsInput
+
+	// Get ID
+	idStr := c.Param("id")
+	idUint, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.ProductID = idUint
+	limit, err := getQueryInt(c, "limit", 10)
+	if err != nil || limit < 1 || limit > 50 {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid limit"})
+		return
+	}
+	req.Limit = uint64(limit)
+
+	// Get response and parse to json
+	res, err := h.Service.GetRelatedProducts(&req)
+	if err != nil {
+		h.Logger.Warn("ProductHandler: GetRelatedProducts warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	if !h.convertPrices(c, res.Products...) {
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// AskQuestion is responsible for parse ask question gin.context request
+// AskQuestion godoc
+// @Summary AskQuestion
+// @Description Ask the seller a question about an active product
+// @Tags question
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Product ID"
+// @Param request body dto.AskQuestionInput true "Question"
+// @Success 200 {object} dto.AskQuestionOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /products/{id}/questions [post]
+func (h *ProductHandler) AskQuestion(c *gin.Context) {
+
+	// Parse from gin.context json to request dto
+	var req dto.AskQuestionInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+
+	// Get ID
+	idStr := c.Param("id")
+	idUint, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.ProductID = idUint
+	req.BuyerID, err = getUserID(c)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+
+	// Get response and parse to json
+	res, err := h.Service.AskQuestion(&req)
+	if err != nil {
+		h.Logger.Warn("ProductHandler: AskQuestion warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// AnswerQuestion is responsible for parse answer question gin.context request
+// AnswerQuestion godoc
+// @Summary AnswerQuestion
+// @Description Answer a question about a product of the store, the buyer is notified
+// @Tags question
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Question ID"
+// @Param request body dto.AnswerQuestionInput true "Answer"
+// @Success 200 {object} dto.AnswerQuestionOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /products/questions/{id}/answers [post]
+func (h *ProductHandler) AnswerQuestion(c *gin.Context) {
+
+	// Parse from gin.context json to request dto
+	var req dto.AnswerQuestionInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+
+	// Get ID
+	idStr := c.Param("id")
+	idUint, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.QuestionID = idUint
+	req.UserID, err = getUserID(c)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+
+	// Get response and parse to json
+	res, err := h.Service.AnswerQuestion(&req)
+	if err != nil {
+		h.Logger.Warn("ProductHandler: AnswerQuestion warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetProductQuestions is responsible for parse get product questions gin.context request
+// GetProductQuestions godoc
+// @Summary GetProductQuestions
+// @Description Get questions of a product with their answers, newest question first. Hidden questions and answers are left out
+// @Tags question
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Product ID"
+// @Param page query integer false "Page, default 1"
+// @Param page_size query integer false "Page size, default 20"
+// @Success 200 {object} dto.GetProductQuestionsOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /products/{id}/questions [get]
+func (h *ProductHandler) GetProductQuestions(c *gin.Context) {
+
+	// Parse from gin.context query to request dto
+	var req dto.GetProductQuestionsInput
+
+	// Get ID
+	idStr := c.Param("id")
+	idUint, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.ProductID = idUint
+	page, err := getQueryInt(c, "page", 1)
+	if err != nil || page < 1 {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid page"})
+		return
+	}
+	pageSize, err := getQueryInt(c, "page_size", 20)
+	if err != nil || pageSize < 1 || pageSize > 100 {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid page_size"})
+		return
+	}
+	req.Page = uint64(page)
+	req.PageSize = uint64(pageSize)
+
+	// Get response and parse to json
+	res, err := h.Service.GetProductQuestions(&req)
+	if err != nil {
+		h.Logger.Warn("ProductHandler: GetProductQuestions warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// FlagQuestion is responsible for parse flag question gin.context request
+// FlagQuestion godoc
+// @Summary FlagQuestion
+// @Description Report a question, after 3 reports it joins the moderation queue and stays listed until a moderator hides it. Buyers only
+// @Tags question
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Question ID"
+// @Param request body dto.FlagQAInput false "Reason"
+// @Success 200 {object} dto.FlagQAOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /products/questions/{id}/flag [post]
+func (h *ProductHandler) FlagQuestion(c *gin.Context) {
+	h.flagQA(c, "QUESTION")
+}
+
+// FlagAnswer is responsible for parse flag answer gin.context request
+// FlagAnswer godoc
+// @Summary FlagAnswer
+// @Description Report an answer, after 3 reports it joins the moderation queue and stays listed until a moderator hides it. Buyers only
+// @Tags question
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Answer ID"
+// @Param request body dto.FlagQAInput false "Reason"
+// @Success 200 {object} dto.FlagQAOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /products/answers/{id}/flag [post]
+func (h *ProductHandler) FlagAnswer(c *gin.Context) {
+	h.flagQA(c, "ANSWER")
+}
+
+func (h *ProductHandler) flagQA(c *gin.Context, targetType string) {
+
+	// Parse from gin.context json to request dto, the body is optional
+	var req dto.FlagQAInput
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+			return
+		}
+	}
+
+	// Get ID
+	idStr := c.Param("id")
+	idUint, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.TargetType = targetType
+	req.TargetID = idUint
+	req.UserID, err = getUserID(c)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+
+	// Get response and parse to json
+	res, err := h.Service.FlagQA(&req)
+	if err != nil {
+		h.Logger.Warn("ProductHandler: FlagQA warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// ModerateQuestion is responsible for parse moderate question gin.context request
+// ModerateQuestion godoc
+// @Summary ModerateQuestion
+// @Description Hide a question or make it visible, either way its reports are cleared and it leaves the moderation queue, admin only
+// @Tags question
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Question ID"
+// @Param request body dto.ModerateQAInput true "New status"
+// @Success 200 {object} dto.ModerateQAOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /admin/questions/{id} [put]
+func (h *ProductHandler) ModerateQuestion(c *gin.Context) {
+	h.moderateQA(c, "QUESTION")
+}
+
+// ModerateAnswer is responsible for parse moderate answer gin.context request
+// ModerateAnswer godoc
+// @Summary ModerateAnswer
+// @Description Hide an answer or make it visible, either way its reports are cleared and it leaves the moderation queue, admin only
+// @Tags question
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path integer true "Answer ID"
+// @Param request body dto.ModerateQAInput true "New status"
+// @Success 200 {object} dto.ModerateQAOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /admin/answers/{id} [put]
+func (h *ProductHandler) ModerateAnswer(c *gin.Context) {
+	h.moderateQA(c, "ANSWER")
+}
+
+func (h *ProductHandler) moderateQA(c *gin.Context, targetType string) {
+
+	// Parse from gin.context json to request dto
+	var req dto.ModerateQAInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+
+	// Get ID
+	idStr := c.Param("id")
+	idUint, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.TargetType = targetType
+	req.TargetID = idUint
+
+	// Get response and parse to json
+	res, err := h.Service.ModerateQA(&req)
+	if err != nil {
+		h.Logger.Warn("ProductHandler: ModerateQA warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetFlaggedQuestions is responsible for parse get flagged questions gin.context request
+// GetFlaggedQuestions godoc
+// @Summary GetFlaggedQuestions
+// @Description Get the moderation queue of questions, reported 3 times or more and not reviewed yet, most reported first, admin only
+// @Tags question
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query integer false "Page, default 1"
+// @Param page_size query integer false "Page size, default 20"
+// @Success 200 {object} dto.GetFlaggedQAOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /admin/questions/flagged [get]
+func (h *ProductHandler) GetFlaggedQuestions(c *gin.Context) {
+	h.getFlaggedQA(c, "QUESTION")
+}
+
+// GetFlaggedAnswers is responsible for parse get flagged answers gin.context request
+// GetFlaggedAnswers godoc
+// @Summary GetFlaggedAnswers
+// @Description Get the moderation queue of answers, reported 3 times or more and not reviewed yet, most reported first, admin only
+// @Tags question
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query integer false "Page, default 1"
+// @Param page_size query integer false "Page size, default 20"
+// @Success 200 {object} dto.GetFlaggedQAOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /admin/answers/flagged [get]
+func (h *ProductHandler) GetFlaggedAnswers(c *gin.Context) {
+	h.getFlaggedQA(c, "ANSWER")
+}
+
+func (h *ProductHandler) getFlaggedQA(c *gin.Context, targetType string) {
+
+	// Parse from gin.context query to request dto
+	req := dto.GetFlaggedQAInput{TargetType: targetType}
+	page, err := getQueryInt(c, "page", 1)
+	if err != nil || page < 1 {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid page"})
+		return
+	}
+	pageSize, err := getQueryInt(c, "page_size", 20)
+	if err != nil || pageSize < 1 || pageSize > 100 {
+		h.Logger.Warn("ProductHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid page_size"})
+		return
+	}
+	req.Page = uint64(page)
+	req.PageSize = uint64(pageSize)
+
+	// Get response and parse to json
+	res, err := h.Service.GetFlaggedQA(&req)
+	if err != nil {
+		h.Logger.Warn("ProductHandler: GetFlaggedQA warn", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
 		return
 	}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+func TestGetFlaggedQARejectsInvalidPage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h := &ProductHandler{Logger: zap.NewNop()}
+	router := gin.New()
+	router.GET("/admin/questions/flagged", h.GetFlaggedQuestions)
+	router.GET("/admin/answers/flagged", h.GetFlaggedAnswers)
+
+	for _, url := range []string{
+		"/admin/questions/flagged?page=0",
+		"/admin/questions/flagged?page=x",
+		"/admin/answers/flagged?page_size=0",
+		"/admin/answers/flagged?page_size=101",
+	} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("GET %s status = %d, want %d", url, w.Code, http.StatusBadRequest)
+		}
+	}
+}
@@ -349,3 +349,252 @@ func (h *UserHandler) DelSellerByID(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, res)
 }
+
+// AddWishlistItem is responsible for parse add wishlist item gin.context request
+// AddWishlistItem godoc
+// @Summary AddWishlistItem
+// @Description Save an active product to wishlist of the buyer
+// @Tags wishlist
+// @Accept json
+// @Produce json
+// @Param request body dto.AddWishlistItemInput true "Product to save"
+// @Security BearerAuth
+// @Success 200 {object} dto.AddWishlistItemOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /users/buyers/wishlist [post]
+func (h *UserHandler) AddWishlistItem(c *gin.Context) {
+
+	// Parse from gin.context json to request dto
+	var req dto.AddWishlistItemInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Logger.Warn("UserHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	userID, err := getUserID(c)
+	if err != nil {
+		h.Logger.Warn("UserHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.UserID = userID
+
+	// Get response and parse to json
+	res, err := h.Service.AddWishlistItem(&req)
+	if err != nil {
+		h.Logger.Warn("UserHandler: AddWishlistItem warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// RemoveWishlistItem is responsible for parse remove wishlist item gin.context request
+// RemoveWishlistItem godoc
+// @Summary RemoveWishlistItem
+// @Description Remove a product from wishlist of the buyer
+// @Tags wishlist
+// @Accept json
+// @Produce json
+// @Param product_id path integer true "Product ID to remove"
+// @Security BearerAuth
+// @Success 200 {object} dto.RemoveWishlistItemOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /users/buyers/wishlist/{product_id} [delete]
+func (h *UserHandler) RemoveWishlistItem(c *gin.Context) {
+
+	// Parse from gin.context param to request dto
+	var req dto.RemoveWishlistItemInput
+	productID, err := strconv.ParseUint(c.Param("product_id"), 10, 64)
+	if err != nil {
+		h.Logger.Warn("UserHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.ProductID = productID
+	req.UserID, err = getUserID(c)
+	if err != nil {
+		h.Logger.Warn("UserHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+
+	// Get response and parse to json
+	res, err := h.Service.RemoveWishlistItem(&req)
+	if err != nil {
+		h.Logger.Warn("UserHandler: RemoveWishlistItem warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetWishlist is responsible for parse get wishlist gin.context request
+// GetWishlist godoc
+// @Summary GetWishlist
+// @Description Get wishlist of the buyer with product data, newest first
+// @Tags wishlist
+// @Accept json
+// @Produce json
+// @Param page query integer false "Page, default 1"
+// @Param page_size query integer false "Page size, default 20"
+// @Security BearerAuth
+// @Success 200 {object} dto.GetWishlistOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /users/buyers/wishlist [get]
+func (h *UserHandler) GetWishlist(c *gin.Context) {
+
+	// Parse from gin.context query to request dto
+	var req dto.GetWishlistInput
+	page, pageSize, ok := h.getPage(c)
+	if !ok {
+		return
+	}
+	req.Page = page
+	req.PageSize = pageSize
+	userID, err := getUserID(c)
+	if err != nil {
+		h.Logger.Warn("UserHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.UserID = userID
+
+	// Get response and parse to json
+	res, err := h.Service.GetWishlist(&req)
+	if err != nil {
+		h.Logger.Warn("UserHandler: GetWishlist warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// ShareWishlist is responsible for parse share wishlist gin.context request
+// ShareWishlist godoc
+// @Summary ShareWishlist
+// @Description Get the public token of wishlist of the buyer, the same token is returned until it is revoked
+// @Tags wishlist
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.ShareWishlistOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /users/buyers/wishlist/share [post]
+func (h *UserHandler) ShareWishlist(c *gin.Context) {
+
+	// Get UserID from token
+	var req dto.ShareWishlistInput
+	userID, err := getUserID(c)
+	if err != nil {
+		h.Logger.Warn("UserHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.UserID = userID
+
+	// Get response and parse to json
+	res, err := h.Service.ShareWishlist(&req)
+	if err != nil {
+		h.Logger.Warn("UserHandler: ShareWishlist warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// UnshareWishlist is responsible for parse unshare wishlist gin.context request
+// UnshareWishlist godoc
+// @Summary UnshareWishlist
+// @Description Revoke the public token of wishlist of the buyer
+// @Tags wishlist
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.UnshareWishlistOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /users/buyers/wishlist/share [delete]
+func (h *UserHandler) UnshareWishlist(c *gin.Context) {
+
+	// Get UserID from token
+	var req dto.UnshareWishlistInput
+	userID, err := getUserID(c)
+	if err != nil {
+		h.Logger.Warn("UserHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	req.UserID = userID
+
+	// Get response and parse to json
+	res, err := h.Service.UnshareWishlist(&req)
+	if err != nil {
+		h.Logger.Warn("UserHandler: UnshareWishlist warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetSharedWishlist is responsible for parse get shared wishlist gin.context request
+// GetSharedWishlist godoc
+// @Summary GetSharedWishlist
+// @Description Get a wishlist shared by a buyer, no login required
+// @Tags wishlist
+// @Accept json
+// @Produce json
+// @Param token path string true "Share token"
+// @Param page query integer false "Page, default 1"
+// @Param page_size query integer false "Page size, default 20"
+// @Success 200 {object} dto.GetSharedWishlistOutput
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.GetSharedWishlistOutput
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /wishlists/shared/{token} [get]
+func (h *UserHandler) GetSharedWishlist(c *gin.Context) {
+
+	// Parse from gin.context param and query to request dto
+	var req dto.GetSharedWishlistInput
+	page, pageSize, ok := h.getPage(c)
+	if !ok {
+		return
+	}
+	req.Token = c.Param("token")
+	req.Page = page
+	req.PageSize = pageSize
+
+	// Get response and parse to json
+	res, err := h.Service.GetSharedWishlist(&req)
+	if err != nil {
+		h.Logger.Warn("UserHandler: GetSharedWishlist warn", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: GetErrorString(err.Error())})
+		return
+	}
+	if !res.Success {
+		c.JSON(http.StatusNotFound, res)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// getPage get page and page_size query, it writes 400 and returns false when they are invalid
+func (h *UserHandler) getPage(c *gin.Context) (uint64, uint64, bool) {
+	page, err := getQueryInt(c, "page", 1)
+	if err != nil || page < 1 {
+		h.Logger.Warn("UserHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid page"})
+		return 0, 0, false
+	}
+	pageSize, err := getQueryInt(c, "page_size", 20)
+	if err != nil || pageSize < 1 || pageSize > 100 {
+		h.Logger.Warn("UserHandler invalid request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid page_size"})
+		return 0, 0, false
+	}
+	return uint64(page), uint64(pageSize), true
+}
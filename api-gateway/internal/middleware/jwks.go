@@ -0,0 +1,151 @@
+package middleware
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// jwksCacheTTL is how long fetched keys are used before the JWKS is fetched again
+	jwksCacheTTL = 5 * time.Minute
+
+	// jwksMinRefetch is the least time between two fetches caused by an unknown kid,
+	// so tokens with made up kids can not flood auth-service
+	jwksMinRefetch = 10 * time.Second
+)
+
+// JWKS verify tokens with the public keys published by auth-service, no secret is shared with the gateway.
+// Keys are fetched on first use, again after jwksCacheTTL, and when a token has a kid of a key rotated in meanwhile.
+// A fetch is shared by the requests waiting for it and does not hold the cache, so requests with cached keys go on.
+type JWKS struct {
+	URL    string
+	Client *http.Client
+	Logger *zap.Logger
+
+	fetches   singleflight.Group
+	mu        sync.Mutex
+	keys      map[string]ed25519.PublicKey
+	fetchedAt time.Time
+}
+
+// NewJWKS create new JWKS for the JWKS endpoint at url
+func NewJWKS(url string, logger *zap.Logger) *JWKS {
+	return &JWKS{
+		URL:    url,
+		Client: &http.Client{Timeout: 5 * time.Second},
+		Logger: logger,
+	}
+}
+
+// Keyfunc return the public key of the kid of token, it is passed to jwt.ParseWithClaims
+func (j *JWKS) Keyfunc(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodEd25519); !ok {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return nil, fmt.Errorf("token without kid")
+	}
+	return j.key(context.Background(), kid)
+}
+
+// key return the cached key of kid, the JWKS is fetched when the cache is stale or does not have kid
+func (j *JWKS) key(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+	j.mu.Lock()
+	key, ok := j.keys[kid]
+	age := time.Since(j.fetchedAt)
+	fetched := j.keys != nil
+	j.mu.Unlock()
+	if ok && age < jwksCacheTTL {
+		return key, nil
+	}
+	if !ok && fetched && age < jwksMinRefetch {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	_, err, _ := j.fetches.Do(j.URL, func() (interface{}, error) {
+		return nil, j.refresh(ctx)
+	})
+	if err != nil {
+		// Keep verifying with the cached keys while auth-service is unreachable
+		j.Logger.Warn("Middleware: fetch JWKS failure", zap.String("url", j.URL), zap.Error(err))
+		if ok {
+			return key, nil
+		}
+		return nil, err
+	}
+
+	j.mu.Lock()
+	key, ok = j.keys[kid]
+	j.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+// refresh fetch the JWKS and replace the cached keys
+func (j *JWKS) refresh(ctx context.Context) error {
+	keys, err := j.fetch(ctx)
+	if err != nil {
+		return err
+	}
+	j.mu.Lock()
+	j.keys = keys
+	j.fetchedAt = time.Now()
+	j.mu.Unlock()
+	return nil
+}
+
+// fetch get the Ed25519 keys of the JWKS endpoint
+func (j *JWKS) fetch(ctx context.Context) (map[string]ed25519.PublicKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, j.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := j.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("JWKS endpoint returned %d", res.StatusCode)
+	}
+
+	var jwks struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Crv string `json:"crv"`
+			Kid string `json:"kid"`
+			X   string `json:"x"`
+		} `json:"keys"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&jwks); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]ed25519.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Kty != "OKP" || jwk.Crv != "Ed25519" {
+			continue
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			j.Logger.Warn("Middleware: invalid key in JWKS", zap.String("kid", jwk.Kid))
+			continue
+		}
+		keys[jwk.Kid] = ed25519.PublicKey(x)
+	}
+	return keys, nil
+}
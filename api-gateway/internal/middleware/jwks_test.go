@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// newTestJWKS serve a JWKS of one key named kid, each fetch waits for release when it is not nil
+func newTestJWKS(t *testing.T, kid string, release chan struct{}) (*JWKS, ed25519.PublicKey, *atomic.Int32) {
+	t.Helper()
+	public, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fetches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		if release != nil {
+			<-release
+		}
+		json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "OKP",
+			"crv": "Ed25519",
+			"kid": kid,
+			"x":   base64.RawURLEncoding.EncodeToString(public),
+		}}})
+	}))
+	t.Cleanup(server.Close)
+	return NewJWKS(server.URL, zap.NewNop()), public, &fetches
+}
+
+func TestJWKSKey(t *testing.T) {
+	j, public, fetches := newTestJWKS(t, "key-1", nil)
+	ctx := context.Background()
+
+	key, err := j.key(ctx, "key-1")
+	if err != nil || !key.Equal(public) {
+		t.Fatalf("key() = %v, %v, want the served key", key, err)
+	}
+	if _, err := j.key(ctx, "key-1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := fetches.Load(); got != 1 {
+		t.Errorf("fetches = %d, want 1 for a cached key", got)
+	}
+
+	// An unknown kid right after a fetch is refused without fetching again
+	if _, err := j.key(ctx, "key-2"); err == nil {
+		t.Error("key() of unknown kid succeeded")
+	}
+	if got := fetches.Load(); got != 1 {
+		t.Errorf("fetches = %d, want 1 within jwksMinRefetch", got)
+	}
+}
+
+func TestJWKSFetchDoesNotBlockCachedKeys(t *testing.T) {
+	release := make(chan struct{})
+	j, _, fetches := newTestJWKS(t, "key-2", release)
+	cached, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	j.keys = map[string]ed25519.PublicKey{"key-1": cached}
+	j.fetchedAt = time.Now().Add(-jwksMinRefetch)
+
+	// Requests with the rotated kid wait for one shared fetch
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := j.key(context.Background(), "key-2")
+			errs <- err
+		}()
+	}
+	for fetches.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// Meanwhile requests with the cached kid are served
+	done := make(chan error, 1)
+	go func() {
+		_, err := j.key(context.Background(), "key-1")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("key() of cached kid error = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Error("key() of cached kid waited for the fetch")
+	}
+
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("key() of rotated kid error = %v", err)
+		}
+	}
+	if got := fetches.Load(); got != 1 {
+		t.Errorf("fetches = %d, want 1 shared fetch", got)
+	}
+}
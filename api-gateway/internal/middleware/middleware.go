@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -15,14 +16,28 @@ import (
 )
 
 type UserClaims struct {
-	UserID     uint64
-	Username   string
-	Role       string
-	PwdVersion int64
-	Type       string
+	UserID      uint64
+	Username    string
+	Role        string
+	Permissions []string // permissions of Role, resolved by auth-service when the token was issued
+	PwdVersion  int64
+	Type        string
+	FamilyID    string
 	jwt.RegisteredClaims
 }
 
+// isTokenRevoked check the denylist filled from auth.revoke_token events for the jti and session of claims,
+// a logout of all sessions revokes each of them
+func isTokenRevoked(ctx context.Context, redisClient *redis.Client, claims *UserClaims) (bool, error) {
+	pipe := redisClient.Pipeline()
+	revokedJTI := pipe.Exists(ctx, fmt.Sprintf("revoked_jti:%s", claims.ID))
+	revokedFamily := pipe.Exists(ctx, fmt.Sprintf("revoked_family:%s", claims.FamilyID))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, err
+	}
+	return (claims.ID != "" && revokedJTI.Val() > 0) || (claims.FamilyID != "" && revokedFamily.Val() > 0), nil
+}
+
 // RequestLoggingMiddleware write logs for middleware
 func RequestLoggingMiddleware(logger *zap.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -98,7 +113,7 @@ func RateLimitingMiddleware(limit int, period time.Duration, logger *zap.Logger,
 }
 
 // AuthMiddleware solve problem about jwt
-func AuthMiddleware(logger *zap.Logger, redisClient *redis.Client, jwtSecret string) gin.HandlerFunc {
+func AuthMiddleware(logger *zap.Logger, redisClient *redis.Client, jwks *JWKS) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -117,26 +132,42 @@ func AuthMiddleware(logger *zap.Logger, redisClient *redis.Client, jwtSecret str
 		}
 
 		tokenString := parts[1]
-		token, err := jwt.ParseWithClaims(tokenString, &UserClaims{}, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				logger.Error("Middleware: error unexpected signing method",
-					zap.String("alg", token.Header["alg"].(string)),
-				)
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			}
-			return []byte(jwtSecret), nil
-		})
+		token, err := jwt.ParseWithClaims(tokenString, &UserClaims{}, jwks.Keyfunc)
 
 		if err != nil {
-			logger.Warn("Middleware: warn invalid or expired token")
+			logger.Warn("Middleware: warn invalid or expired token", zap.Error(err))
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
 			c.Abort()
 			return
 		}
 
 		if claims, ok := token.Claims.(*UserClaims); ok && token.Valid {
+			if claims.Type != "access" {
+				logger.Warn("Middleware: warn token is not an access token", zap.String("type", claims.Type))
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Access token required"})
+				c.Abort()
+				return
+			}
+
+			// Check logout
+			revoked, err := isTokenRevoked(c.Request.Context(), redisClient, claims)
+			if err != nil {
+				logger.Warn("Middleware: error checking token revocation", zap.Error(err))
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Error checking token revocation"})
+				c.Abort()
+				return
+			}
+			if revoked {
+				logger.Warn("Middleware: warn revoked token", zap.Uint64("userID", claims.UserID))
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Token has been revoked"})
+				c.Abort()
+				return
+			}
+
 			c.Set("userID", claims.UserID)
 			c.Set("userRole", claims.Role)
+			c.Set("userPermissions", claims.Permissions)
+			c.Set("accessToken", tokenString)
 
 			// Check logic for change password
 			keyPwdVersion := fmt.Sprintf("%d:pwd_version", claims.UserID)
@@ -178,27 +209,33 @@ func AuthMiddleware(logger *zap.Logger, redisClient *redis.Client, jwtSecret str
 	}
 }
 
-// AuthorizationMiddleware solve problem about role user
-func AuthorizationMiddleware(requiredRoles []string, logger *zap.Logger) gin.HandlerFunc {
+// OptionalAuthMiddleware authenticate a request with an Authorization header like AuthMiddleware, and let
+// a request without one through anonymously
+func OptionalAuthMiddleware(logger *zap.Logger, redisClient *redis.Client, jwks *JWKS) gin.HandlerFunc {
+	auth := AuthMiddleware(logger, redisClient, jwks)
 	return func(c *gin.Context) {
-		userRole, exist := c.Get("userRole")
-		if !exist {
-			logger.Warn("Middleware: warn role is required")
-			c.JSON(http.StatusForbidden, gin.H{"error": "Role is required"})
-			c.Abort()
+		if c.GetHeader("Authorization") == "" {
+			c.Next()
 			return
 		}
+		auth(c)
+	}
+}
 
-		hasPermission := false
-		for _, role := range requiredRoles {
-			if role == userRole {
-				hasPermission = true
-				break
-			}
+// PermissionMiddleware allow only users whose access token grants permission
+func PermissionMiddleware(permission string, logger *zap.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, exist := c.Get("userPermissions")
+		if !exist {
+			logger.Warn("Middleware: warn permissions are required")
+			c.JSON(http.StatusForbidden, gin.H{"error": "Permission is required"})
+			c.Abort()
+			return
 		}
 
-		if !hasPermission {
-			logger.Warn("Middleware: warn role does not have permission")
+		permissions, _ := value.([]string)
+		if !slices.Contains(permissions, permission) {
+			logger.Warn("Middleware: warn user does not have permission", zap.String("permission", permission))
 			c.JSON(http.StatusForbidden, gin.H{"error": "Do not have permission to access"})
 			c.Abort()
 			return
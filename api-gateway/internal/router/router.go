@@ -10,6 +10,16 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// NewEngine create the gin engine, the client IP of rate limits, login throttling and sessions is only
+// taken from X-Forwarded-For when the request comes from one of the trusted proxies
+func NewEngine(envConfig *config.EnvConfig) (*gin.Engine, error) {
+	engine := gin.New()
+	if err := engine.SetTrustedProxies(envConfig.TrustedProxies); err != nil {
+		return nil, err
+	}
+	return engine, nil
+}
+
 // SetupRouter setup middleware, router for engine
 func SetupRouter(router *gin.Engine, h *handler.ManagerHandler, serviceConfig *config.ServiceConfig, envConfig *config.EnvConfig) {
 
@@ -25,47 +35,132 @@ func SetupRouter(router *gin.Engine, h *handler.ManagerHandler, serviceConfig *c
 	router.Use(middleware.RequestLoggingMiddleware(serviceConfig.ZapLogger))
 	// router.Use(middleware.RateLimitingMiddleware(100, time.Minute, serviceConfig.ZapLogger, serviceConfig.RedisClient))
 
+	// Tokens are verified with the public keys of auth-service
+	jwks := middleware.NewJWKS(envConfig.JWKSURL, serviceConfig.ZapLogger)
+
 	authRoute := router.Group("/auth")
 	{
-		authRoute.POST("/login", h.AuthHandler.Login)
+		authRoute.POST("/login", middleware.RateLimitingMiddleware(30, time.Minute, serviceConfig.ZapLogger, serviceConfig.RedisClient), h.AuthHandler.Login)
 		authRoute.POST("/register", h.AuthHandler.Register)
 		authRoute.POST("/change-password", h.AuthHandler.ChangePassword)
 		authRoute.POST("/refresh-token", h.AuthHandler.RefreshToken)
-		authRoute.POST("/register-seller-roles", middleware.AuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, envConfig.JWTSecret),
-			middleware.AuthorizationMiddleware([]string{"seller_admin"}, serviceConfig.ZapLogger),
+		authRoute.POST("/forgot-password", middleware.RateLimitingMiddleware(30, time.Minute, serviceConfig.ZapLogger, serviceConfig.RedisClient), h.AuthHandler.RequestPasswordReset)
+		authRoute.POST("/reset-password", middleware.RateLimitingMiddleware(30, time.Minute, serviceConfig.ZapLogger, serviceConfig.RedisClient), h.AuthHandler.ResetPassword)
+		authRoute.POST("/register-seller-roles", middleware.AuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, jwks),
+			middleware.PermissionMiddleware("staff:write", serviceConfig.ZapLogger),
 			h.AuthHandler.RegisterSellerRoles)
+		authRoute.POST("/logout", middleware.AuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, jwks),
+			h.AuthHandler.Logout)
+		authRoute.POST("/logout-all", middleware.AuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, jwks),
+			h.AuthHandler.LogoutAll)
+		authRoute.GET("/sessions", middleware.AuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, jwks),
+			h.AuthHandler.ListSessions)
+		authRoute.DELETE("/sessions/:id", middleware.AuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, jwks),
+			h.AuthHandler.RevokeSession)
+		authRoute.POST("/unlock-account", middleware.AuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, jwks),
+			middleware.PermissionMiddleware("account:unlock", serviceConfig.ZapLogger),
+			h.AuthHandler.UnlockAccount)
+
+		// Custom roles of a store, staff manage their own store and admins pass store_id
+		authRoute.GET("/store-roles", middleware.AuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, jwks),
+			middleware.PermissionMiddleware("store_role:write", serviceConfig.ZapLogger), h.AuthHandler.ListStoreRoles)
+		authRoute.POST("/store-roles", middleware.AuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, jwks),
+			middleware.PermissionMiddleware("store_role:write", serviceConfig.ZapLogger), h.AuthHandler.CreateStoreRole)
+		authRoute.PUT("/store-roles/:name", middleware.AuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, jwks),
+			middleware.PermissionMiddleware("store_role:write", serviceConfig.ZapLogger), h.AuthHandler.UpdateStoreRole)
+		authRoute.DELETE("/store-roles/:name", middleware.AuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, jwks),
+			middleware.PermissionMiddleware("store_role:write", serviceConfig.ZapLogger), h.AuthHandler.DeleteStoreRole)
+
+		// Second step of a login that returned a challenge token
+		authRoute.POST("/login/2fa/enroll", middleware.RateLimitingMiddleware(30, time.Minute, serviceConfig.ZapLogger, serviceConfig.RedisClient), h.AuthHandler.BeginTOTPEnrollment)
+		authRoute.POST("/login/2fa/confirm", middleware.RateLimitingMiddleware(30, time.Minute, serviceConfig.ZapLogger, serviceConfig.RedisClient), h.AuthHandler.ConfirmTOTPEnrollment)
+		authRoute.POST("/login/2fa/verify", middleware.RateLimitingMiddleware(30, time.Minute, serviceConfig.ZapLogger, serviceConfig.RedisClient), h.AuthHandler.VerifySecondFactor)
+		authRoute.POST("/2fa/enroll", middleware.AuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, jwks),
+			h.AuthHandler.BeginTOTPEnrollment)
+		authRoute.POST("/2fa/confirm", middleware.AuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, jwks),
+			h.AuthHandler.ConfirmTOTPEnrollment)
+		authRoute.POST("/2fa/disable", middleware.AuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, jwks),
+			h.AuthHandler.DisableTOTP)
+
+		// Login with an identity provider, the callback completes logins and links
+		authRoute.GET("/oidc/:provider/login", middleware.RateLimitingMiddleware(30, time.Minute, serviceConfig.ZapLogger, serviceConfig.RedisClient), h.AuthHandler.BeginOIDCLogin)
+		authRoute.GET("/oidc/:provider/callback", middleware.RateLimitingMiddleware(30, time.Minute, serviceConfig.ZapLogger, serviceConfig.RedisClient),
+			middleware.OptionalAuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, jwks), h.AuthHandler.CompleteOIDCLogin)
+		authRoute.POST("/oidc/:provider/link", middleware.AuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, jwks),
+			h.AuthHandler.LinkOIDCIdentity)
 	}
 
-	router.Use(middleware.AuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, envConfig.JWTSecret))
+	// Shared wishlists are public, so they are registered before AuthMiddleware
+	router.GET("/wishlists/shared/:token", h.UserHandler.GetSharedWishlist)
+
+	router.Use(middleware.AuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, jwks))
 	userRoute := router.Group("/users")
 	{
-		//userRoute.Use(middleware.AuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, envConfig.JWTSecret))
+		//userRoute.Use(middleware.AuthMiddleware(serviceConfig.ZapLogger, serviceConfig.RedisClient, jwks))
 		buyerRoute := userRoute.Group("/buyers")
 		{
-			buyerRoute.Use(middleware.AuthorizationMiddleware([]string{"buyer"}, serviceConfig.ZapLogger))
+			buyerRoute.Use(middleware.PermissionMiddleware("buyer:profile", serviceConfig.ZapLogger))
 			buyerRoute.POST("", h.UserHandler.CreateBuyer)
 			buyerRoute.GET("/:id", h.UserHandler.GetBuyerByUserID)
 			buyerRoute.PUT("/:id", h.UserHandler.UpdateBuyerByUserID)
 			buyerRoute.DELETE("/:id", h.UserHandler.DelBuyerByUserID)
+			buyerRoute.POST("/wishlist", h.UserHandler.AddWishlistItem)
+			buyerRoute.GET("/wishlist", h.UserHandler.GetWishlist)
+			buyerRoute.DELETE("/wishlist/:product_id", h.UserHandler.RemoveWishlistItem)
+			buyerRoute.POST("/wishlist/share", h.UserHandler.ShareWishlist)
+			buyerRoute.DELETE("/wishlist/share", h.UserHandler.UnshareWishlist)
 		}
 		sellerRoute := userRoute.Group("/sellers")
 		{
-			sellerRoute.POST("", middleware.AuthorizationMiddleware([]string{"seller_admin"}, serviceConfig.ZapLogger), h.UserHandler.CreateSeller)
+			sellerRoute.POST("", middleware.PermissionMiddleware("seller:write", serviceConfig.ZapLogger), h.UserHandler.CreateSeller)
 			sellerRoute.GET("/:id", h.UserHandler.GetSellerByID)
-			sellerRoute.PUT("/:id", middleware.AuthorizationMiddleware([]string{"seller_admin"}, serviceConfig.ZapLogger), h.UserHandler.UpdateSellerByID)
-			sellerRoute.DELETE("/:id", middleware.AuthorizationMiddleware([]string{"seller_admin"}, serviceConfig.ZapLogger), h.UserHandler.DelSellerByID)
+			sellerRoute.PUT("/:id", middleware.PermissionMiddleware("seller:write", serviceConfig.ZapLogger), h.UserHandler.UpdateSellerByID)
+			sellerRoute.DELETE("/:id", middleware.PermissionMiddleware("seller:write", serviceConfig.ZapLogger), h.UserHandler.DelSellerByID)
 		}
 	}
 
 	productRoute := router.Group("/products")
 	{
-		productRoute.POST("", middleware.AuthorizationMiddleware([]string{"seller_admin", "seller_employee"}, serviceConfig.ZapLogger), h.ProductHandler.CreateProduct)
-		productRoute.PUT("/:id", middleware.AuthorizationMiddleware([]string{"seller_admin", "seller_employee"}, serviceConfig.ZapLogger), h.ProductHandler.UpdateProduct)
+		productRoute.POST("", middleware.PermissionMiddleware("product:write", serviceConfig.ZapLogger), h.ProductHandler.CreateProduct)
+		productRoute.PUT("/:id", middleware.PermissionMiddleware("product:write", serviceConfig.ZapLogger), h.ProductHandler.UpdateProduct)
+		productRoute.DELETE("/:id", middleware.PermissionMiddleware("product:delete", serviceConfig.ZapLogger), h.ProductHandler.DeleteProduct)
+		productRoute.POST("/:id/archive", middleware.PermissionMiddleware("product:delete", serviceConfig.ZapLogger), h.ProductHandler.ArchiveProduct)
+		productRoute.POST("/:id/restore", middleware.PermissionMiddleware("product:delete", serviceConfig.ZapLogger), h.ProductHandler.RestoreProduct)
+		productRoute.POST("/import", middleware.PermissionMiddleware("product:write", serviceConfig.ZapLogger), h.ProductHandler.ImportProducts)
+		productRoute.GET("/import/:job_id", middleware.PermissionMiddleware("product:write", serviceConfig.ZapLogger), h.ProductHandler.GetImportJob)
+		productRoute.GET("/export", middleware.PermissionMiddleware("product:write", serviceConfig.ZapLogger), h.ProductHandler.ExportProducts)
+		productRoute.POST("/:id/inventory/adjust", middleware.PermissionMiddleware("inventory:write", serviceConfig.ZapLogger), h.ProductHandler.AdjustInventory)
+		productRoute.GET("/inventory/movements", middleware.PermissionMiddleware("inventory:read", serviceConfig.ZapLogger), h.ProductHandler.GetInventoryMovements)
+		productRoute.GET("/search", h.ProductHandler.SearchProducts)
 		productRoute.GET("/:id", h.ProductHandler.GetProductByID)
+		productRoute.GET("/:id/also-bought", h.ProductHandler.GetRelatedProducts)
+		productRoute.GET("/:id/questions", h.ProductHandler.GetProductQuestions)
+		productRoute.POST("/:id/questions", middleware.PermissionMiddleware("question:ask", serviceConfig.ZapLogger), h.ProductHandler.AskQuestion)
+		productRoute.POST("/questions/:id/answers", middleware.PermissionMiddleware("question:answer", serviceConfig.ZapLogger), h.ProductHandler.AnswerQuestion)
+		productRoute.POST("/questions/:id/flag", middleware.PermissionMiddleware("question:ask", serviceConfig.ZapLogger), h.ProductHandler.FlagQuestion)
+		productRoute.POST("/answers/:id/flag", middleware.PermissionMiddleware("question:ask", serviceConfig.ZapLogger), h.ProductHandler.FlagAnswer)
 		productRoute.GET("", h.ProductHandler.GetProducts)
 		productRoute.GET("/seller/:seller_id", h.ProductHandler.GetProductsBySellerID)
 	}
 
+	categoryRoute := router.Group("/categories")
+	{
+		categoryRoute.GET("", h.ProductHandler.GetCategories)
+		categoryRoute.GET("/:id", h.ProductHandler.GetCategoryByID)
+	}
+	router.GET("/exchange-rates", h.ProductHandler.GetExchangeRates)
+
+	adminRoute := router.Group("/admin")
+	{
+		adminRoute.POST("/categories", middleware.PermissionMiddleware("category:write", serviceConfig.ZapLogger), h.ProductHandler.CreateCategory)
+		adminRoute.PUT("/categories/:id", middleware.PermissionMiddleware("category:write", serviceConfig.ZapLogger), h.ProductHandler.UpdateCategory)
+		adminRoute.DELETE("/categories/:id", middleware.PermissionMiddleware("category:write", serviceConfig.ZapLogger), h.ProductHandler.DeleteCategory)
+		adminRoute.GET("/questions/flagged", middleware.PermissionMiddleware("content:moderate", serviceConfig.ZapLogger), h.ProductHandler.GetFlaggedQuestions)
+		adminRoute.GET("/answers/flagged", middleware.PermissionMiddleware("content:moderate", serviceConfig.ZapLogger), h.ProductHandler.GetFlaggedAnswers)
+		adminRoute.PUT("/questions/:id", middleware.PermissionMiddleware("content:moderate", serviceConfig.ZapLogger), h.ProductHandler.ModerateQuestion)
+		adminRoute.PUT("/answers/:id", middleware.PermissionMiddleware("content:moderate", serviceConfig.ZapLogger), h.ProductHandler.ModerateAnswer)
+	}
+
 	orderRoute := router.Group("/orders")
 	{
 		orderRoute.POST("", h.OrderHandler.CreateOrder)
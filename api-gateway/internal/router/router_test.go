@@ -0,0 +1,52 @@
+package router
+
+import (
+	"api-gateway/internal/config"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestNewEngineClientIP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		remoteAddr     string
+		forwardedFor   string
+		want           string
+	}{
+		{"no proxy", nil, "203.0.113.7:4242", "", "203.0.113.7"},
+		{"forged without trusted proxies", nil, "203.0.113.7:4242", "198.51.100.1", "203.0.113.7"},
+		{"forged to untrusted proxy", []string{"10.0.0.0/8"}, "203.0.113.7:4242", "198.51.100.1", "203.0.113.7"},
+		{"trusted proxy", []string{"10.0.0.0/8"}, "10.0.0.2:4242", "203.0.113.7", "203.0.113.7"},
+		{"forged through trusted proxy", []string{"10.0.0.0/8"}, "10.0.0.2:4242", "198.51.100.1, 203.0.113.7", "203.0.113.7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine, err := NewEngine(&config.EnvConfig{TrustedProxies: tt.trustedProxies})
+			if err != nil {
+				t.Fatal(err)
+			}
+			// The login throttle of auth-service and the rate limit of the gateway are keyed by this IP
+			var got string
+			engine.POST("/auth/login", func(c *gin.Context) { got = c.ClientIP() })
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/login", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.forwardedFor != "" {
+				req.Header.Set("X-Forwarded-For", tt.forwardedFor)
+				req.Header.Set("X-Real-IP", "198.51.100.2")
+			}
+			engine.ServeHTTP(httptest.NewRecorder(), req)
+			if got != tt.want {
+				t.Errorf("ClientIP() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+	if _, err := NewEngine(&config.EnvConfig{TrustedProxies: []string{"not an ip"}}); err == nil {
+		t.Error("NewEngine() accepted an invalid trusted proxy")
+	}
+}
@@ -25,3 +25,29 @@ func (s *APIGatewayService) AddChaPwdVerToRedis(ctx context.Context, msg *kafka.
 	}
 	return nil
 }
+
+// AddTokRevToRedis add a logout to the denylist checked by AuthMiddleware, the entry expires with the revoked tokens
+func (s *APIGatewayService) AddTokRevToRedis(ctx context.Context, msg *kafka.Message) error {
+
+	var eventDTO dto.TokenRevocationKafkaEvent
+	if err := json.Unmarshal(msg.Value, &eventDTO); err != nil {
+		return err
+	}
+	period := time.Until(eventDTO.ExpiresAt)
+	if period <= 0 {
+		return nil
+	}
+
+	if eventDTO.JTI == "" && eventDTO.FamilyID == "" {
+		return fmt.Errorf("token revocation event %d names neither a token nor a session", eventDTO.EventID)
+	}
+	pipe := s.RedisClient.Pipeline()
+	if eventDTO.JTI != "" {
+		pipe.Set(ctx, fmt.Sprintf("revoked_jti:%s", eventDTO.JTI), eventDTO.UserID, period)
+	}
+	if eventDTO.FamilyID != "" {
+		pipe.Set(ctx, fmt.Sprintf("revoked_family:%s", eventDTO.FamilyID), eventDTO.UserID, period)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
@@ -1,15 +1,23 @@
 package dto
 
+import "time"
+
 type LoginInput struct {
-	Username string `json:"username" binding:"required" example:"user1"`
-	Password string `json:"password" binding:"required" example:"password1"`
-	Role     string `json:"role" binding:"required" example:"admin"`
+	Username    string `json:"username" binding:"required" example:"user1"`
+	Password    string `json:"password" binding:"required" example:"password1"`
+	Role        string `json:"role" binding:"required" example:"admin"`
+	DeviceLabel string `json:"device_label" example:"Work laptop"` // name of the session, derived from the user agent when empty
+	UserAgent   string `json:"-"`
+	ClientIP    string `json:"-"`
 }
 type LoginOutput struct {
-	Message      string `json:"message"`
-	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
-	Success      bool   `json:"success"`
+	Message              string `json:"message"`
+	AccessToken          string `json:"access_token"`
+	RefreshToken         string `json:"refresh_token"`
+	Success              bool   `json:"success"`
+	SecondFactorRequired bool   `json:"second_factor_required"`
+	EnrollmentRequired   bool   `json:"enrollment_required"`
+	ChallengeToken       string `json:"challenge_token"`
 }
 
 type RegisterInput struct {
@@ -53,3 +61,211 @@ type RegisterSellerRolesOutput struct {
 	Message string `json:"message"`
 	Success bool   `json:"success"`
 }
+
+type LogoutInput struct {
+	AccessToken string `json:"-"`
+}
+type LogoutOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type LogoutAllInput struct {
+	AccessToken string `json:"-"`
+}
+type LogoutAllOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type UnlockAccountInput struct {
+	Username string `json:"username" example:"user1"`
+	Role     string `json:"role" example:"buyer"`
+	ClientIP string `json:"client_ip" example:"203.0.113.7"`
+}
+type UnlockAccountOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type RequestPasswordResetInput struct {
+	Username string `json:"username" binding:"required" example:"user1"`
+	Role     string `json:"role" binding:"required" example:"buyer"`
+}
+type RequestPasswordResetOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type ResetPasswordInput struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required" example:"password2"`
+}
+type ResetPasswordOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type BeginTOTPEnrollmentInput struct {
+	UserID         uint64 `json:"-"`
+	ChallengeToken string `json:"challenge_token"`
+}
+type BeginTOTPEnrollmentOutput struct {
+	Message         string `json:"message"`
+	Success         bool   `json:"success"`
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+type ConfirmTOTPEnrollmentInput struct {
+	UserID         uint64 `json:"-"`
+	ChallengeToken string `json:"challenge_token"`
+	Code           string `json:"code" binding:"required" example:"123456"`
+	DeviceLabel    string `json:"device_label" example:"Work laptop"`
+	UserAgent      string `json:"-"`
+	ClientIP       string `json:"-"`
+}
+type ConfirmTOTPEnrollmentOutput struct {
+	Message       string   `json:"message"`
+	Success       bool     `json:"success"`
+	RecoveryCodes []string `json:"recovery_codes"`
+	AccessToken   string   `json:"access_token,omitempty"`
+	RefreshToken  string   `json:"refresh_token,omitempty"`
+}
+
+type VerifySecondFactorInput struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+	Code           string `json:"code" binding:"required" example:"123456"`
+	DeviceLabel    string `json:"device_label" example:"Work laptop"`
+	UserAgent      string `json:"-"`
+	ClientIP       string `json:"-"`
+}
+type VerifySecondFactorOutput struct {
+	Message      string `json:"message"`
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	Success      bool   `json:"success"`
+}
+
+type DisableTOTPInput struct {
+	UserID uint64 `json:"-"`
+	Code   string `json:"code" binding:"required" example:"123456"`
+}
+type DisableTOTPOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type BeginOIDCLoginInput struct {
+	Provider string `json:"-"`
+	UserID   uint64 `json:"-"`
+}
+type BeginOIDCLoginOutput struct {
+	Message          string `json:"message"`
+	Success          bool   `json:"success"`
+	AuthorizationURL string `json:"authorization_url"`
+	State            string `json:"state"`
+}
+
+type CompleteOIDCLoginInput struct {
+	Provider  string `json:"-"`
+	State     string `form:"state" binding:"required"`
+	Code      string `form:"code" binding:"required"`
+	UserAgent string `form:"-"`
+	ClientIP  string `form:"-"`
+	UserID    uint64 `form:"-"` // authenticated caller, 0 for anonymous
+}
+type CompleteOIDCLoginOutput struct {
+	Message              string `json:"message"`
+	Success              bool   `json:"success"`
+	AccessToken          string `json:"access_token,omitempty"`
+	RefreshToken         string `json:"refresh_token,omitempty"`
+	SecondFactorRequired bool   `json:"second_factor_required"`
+	EnrollmentRequired   bool   `json:"enrollment_required"`
+	ChallengeToken       string `json:"challenge_token,omitempty"`
+	AccountCreated       bool   `json:"account_created"`
+	Linked               bool   `json:"linked"`
+}
+
+// StoreRole is a custom role of a store
+type StoreRole struct {
+	Name        string   `json:"name" example:"catalog_editor"`
+	Description string   `json:"description" example:"Edit products, no deletes"`
+	Permissions []string `json:"permissions" example:"product:write,inventory:read"`
+}
+
+type ListStoreRolesInput struct {
+	ActorID uint64 `json:"-"`
+	StoreID uint64 `form:"store_id"` // required for admins, staff manage the roles of their own store
+}
+type ListStoreRolesOutput struct {
+	Message               string       `json:"message"`
+	Success               bool         `json:"success"`
+	Roles                 []*StoreRole `json:"roles"`
+	AssignablePermissions []string     `json:"assignable_permissions"`
+}
+
+type CreateStoreRoleInput struct {
+	ActorID     uint64   `json:"-"`
+	StoreID     uint64   `json:"store_id"`
+	Name        string   `json:"name" binding:"required" example:"catalog_editor"`
+	Description string   `json:"description" example:"Edit products, no deletes"`
+	Permissions []string `json:"permissions" binding:"required" example:"product:write,inventory:read"`
+}
+type CreateStoreRoleOutput struct {
+	Message string     `json:"message"`
+	Success bool       `json:"success"`
+	Role    *StoreRole `json:"role"`
+}
+
+type UpdateStoreRoleInput struct {
+	ActorID     uint64   `json:"-"`
+	StoreID     uint64   `json:"store_id"`
+	Name        string   `json:"-"`
+	Description string   `json:"description" example:"Edit products, no deletes"`
+	Permissions []string `json:"permissions" binding:"required" example:"product:write,inventory:read"`
+}
+type UpdateStoreRoleOutput struct {
+	Message string     `json:"message"`
+	Success bool       `json:"success"`
+	Role    *StoreRole `json:"role"`
+}
+
+type DeleteStoreRoleInput struct {
+	ActorID uint64 `json:"-"`
+	StoreID uint64 `form:"store_id"`
+	Name    string `json:"-"`
+}
+type DeleteStoreRoleOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+// Session is a login of the user, Current is the session of the request
+type Session struct {
+	ID          string    `json:"id"`
+	DeviceLabel string    `json:"device_label" example:"Firefox on Linux"`
+	UserAgent   string    `json:"user_agent"`
+	ClientIP    string    `json:"client_ip" example:"203.0.113.7"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastUsedAt  time.Time `json:"last_used_at"`
+	Current     bool      `json:"current"`
+}
+
+type ListSessionsInput struct {
+	AccessToken string `json:"-"`
+}
+type ListSessionsOutput struct {
+	Message  string     `json:"message"`
+	Success  bool       `json:"success"`
+	Sessions []*Session `json:"sessions"`
+}
+
+type RevokeSessionInput struct {
+	AccessToken string `json:"-"`
+	SessionID   string `json:"-"`
+}
+type RevokeSessionOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
@@ -1,5 +1,6 @@
 package dto
 
 type ErrorResponse struct {
-	Error string `json:"error" example:"invalid request"`
+	Error  string        `json:"error" example:"invalid request"`
+	Fields []*FieldError `json:"fields,omitempty"` // errors of single fields of the request, when the service named them
 }
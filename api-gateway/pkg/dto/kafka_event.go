@@ -1,6 +1,19 @@
 package dto
 
+import "time"
+
 type ChangePwdKafkaEvent struct {
 	UserID     uint64 `json:"user_id"`
 	PwdVersion int64  `json:"pwd_version"`
 }
+
+// TokenRevocationKafkaEvent revoke the access token JTI and the access tokens of the session FamilyID,
+// a logout of all sessions sends one event per session
+type TokenRevocationKafkaEvent struct {
+	EventID      uint64    `json:"event_id"`
+	UserID       uint64    `json:"user_id"`
+	JTI          string    `json:"jti"`
+	FamilyID     string    `json:"family_id"`
+	IssuedBefore time.Time `json:"issued_before"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
@@ -1,20 +1,33 @@
 package dto
 
+import (
+	"product-service/pkg/money"
+	"time"
+)
+
 type Order struct {
 	ID         uint64       `json:"id"`
 	BuyerID    uint64       `json:"buyer_id"`
 	Status     string       `json:"status"`
-	TotalPrice float64      `json:"total_price"`
+	TotalPrice money.Money  `json:"total_price"`
+	Currency   string       `json:"currency" example:"VND"` // empty is the currency of the first item
 	OrderItems []*OrderItem `json:"order_items"`
+
+	// Exchange rates the order was placed at, set by OrderService
+	RateBase       string    `json:"rate_base,omitempty"`
+	RatesUpdatedAt time.Time `json:"rates_updated_at"`
 }
 
 type OrderItem struct {
-	ID        uint64  `json:"id"`
-	Name      string  `json:"name"`
-	OrderID   uint64  `json:"order_id"`
-	ProductID uint64  `json:"product_id"`
-	Quantity  int64   `json:"quantity"`
-	Price     float64 `json:"price"`
+	ID        uint64      `json:"id"`
+	Name      string      `json:"name"`
+	OrderID   uint64      `json:"order_id"`
+	ProductID uint64      `json:"product_id"`
+	Quantity  int64       `json:"quantity"`
+	Price     money.Money `json:"price"`
+
+	// Set by OrderService from the product
+	ExchangeRate float64 `json:"exchange_rate,omitempty"` // units of order currency for one unit of price currency
 }
 
 type CreateOrderInput struct {
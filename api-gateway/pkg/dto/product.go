@@ -1,24 +1,41 @@
 package dto
 
+import (
+	"product-service/pkg/money"
+	"time"
+)
+
 type Product struct {
-	ID         uint64         `json:"id"`
-	Name       string         `json:"name"`
-	Price      float64        `json:"price"`
-	SellerID   uint64         `json:"seller_id"`
-	Inventory  int64          `json:"inventory"`
-	Attributes map[string]any `json:"attributes"`
+	ID                uint64         `json:"id"`
+	Name              string         `json:"name"`
+	Price             money.Money    `json:"price"`
+	SellerID          uint64         `json:"seller_id"`
+	Inventory         int64          `json:"inventory"`
+	Attributes        map[string]any `json:"attributes"`
+	SKU               string         `json:"sku"`
+	LowStockThreshold int64          `json:"low_stock_threshold"`
+	CategoryID        uint64         `json:"category_id"`
+	Status            string         `json:"status"`
+	Version           uint64         `json:"version"`
+
+	// Set when prices are requested in another currency
+	ConvertedPrice *money.Money `json:"converted_price,omitempty"`
 }
 
 type CreateProductInput struct {
-	Name       string         `json:"name"`
-	Price      float64        `json:"price"`
-	SellerID   uint64         `json:"seller_id"`
-	Inventory  int64          `json:"inventory"`
-	Attributes map[string]any `json:"attributes"`
+	Name              string         `json:"name"`
+	Price             money.Money    `json:"price"` // empty currency is VND
+	SellerID          uint64         `json:"seller_id"`
+	Inventory         int64          `json:"inventory"`
+	Attributes        map[string]any `json:"attributes"`
+	SKU               string         `json:"sku"`
+	LowStockThreshold int64          `json:"low_stock_threshold"`
+	CategoryID        uint64         `json:"category_id"`
 }
 type CreateProductOutput struct {
-	Message string `json:"message"`
-	Success bool   `json:"success"`
+	Message     string        `json:"message"`
+	Success     bool          `json:"success"`
+	FieldErrors []*FieldError `json:"field_errors,omitempty"`
 }
 
 type UpdateProductInput struct {
@@ -26,6 +43,34 @@ type UpdateProductInput struct {
 	UserId  uint64   `json:"user_id"`
 }
 type UpdateProductOutput struct {
+	Message     string        `json:"message"`
+	Success     bool          `json:"success"`
+	FieldErrors []*FieldError `json:"field_errors,omitempty"`
+}
+
+type DeleteProductInput struct {
+	ID     uint64 `json:"id"`
+	UserID uint64 `json:"user_id"`
+}
+type DeleteProductOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type ArchiveProductInput struct {
+	ID     uint64 `json:"id"`
+	UserID uint64 `json:"user_id"`
+}
+type ArchiveProductOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type RestoreProductInput struct {
+	ID     uint64 `json:"id"`
+	UserID uint64 `json:"user_id"`
+}
+type RestoreProductOutput struct {
 	Message string `json:"message"`
 	Success bool   `json:"success"`
 }
@@ -40,20 +85,309 @@ type GetProductByIDOutput struct {
 }
 
 type GetProductsBySellerIDInput struct {
-	SellerID uint64 `json:"seller_id"`
+	SellerID  uint64 `json:"seller_id"`
+	PageSize  uint64 `json:"page_size"`
+	PageToken string `json:"page_token"`
+	Sort      string `json:"sort"`
 }
 type GetProductsBySellerIDOutput struct {
+	Message         string     `json:"message"`
+	Success         bool       `json:"success"`
+	Products        []*Product `json:"products"`
+	NextPageToken   string     `json:"next_page_token,omitempty"`
+	TotalCount      uint64     `json:"total_count"`
+	TotalIsEstimate bool       `json:"total_is_estimate"`
+}
+
+type GetProductsInput struct {
+	PageSize  uint64 `json:"page_size"`
+	PageToken string `json:"page_token"`
+	Sort      string `json:"sort"`
+}
+type GetProductsOutput struct {
+	Message         string     `json:"message"`
+	Success         bool       `json:"success"`
+	Products        []*Product `json:"products"`
+	NextPageToken   string     `json:"next_page_token,omitempty"`
+	TotalCount      uint64     `json:"total_count"`
+	TotalIsEstimate bool       `json:"total_is_estimate"`
+}
+
+type ImportRowError struct {
+	Row     uint64 `json:"row"`
+	SKU     string `json:"sku"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+type ImportJob struct {
+	ID          uint64            `json:"id"`
+	SellerID    uint64            `json:"seller_id"`
+	Format      string            `json:"format"`
+	Status      string            `json:"status"`
+	TotalRows   uint64            `json:"total_rows"`
+	SuccessRows uint64            `json:"success_rows"`
+	FailedRows  uint64            `json:"failed_rows"`
+	Errors      []*ImportRowError `json:"errors"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+type ImportProductsInput struct {
+	SellerID uint64 `json:"seller_id"`
+	Format   string `json:"format"`
+	Data     []byte `json:"-"`
+}
+type ImportProductsOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+	JobID   uint64 `json:"job_id"`
+}
+
+type GetImportJobInput struct {
+	JobID    uint64 `json:"job_id"`
+	SellerID uint64 `json:"seller_id"`
+}
+type GetImportJobOutput struct {
+	Message string     `json:"message"`
+	Success bool       `json:"success"`
+	Job     *ImportJob `json:"job"`
+}
+
+type ExportProductsInput struct {
+	SellerID uint64 `json:"seller_id"`
+	Format   string `json:"format"`
+}
+
+type InventoryMovement struct {
+	ID             uint64    `json:"id"`
+	ProductID      uint64    `json:"product_id"`
+	SellerID       uint64    `json:"seller_id"`
+	Type           string    `json:"type"`
+	ReferenceID    uint64    `json:"reference_id"`
+	Quantity       int64     `json:"quantity"`
+	InventoryAfter int64     `json:"inventory_after"`
+	Reason         string    `json:"reason"`
+	ActorID        uint64    `json:"actor_id"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type AdjustInventoryInput struct {
+	ProductID uint64 `json:"product_id"`
+	UserID    uint64 `json:"user_id"`
+	Quantity  int64  `json:"quantity"`
+	Reason    string `json:"reason"`
+}
+type AdjustInventoryOutput struct {
+	Message   string `json:"message"`
+	Success   bool   `json:"success"`
+	Inventory int64  `json:"inventory"`
+}
+
+type GetInventoryMovementsInput struct {
+	SellerID  uint64 `json:"seller_id"`
+	ProductID uint64 `json:"product_id"`
+	Type      string `json:"type"`
+	Page      uint64 `json:"page"`
+	PageSize  uint64 `json:"page_size"`
+}
+type GetInventoryMovementsOutput struct {
+	Message   string               `json:"message"`
+	Success   bool                 `json:"success"`
+	Movements []*InventoryMovement `json:"movements"`
+}
+
+// FieldError is a validation error of one request field, e.g. "attributes.color"
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type Category struct {
+	ID         uint64                 `json:"id"`
+	Name       string                 `json:"name"`
+	Attributes []*AttributeDefinition `json:"attributes"`
+	CreatedAt  time.Time              `json:"created_at"`
+	UpdatedAt  time.Time              `json:"updated_at"`
+}
+
+type AttributeDefinition struct {
+	Name          string   `json:"name" example:"color"`
+	Type          string   `json:"type" example:"string"` // string, number, boolean
+	AllowedValues []string `json:"allowed_values"`
+	Required      bool     `json:"required"`
+}
+
+type CreateCategoryInput struct {
+	Name       string                 `json:"name" binding:"required"`
+	Attributes []*AttributeDefinition `json:"attributes"`
+}
+type CreateCategoryOutput struct {
+	Message     string        `json:"message"`
+	Success     bool          `json:"success"`
+	Category    *Category     `json:"category"`
+	FieldErrors []*FieldError `json:"field_errors,omitempty"`
+}
+
+type UpdateCategoryInput struct {
+	ID         uint64                 `json:"id"`
+	Name       string                 `json:"name" binding:"required"`
+	Attributes []*AttributeDefinition `json:"attributes"`
+}
+type UpdateCategoryOutput struct {
+	Message     string        `json:"message"`
+	Success     bool          `json:"success"`
+	Category    *Category     `json:"category"`
+	FieldErrors []*FieldError `json:"field_errors,omitempty"`
+}
+
+type DeleteCategoryInput struct {
+	ID uint64 `json:"id"`
+}
+type DeleteCategoryOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type GetCategoryByIDInput struct {
+	ID uint64 `json:"id"`
+}
+type GetCategoryByIDOutput struct {
+	Message  string    `json:"message"`
+	Success  bool      `json:"success"`
+	Category *Category `json:"category"`
+}
+
+type GetCategoriesInput struct {
+}
+type GetCategoriesOutput struct {
+	Message    string      `json:"message"`
+	Success    bool        `json:"success"`
+	Categories []*Category `json:"categories"`
+}
+
+type GetRelatedProductsInput struct {
+	ProductID uint64 `json:"product_id"`
+	Limit     uint64 `json:"limit"`
+}
+type GetRelatedProductsOutput struct {
 	Message  string     `json:"message"`
 	Success  bool       `json:"success"`
 	Products []*Product `json:"products"`
 }
 
-type GetProductsInput struct {
-	Page     uint64 `json:"page"`
-	PageSize uint64 `json:"page_size"`
+type ProductAnswer struct {
+	ID          uint64    `json:"id"`
+	QuestionID  uint64    `json:"question_id"`
+	ResponderID uint64    `json:"responder_id"`
+	Body        string    `json:"body"`
+	CreatedAt   time.Time `json:"created_at"`
 }
-type GetProductsOutput struct {
+type ProductQuestion struct {
+	ID        uint64           `json:"id"`
+	ProductID uint64           `json:"product_id"`
+	BuyerID   uint64           `json:"buyer_id"`
+	Body      string           `json:"body"`
+	Answers   []*ProductAnswer `json:"answers"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+type AskQuestionInput struct {
+	ProductID uint64 `json:"-"`
+	BuyerID   uint64 `json:"-"`
+	Body      string `json:"body" binding:"required,max=1000"`
+}
+type AskQuestionOutput struct {
+	Message  string           `json:"message"`
+	Success  bool             `json:"success"`
+	Question *ProductQuestion `json:"question"`
+}
+
+type AnswerQuestionInput struct {
+	QuestionID uint64 `json:"-"`
+	UserID     uint64 `json:"-"`
+	Body       string `json:"body" binding:"required,max=2000"`
+}
+type AnswerQuestionOutput struct {
+	Message string         `json:"message"`
+	Success bool           `json:"success"`
+	Answer  *ProductAnswer `json:"answer"`
+}
+
+type GetProductQuestionsInput struct {
+	ProductID uint64 `json:"product_id"`
+	Page      uint64 `json:"page"`
+	PageSize  uint64 `json:"page_size"`
+}
+type GetProductQuestionsOutput struct {
+	Message   string             `json:"message"`
+	Success   bool               `json:"success"`
+	Questions []*ProductQuestion `json:"questions"`
+	Total     int64              `json:"total"`
+}
+
+type FlagQAInput struct {
+	TargetType string `json:"-"`
+	TargetID   uint64 `json:"-"`
+	UserID     uint64 `json:"-"`
+	Reason     string `json:"reason" binding:"max=500"`
+}
+type FlagQAOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type ModerateQAInput struct {
+	TargetType string `json:"-"`
+	TargetID   uint64 `json:"-"`
+	Status     string `json:"status" binding:"required,oneof=VISIBLE HIDDEN"`
+}
+type ModerateQAOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type FlaggedQA struct {
+	TargetType string    `json:"target_type"`
+	TargetID   uint64    `json:"target_id"`
+	ProductID  uint64    `json:"product_id"`
+	QuestionID uint64    `json:"question_id"`
+	AuthorID   uint64    `json:"author_id"`
+	Body       string    `json:"body"`
+	FlagCount  int64     `json:"flag_count"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+type GetFlaggedQAInput struct {
+	TargetType string `json:"target_type"`
+	Page       uint64 `json:"page"`
+	PageSize   uint64 `json:"page_size"`
+}
+type GetFlaggedQAOutput struct {
+	Message string       `json:"message"`
+	Success bool         `json:"success"`
+	Items   []*FlaggedQA `json:"items"`
+	Total   int64        `json:"total"`
+}
+
+type GetExchangeRatesInput struct {
+}
+type GetExchangeRatesOutput struct {
+	Message   string             `json:"message"`
+	Success   bool               `json:"success"`
+	Base      string             `json:"base"`
+	Rates     map[string]float64 `json:"rates"` // units of currency for one unit of base
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+type SearchProductsInput struct {
+	Query      string `json:"query"`
+	SellerID   uint64 `json:"seller_id"`
+	CategoryID uint64 `json:"category_id"`
+	Page       uint64 `json:"page"`
+	PageSize   uint64 `json:"page_size"`
+}
+type SearchProductsOutput struct {
 	Message  string     `json:"message"`
 	Success  bool       `json:"success"`
 	Products []*Product `json:"products"`
+	Total    uint64     `json:"total"`
 }
@@ -1,6 +1,9 @@
 package dto
 
-import "time"
+import (
+	"product-service/pkg/money"
+	"time"
+)
 
 type Buyer struct {
 	UserID      uint64    `json:"user_id"`
@@ -92,3 +95,78 @@ type DelSelByIDOutput struct {
 	Message string `json:"message"`
 	Success bool   `json:"success"`
 }
+
+// For Wishlist
+
+type WishlistProduct struct {
+	ID       uint64      `json:"id"`
+	Name     string      `json:"name"`
+	Price    money.Money `json:"price"`
+	SellerID uint64      `json:"seller_id"`
+	Status   string      `json:"status"`
+}
+type WishlistItem struct {
+	ProductID uint64           `json:"product_id"`
+	AddedAt   time.Time        `json:"added_at"`
+	Product   *WishlistProduct `json:"product"`
+	Available bool             `json:"available"`
+}
+
+type AddWishlistItemInput struct {
+	UserID    uint64 `json:"-"`
+	ProductID uint64 `json:"product_id" binding:"required"`
+}
+type AddWishlistItemOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type RemoveWishlistItemInput struct {
+	UserID    uint64 `json:"-"`
+	ProductID uint64 `json:"-"`
+}
+type RemoveWishlistItemOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type GetWishlistInput struct {
+	UserID   uint64 `json:"-"`
+	Page     uint64 `json:"page"`
+	PageSize uint64 `json:"page_size"`
+}
+type GetWishlistOutput struct {
+	Message string          `json:"message"`
+	Success bool            `json:"success"`
+	Items   []*WishlistItem `json:"items"`
+	Total   int64           `json:"total"`
+}
+
+type ShareWishlistInput struct {
+	UserID uint64 `json:"-"`
+}
+type ShareWishlistOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+	Token   string `json:"token"`
+}
+
+type UnshareWishlistInput struct {
+	UserID uint64 `json:"-"`
+}
+type UnshareWishlistOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type GetSharedWishlistInput struct {
+	Token    string `json:"-"`
+	Page     uint64 `json:"page"`
+	PageSize uint64 `json:"page_size"`
+}
+type GetSharedWishlistOutput struct {
+	Message string          `json:"message"`
+	Success bool            `json:"success"`
+	Items   []*WishlistItem `json:"items"`
+	Total   int64           `json:"total"`
+}
@@ -1010,6 +1010,868 @@ func (x *DelSellerByIDResponse) GetSuccess() bool {
 	return false
 }
 
+// For Wishlist gRPC
+// Money is an exact amount in minor units of an ISO 4217 currency, e.g. amount 1999 and currency USD is 19.99 USD
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type WishlistProduct struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SellerId      uint64                 `protobuf:"varint,4,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Price         *Money                 `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WishlistProduct) Reset() {
+	*x = WishlistProduct{}
+	mi := &file_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WishlistProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WishlistProduct) ProtoMessage() {}
+
+func (x *WishlistProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WishlistProduct.ProtoReflect.Descriptor instead.
+func (*WishlistProduct) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WishlistProduct) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WishlistProduct) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WishlistProduct) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *WishlistProduct) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WishlistProduct) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type WishlistItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     uint64                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	Product       *WishlistProduct       `protobuf:"bytes,3,opt,name=product,proto3" json:"product,omitempty"`
+	Available     bool                   `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WishlistItem) Reset() {
+	*x = WishlistItem{}
+	mi := &file_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WishlistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WishlistItem) ProtoMessage() {}
+
+func (x *WishlistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WishlistItem.ProtoReflect.Descriptor instead.
+func (*WishlistItem) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WishlistItem) GetProductId() uint64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *WishlistItem) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+func (x *WishlistItem) GetProduct() *WishlistProduct {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *WishlistItem) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+type AddWishlistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId     uint64                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWishlistItemRequest) Reset() {
+	*x = AddWishlistItemRequest{}
+	mi := &file_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWishlistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWishlistItemRequest) ProtoMessage() {}
+
+func (x *AddWishlistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWishlistItemRequest.ProtoReflect.Descriptor instead.
+func (*AddWishlistItemRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddWishlistItemRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddWishlistItemRequest) GetProductId() uint64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type AddWishlistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWishlistItemResponse) Reset() {
+	*x = AddWishlistItemResponse{}
+	mi := &file_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWishlistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWishlistItemResponse) ProtoMessage() {}
+
+func (x *AddWishlistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWishlistItemResponse.ProtoReflect.Descriptor instead.
+func (*AddWishlistItemResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AddWishlistItemResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddWishlistItemResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RemoveWishlistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId     uint64                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWishlistItemRequest) Reset() {
+	*x = RemoveWishlistItemRequest{}
+	mi := &file_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWishlistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWishlistItemRequest) ProtoMessage() {}
+
+func (x *RemoveWishlistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWishlistItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWishlistItemRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RemoveWishlistItemRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveWishlistItemRequest) GetProductId() uint64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type RemoveWishlistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWishlistItemResponse) Reset() {
+	*x = RemoveWishlistItemResponse{}
+	mi := &file_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWishlistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWishlistItemResponse) ProtoMessage() {}
+
+func (x *RemoveWishlistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWishlistItemResponse.ProtoReflect.Descriptor instead.
+func (*RemoveWishlistItemResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RemoveWishlistItemResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RemoveWishlistItemResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          uint64                 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      uint64                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWishlistRequest) Reset() {
+	*x = GetWishlistRequest{}
+	mi := &file_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWishlistRequest) ProtoMessage() {}
+
+func (x *GetWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWishlistRequest.ProtoReflect.Descriptor instead.
+func (*GetWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetWishlistRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetWishlistRequest) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetWishlistRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Items         []*WishlistItem        `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWishlistResponse) Reset() {
+	*x = GetWishlistResponse{}
+	mi := &file_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWishlistResponse) ProtoMessage() {}
+
+func (x *GetWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWishlistResponse.ProtoReflect.Descriptor instead.
+func (*GetWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetWishlistResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetWishlistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetWishlistResponse) GetItems() []*WishlistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetWishlistResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ShareWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareWishlistRequest) Reset() {
+	*x = ShareWishlistRequest{}
+	mi := &file_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareWishlistRequest) ProtoMessage() {}
+
+func (x *ShareWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareWishlistRequest.ProtoReflect.Descriptor instead.
+func (*ShareWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ShareWishlistRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ShareWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareWishlistResponse) Reset() {
+	*x = ShareWishlistResponse{}
+	mi := &file_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareWishlistResponse) ProtoMessage() {}
+
+func (x *ShareWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareWishlistResponse.ProtoReflect.Descriptor instead.
+func (*ShareWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ShareWishlistResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ShareWishlistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ShareWishlistResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UnshareWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareWishlistRequest) Reset() {
+	*x = UnshareWishlistRequest{}
+	mi := &file_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareWishlistRequest) ProtoMessage() {}
+
+func (x *UnshareWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareWishlistRequest.ProtoReflect.Descriptor instead.
+func (*UnshareWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UnshareWishlistRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UnshareWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareWishlistResponse) Reset() {
+	*x = UnshareWishlistResponse{}
+	mi := &file_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareWishlistResponse) ProtoMessage() {}
+
+func (x *UnshareWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareWishlistResponse.ProtoReflect.Descriptor instead.
+func (*UnshareWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UnshareWishlistResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UnshareWishlistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetSharedWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Page          uint64                 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      uint64                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedWishlistRequest) Reset() {
+	*x = GetSharedWishlistRequest{}
+	mi := &file_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedWishlistRequest) ProtoMessage() {}
+
+func (x *GetSharedWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedWishlistRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetSharedWishlistRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetSharedWishlistRequest) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetSharedWishlistRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetSharedWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Items         []*WishlistItem        `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedWishlistResponse) Reset() {
+	*x = GetSharedWishlistResponse{}
+	mi := &file_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedWishlistResponse) ProtoMessage() {}
+
+func (x *GetSharedWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedWishlistResponse.ProtoReflect.Descriptor instead.
+func (*GetSharedWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetSharedWishlistResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetSharedWishlistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetSharedWishlistResponse) GetItems() []*WishlistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetSharedWishlistResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 var File_user_proto protoreflect.FileDescriptor
 
 const file_user_proto_rawDesc = "" +
@@ -1075,7 +1937,65 @@ const file_user_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\"K\n" +
 	"\x15DelSellerByIDResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
-	"\asuccess\x18\x02 \x01(\bR\asuccess2\xf1\x06\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\";\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xa8\x01\n" +
+	"\x0fWishlistProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tseller_id\x18\x04 \x01(\x04R\bsellerId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x120\n" +
+	"\x05price\x18\a \x01(\v2\x1a.user_service.pkg.pb.MoneyR\x05priceJ\x04\b\x03\x10\x04J\x04\b\x06\x10\a\"\xc2\x01\n" +
+	"\fWishlistItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x04R\tproductId\x125\n" +
+	"\badded_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\x12>\n" +
+	"\aproduct\x18\x03 \x01(\v2$.user_service.pkg.pb.WishlistProductR\aproduct\x12\x1c\n" +
+	"\tavailable\x18\x04 \x01(\bR\tavailable\"b\n" +
+	"\x16AddWishlistItemRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x06userId\x12&\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x04B\a\xbaH\x042\x02 \x00R\tproductId\"M\n" +
+	"\x17AddWishlistItemResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"e\n" +
+	"\x19RemoveWishlistItemRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x06userId\x12&\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x04B\a\xbaH\x042\x02 \x00R\tproductId\"P\n" +
+	"\x1aRemoveWishlistItemResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"{\n" +
+	"\x12GetWishlistRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x06userId\x12\x1b\n" +
+	"\x04page\x18\x02 \x01(\x04B\a\xbaH\x042\x02 \x00R\x04page\x12&\n" +
+	"\tpage_size\x18\x03 \x01(\x04B\t\xbaH\x062\x04\x18d \x00R\bpageSize\"\x98\x01\n" +
+	"\x13GetWishlistResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x127\n" +
+	"\x05items\x18\x03 \x03(\v2!.user_service.pkg.pb.WishlistItemR\x05items\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\"8\n" +
+	"\x14ShareWishlistRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x06userId\"a\n" +
+	"\x15ShareWishlistResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\":\n" +
+	"\x16UnshareWishlistRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x04B\a\xbaH\x042\x02 \x00R\x06userId\"M\n" +
+	"\x17UnshareWishlistResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"~\n" +
+	"\x18GetSharedWishlistRequest\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x05token\x12\x1b\n" +
+	"\x04page\x18\x02 \x01(\x04B\a\xbaH\x042\x02 \x00R\x04page\x12&\n" +
+	"\tpage_size\x18\x03 \x01(\x04B\t\xbaH\x062\x04\x18d \x00R\bpageSize\"\x9e\x01\n" +
+	"\x19GetSharedWishlistResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x127\n" +
+	"\x05items\x18\x03 \x03(\v2!.user_service.pkg.pb.WishlistItemR\x05items\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total2\x82\f\n" +
 	"\vUserService\x12`\n" +
 	"\vCreateBuyer\x12'.user_service.pkg.pb.CreateBuyerRequest\x1a(.user_service.pkg.pb.CreateBuyerResponse\x12x\n" +
 	"\x13UpdateBuyerByUserID\x12/.user_service.pkg.pb.UpdateBuyerByUserIDRequest\x1a0.user_service.pkg.pb.UpdateBuyerByUserIDResponse\x12o\n" +
@@ -1084,7 +2004,13 @@ const file_user_proto_rawDesc = "" +
 	"\fCreateSeller\x12(.user_service.pkg.pb.CreateSellerRequest\x1a).user_service.pkg.pb.CreateSellerResponse\x12o\n" +
 	"\x10UpdateSellerByID\x12,.user_service.pkg.pb.UpdateSellerByIDRequest\x1a-.user_service.pkg.pb.UpdateSellerByIDResponse\x12f\n" +
 	"\rGetSellerByID\x12).user_service.pkg.pb.GetSellerByIDRequest\x1a*.user_service.pkg.pb.GetSellerByIDResponse\x12f\n" +
-	"\rDelSellerByID\x12).user_service.pkg.pb.DelSellerByIDRequest\x1a*.user_service.pkg.pb.DelSellerByIDResponseB\x15Z\x13user-service/userpbb\x06proto3"
+	"\rDelSellerByID\x12).user_service.pkg.pb.DelSellerByIDRequest\x1a*.user_service.pkg.pb.DelSellerByIDResponse\x12l\n" +
+	"\x0fAddWishlistItem\x12+.user_service.pkg.pb.AddWishlistItemRequest\x1a,.user_service.pkg.pb.AddWishlistItemResponse\x12u\n" +
+	"\x12RemoveWishlistItem\x12..user_service.pkg.pb.RemoveWishlistItemRequest\x1a/.user_service.pkg.pb.RemoveWishlistItemResponse\x12`\n" +
+	"\vGetWishlist\x12'.user_service.pkg.pb.GetWishlistRequest\x1a(.user_service.pkg.pb.GetWishlistResponse\x12f\n" +
+	"\rShareWishlist\x12).user_service.pkg.pb.ShareWishlistRequest\x1a*.user_service.pkg.pb.ShareWishlistResponse\x12l\n" +
+	"\x0fUnshareWishlist\x12+.user_service.pkg.pb.UnshareWishlistRequest\x1a,.user_service.pkg.pb.UnshareWishlistResponse\x12r\n" +
+	"\x11GetSharedWishlist\x12-.user_service.pkg.pb.GetSharedWishlistRequest\x1a..user_service.pkg.pb.GetSharedWishlistResponseB\x15Z\x13user-service/userpbb\x06proto3"
 
 var (
 	file_user_proto_rawDescOnce sync.Once
@@ -1098,7 +2024,7 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_user_proto_goTypes = []any{
 	(*Buyer)(nil),                       // 0: user_service.pkg.pb.Buyer
 	(*Seller)(nil),                      // 1: user_service.pkg.pb.Seller
@@ -1118,38 +2044,70 @@ var file_user_proto_goTypes = []any{
 	(*GetSellerByIDResponse)(nil),       // 15: user_service.pkg.pb.GetSellerByIDResponse
 	(*DelSellerByIDRequest)(nil),        // 16: user_service.pkg.pb.DelSellerByIDRequest
 	(*DelSellerByIDResponse)(nil),       // 17: user_service.pkg.pb.DelSellerByIDResponse
-	(*timestamppb.Timestamp)(nil),       // 18: google.protobuf.Timestamp
+	(*Money)(nil),                       // 18: user_service.pkg.pb.Money
+	(*WishlistProduct)(nil),             // 19: user_service.pkg.pb.WishlistProduct
+	(*WishlistItem)(nil),                // 20: user_service.pkg.pb.WishlistItem
+	(*AddWishlistItemRequest)(nil),      // 21: user_service.pkg.pb.AddWishlistItemRequest
+	(*AddWishlistItemResponse)(nil),     // 22: user_service.pkg.pb.AddWishlistItemResponse
+	(*RemoveWishlistItemRequest)(nil),   // 23: user_service.pkg.pb.RemoveWishlistItemRequest
+	(*RemoveWishlistItemResponse)(nil),  // 24: user_service.pkg.pb.RemoveWishlistItemResponse
+	(*GetWishlistRequest)(nil),          // 25: user_service.pkg.pb.GetWishlistRequest
+	(*GetWishlistResponse)(nil),         // 26: user_service.pkg.pb.GetWishlistResponse
+	(*ShareWishlistRequest)(nil),        // 27: user_service.pkg.pb.ShareWishlistRequest
+	(*ShareWishlistResponse)(nil),       // 28: user_service.pkg.pb.ShareWishlistResponse
+	(*UnshareWishlistRequest)(nil),      // 29: user_service.pkg.pb.UnshareWishlistRequest
+	(*UnshareWishlistResponse)(nil),     // 30: user_service.pkg.pb.UnshareWishlistResponse
+	(*GetSharedWishlistRequest)(nil),    // 31: user_service.pkg.pb.GetSharedWishlistRequest
+	(*GetSharedWishlistResponse)(nil),   // 32: user_service.pkg.pb.GetSharedWishlistResponse
+	(*timestamppb.Timestamp)(nil),       // 33: google.protobuf.Timestamp
 }
 var file_user_proto_depIdxs = []int32{
-	18, // 0: user_service.pkg.pb.Buyer.date_of_birth:type_name -> google.protobuf.Timestamp
-	18, // 1: user_service.pkg.pb.Seller.date_of_birth:type_name -> google.protobuf.Timestamp
+	33, // 0: user_service.pkg.pb.Buyer.date_of_birth:type_name -> google.protobuf.Timestamp
+	33, // 1: user_service.pkg.pb.Seller.date_of_birth:type_name -> google.protobuf.Timestamp
 	0,  // 2: user_service.pkg.pb.CreateBuyerRequest.buyer:type_name -> user_service.pkg.pb.Buyer
 	0,  // 3: user_service.pkg.pb.UpdateBuyerByUserIDRequest.buyer:type_name -> user_service.pkg.pb.Buyer
 	0,  // 4: user_service.pkg.pb.GetBuyerByUserIDResponse.buyer:type_name -> user_service.pkg.pb.Buyer
 	1,  // 5: user_service.pkg.pb.CreateSellerRequest.seller:type_name -> user_service.pkg.pb.Seller
 	1,  // 6: user_service.pkg.pb.UpdateSellerByIDRequest.seller:type_name -> user_service.pkg.pb.Seller
 	1,  // 7: user_service.pkg.pb.GetSellerByIDResponse.seller:type_name -> user_service.pkg.pb.Seller
-	2,  // 8: user_service.pkg.pb.UserService.CreateBuyer:input_type -> user_service.pkg.pb.CreateBuyerRequest
-	4,  // 9: user_service.pkg.pb.UserService.UpdateBuyerByUserID:input_type -> user_service.pkg.pb.UpdateBuyerByUserIDRequest
-	6,  // 10: user_service.pkg.pb.UserService.GetBuyerByUserID:input_type -> user_service.pkg.pb.GetBuyerByUserIDRequest
-	8,  // 11: user_service.pkg.pb.UserService.DelBuyerByUserID:input_type -> user_service.pkg.pb.DelBuyerByUserIDRequest
-	10, // 12: user_service.pkg.pb.UserService.CreateSeller:input_type -> user_service.pkg.pb.CreateSellerRequest
-	12, // 13: user_service.pkg.pb.UserService.UpdateSellerByID:input_type -> user_service.pkg.pb.UpdateSellerByIDRequest
-	14, // 14: user_service.pkg.pb.UserService.GetSellerByID:input_type -> user_service.pkg.pb.GetSellerByIDRequest
-	16, // 15: user_service.pkg.pb.UserService.DelSellerByID:input_type -> user_service.pkg.pb.DelSellerByIDRequest
-	3,  // 16: user_service.pkg.pb.UserService.CreateBuyer:output_type -> user_service.pkg.pb.CreateBuyerResponse
-	5,  // 17: user_service.pkg.pb.UserService.UpdateBuyerByUserID:output_type -> user_service.pkg.pb.UpdateBuyerByUserIDResponse
-	7,  // 18: user_service.pkg.pb.UserService.GetBuyerByUserID:output_type -> user_service.pkg.pb.GetBuyerByUserIDResponse
-	9,  // 19: user_service.pkg.pb.UserService.DelBuyerByUserID:output_type -> user_service.pkg.pb.DelBuyerByUserIDResponse
-	11, // 20: user_service.pkg.pb.UserService.CreateSeller:output_type -> user_service.pkg.pb.CreateSellerResponse
-	13, // 21: user_service.pkg.pb.UserService.UpdateSellerByID:output_type -> user_service.pkg.pb.UpdateSellerByIDResponse
-	15, // 22: user_service.pkg.pb.UserService.GetSellerByID:output_type -> user_service.pkg.pb.GetSellerByIDResponse
-	17, // 23: user_service.pkg.pb.UserService.DelSellerByID:output_type -> user_service.pkg.pb.DelSellerByIDResponse
-	16, // [16:24] is the sub-list for method output_type
-	8,  // [8:16] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	18, // 8: user_service.pkg.pb.WishlistProduct.price:type_name -> user_service.pkg.pb.Money
+	33, // 9: user_service.pkg.pb.WishlistItem.added_at:type_name -> google.protobuf.Timestamp
+	19, // 10: user_service.pkg.pb.WishlistItem.product:type_name -> user_service.pkg.pb.WishlistProduct
+	20, // 11: user_service.pkg.pb.GetWishlistResponse.items:type_name -> user_service.pkg.pb.WishlistItem
+	20, // 12: user_service.pkg.pb.GetSharedWishlistResponse.items:type_name -> user_service.pkg.pb.WishlistItem
+	2,  // 13: user_service.pkg.pb.UserService.CreateBuyer:input_type -> user_service.pkg.pb.CreateBuyerRequest
+	4,  // 14: user_service.pkg.pb.UserService.UpdateBuyerByUserID:input_type -> user_service.pkg.pb.UpdateBuyerByUserIDRequest
+	6,  // 15: user_service.pkg.pb.UserService.GetBuyerByUserID:input_type -> user_service.pkg.pb.GetBuyerByUserIDRequest
+	8,  // 16: user_service.pkg.pb.UserService.DelBuyerByUserID:input_type -> user_service.pkg.pb.DelBuyerByUserIDRequest
+	10, // 17: user_service.pkg.pb.UserService.CreateSeller:input_type -> user_service.pkg.pb.CreateSellerRequest
+	12, // 18: user_service.pkg.pb.UserService.UpdateSellerByID:input_type -> user_service.pkg.pb.UpdateSellerByIDRequest
+	14, // 19: user_service.pkg.pb.UserService.GetSellerByID:input_type -> user_service.pkg.pb.GetSellerByIDRequest
+	16, // 20: user_service.pkg.pb.UserService.DelSellerByID:input_type -> user_service.pkg.pb.DelSellerByIDRequest
+	21, // 21: user_service.pkg.pb.UserService.AddWishlistItem:input_type -> user_service.pkg.pb.AddWishlistItemRequest
+	23, // 22: user_service.pkg.pb.UserService.RemoveWishlistItem:input_type -> user_service.pkg.pb.RemoveWishlistItemRequest
+	25, // 23: user_service.pkg.pb.UserService.GetWishlist:input_type -> user_service.pkg.pb.GetWishlistRequest
+	27, // 24: user_service.pkg.pb.UserService.ShareWishlist:input_type -> user_service.pkg.pb.ShareWishlistRequest
+	29, // 25: user_service.pkg.pb.UserService.UnshareWishlist:input_type -> user_service.pkg.pb.UnshareWishlistRequest
+	31, // 26: user_service.pkg.pb.UserService.GetSharedWishlist:input_type -> user_service.pkg.pb.GetSharedWishlistRequest
+	3,  // 27: user_service.pkg.pb.UserService.CreateBuyer:output_type -> user_service.pkg.pb.CreateBuyerResponse
+	5,  // 28: user_service.pkg.pb.UserService.UpdateBuyerByUserID:output_type -> user_service.pkg.pb.UpdateBuyerByUserIDResponse
+	7,  // 29: user_service.pkg.pb.UserService.GetBuyerByUserID:output_type -> user_service.pkg.pb.GetBuyerByUserIDResponse
+	9,  // 30: user_service.pkg.pb.UserService.DelBuyerByUserID:output_type -> user_service.pkg.pb.DelBuyerByUserIDResponse
+	11, // 31: user_service.pkg.pb.UserService.CreateSeller:output_type -> user_service.pkg.pb.CreateSellerResponse
+	13, // 32: user_service.pkg.pb.UserService.UpdateSellerByID:output_type -> user_service.pkg.pb.UpdateSellerByIDResponse
+	15, // 33: user_service.pkg.pb.UserService.GetSellerByID:output_type -> user_service.pkg.pb.GetSellerByIDResponse
+	17, // 34: user_service.pkg.pb.UserService.DelSellerByID:output_type -> user_service.pkg.pb.DelSellerByIDResponse
+	22, // 35: user_service.pkg.pb.UserService.AddWishlistItem:output_type -> user_service.pkg.pb.AddWishlistItemResponse
+	24, // 36: user_service.pkg.pb.UserService.RemoveWishlistItem:output_type -> user_service.pkg.pb.RemoveWishlistItemResponse
+	26, // 37: user_service.pkg.pb.UserService.GetWishlist:output_type -> user_service.pkg.pb.GetWishlistResponse
+	28, // 38: user_service.pkg.pb.UserService.ShareWishlist:output_type -> user_service.pkg.pb.ShareWishlistResponse
+	30, // 39: user_service.pkg.pb.UserService.UnshareWishlist:output_type -> user_service.pkg.pb.UnshareWishlistResponse
+	32, // 40: user_service.pkg.pb.UserService.GetSharedWishlist:output_type -> user_service.pkg.pb.GetSharedWishlistResponse
+	27, // [27:41] is the sub-list for method output_type
+	13, // [13:27] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
@@ -1163,7 +2121,7 @@ func file_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -16,7 +16,8 @@ CREATE TABLE IF NOT EXISTS accounts (
 CREATE TABLE IF NOT EXISTS products (
     id BIGSERIAL PRIMARY KEY,
     name VARCHAR(255) NOT NULL,
-    price DOUBLE PRECISION NOT NULL,
+    price_amount BIGINT NOT NULL DEFAULT 0,
+    currency CHAR(3) NOT NULL DEFAULT 'VND',
     seller_id BIGINT NOT NULL,
     inventory BIGINT NOT NULL,
     attributes JSONB NOT NULL,
@@ -31,9 +32,9 @@ VALUES
 ON CONFLICT (username) DO NOTHING;
 
 -- Dữ liệu mẫu cho bảng Product
-INSERT INTO products (name, price, seller_id, inventory, attributes)
+INSERT INTO products (name, price_amount, currency, seller_id, inventory, attributes)
 VALUES
-    ('Iphone 15 Pro', 29990000, 1, 50, '{"color": "gray", "storage": "256GB"}'),
-    ('Macbook Air M3', 34990000, 1, 20, '{"color": "silver", "ram": "16GB"}'),
-    ('AirPods Pro 2', 5990000, 1, 100, '{"color": "white", "noise_cancel": true}')
+    ('Iphone 15 Pro', 29990000, 'VND', 1, 50, '{"color": "gray", "storage": "256GB"}'),
+    ('Macbook Air M3', 34990000, 'VND', 1, 20, '{"color": "silver", "ram": "16GB"}'),
+    ('AirPods Pro 2', 5990000, 'VND', 1, 100, '{"color": "white", "noise_cancel": true}')
 ON CONFLICT DO NOTHING;
@@ -1,10 +1,16 @@
 package main
 
 import (
+	"auth-service/internal/repository"
 	"auth-service/internal/server"
+	"auth-service/pkg/model"
 	authpb "auth-service/pkg/pb"
 	"context"
+	"errors"
 	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
 )
 
 func SeedAccounts(s *server.AuthServer) {
@@ -15,8 +21,8 @@ func SeedAccounts(s *server.AuthServer) {
 		Password string
 		Role     string
 	}{
-		{"buyer1", "password", "buyer"},
-		{"seller1", "password", "seller_admin"},
+		{"buyer1", "password", model.RoleBuyer},
+		{"seller1", "password", model.RoleSellerAdmin},
 	}
 
 	for _, acc := range accounts {
@@ -32,3 +38,31 @@ func SeedAccounts(s *server.AuthServer) {
 		fmt.Printf("✅ Seed account %s thành công\n", acc.Username)
 	}
 }
+
+// SeedAdminAccount create the platform admin account, admin can not be registered through Register
+func SeedAdminAccount(accountRepo *repository.AccountRepository) {
+	ctx := context.Background()
+
+	_, err := accountRepo.GetAccountByUsernameRole(ctx, "admin1", model.RoleAdmin)
+	if err == nil {
+		return
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		fmt.Printf("❌ Seed account admin1 thất bại: %v\n", err)
+		return
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	if err != nil {
+		fmt.Printf("❌ Seed account admin1 thất bại: %v\n", err)
+		return
+	}
+	if err := accountRepo.CreateAccount(ctx, &model.Account{
+		Username: "admin1",
+		Password: string(hashedPassword),
+		Role:     model.RoleAdmin,
+	}); err != nil {
+		fmt.Printf("❌ Seed account admin1 thất bại: %v\n", err)
+		return
+	}
+	fmt.Printf("✅ Seed account admin1 thành công\n")
+}
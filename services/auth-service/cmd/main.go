@@ -5,12 +5,16 @@ import (
 	"auth-service/internal/repository"
 	"auth-service/internal/server"
 	"auth-service/internal/service"
+	"auth-service/internal/service/notify"
+	"auth-service/internal/service/oidc"
 	"auth-service/pkg/model"
 	authpb "auth-service/pkg/pb"
 	"context"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
+	"os"
 	"time"
 
 	"github.com/lpernett/godotenv"
@@ -43,9 +47,31 @@ func main() {
 
 	// Create Repository, Service
 	accountRepo := repository.NewAccountRepository(serviceConfig.PostgresDB)
-	authService := service.NewAuthService(accountRepo, envConfig.JWTSecret, envConfig.JWTExpireTime, serviceConfig.ZapLogger,
+	notifier, err := notify.New(&notify.Config{Sink: envConfig.Notifier, FilePath: envConfig.NotifierFile}, serviceConfig.ZapLogger)
+	if err != nil {
+		log.Fatalf("Can not create notifier: %v", err)
+	}
+	authService := service.NewAuthService(accountRepo, envConfig.JWTExpireTime, serviceConfig.RedisClient, notifier,
+		oidc.NewProviders(envConfig.OIDCProviders), envConfig.PasswordPolicy, serviceConfig.ZapLogger,
 		serviceConfig.KafkaInstance.KafkaProducer, serviceConfig.KafkaInstance.KafkaConsumer, serviceConfig.KafkaInstance.KafkaClient)
 
+	// "auth-service rotate-keys" sign new tokens with a new key and exit, the previous key stays published
+	// until the tokens it signed expire
+	if len(os.Args) > 1 && os.Args[1] == "rotate-keys" {
+		key, err := authService.RotateSigningKey(context.Background())
+		if err != nil {
+			log.Fatalf("Can not rotate signing key: %v", err)
+		}
+		log.Printf("Signing key rotated, new kid %s", key.KID)
+		return
+	}
+	if err := authService.EnsureSigningKey(context.Background()); err != nil {
+		log.Fatalf("Can not create signing key: %v", err)
+	}
+	if err := authService.SyncSystemRoles(context.Background()); err != nil {
+		log.Fatalf("Can not sync system roles: %v", err)
+	}
+
 	// Create Server
 	authServer := server.AuthServer{
 		AuthService: authService,
@@ -60,6 +86,7 @@ func main() {
 
 	// Init sample data
 	SeedAccounts(&authServer)
+	SeedAdminAccount(accountRepo)
 
 	// Test
 	topic1 := "auth.change_password"
@@ -71,6 +98,22 @@ func main() {
 	ctx1 := context.Context(context.Background())
 	authService.ProducerPwdVerKafkaEventWorker(ctx1, 3*time.Second, 100, topic1)
 
+	// Publish logouts to the gateway denylist
+	topic3 := "auth.revoke_token"
+	conn3, err := kafka.DialLeader(context.Background(), "tcp", "broker1:9092", topic3, 0)
+	if err != nil {
+		panic(err)
+	}
+	defer conn3.Close()
+	authService.ProducerTokRevKafkaEventWorker(ctx1, time.Second, 100, topic3)
+
+	// Topic of login lockouts, published by Login
+	conn4, err := kafka.DialLeader(context.Background(), "tcp", "broker1:9092", service.TopicLoginLocked, 0)
+	if err != nil {
+		panic(err)
+	}
+	defer conn4.Close()
+
 	// Chạy consumer trong goroutine
 	ctx2 := context.Context(context.Background())
 	topic2 := "user.create_seller"
@@ -80,6 +123,15 @@ func main() {
 		}
 	}()
 
+	// Serve JWKS over HTTP
+	jwksMux := http.NewServeMux()
+	jwksMux.HandleFunc(server.JWKSPath, authServer.JWKSHandler)
+	go func() {
+		if err := http.ListenAndServe(envConfig.JWKSAddr, jwksMux); err != nil {
+			log.Printf("JWKS server stopped with error: %v", err)
+		}
+	}()
+
 	// Run
 	log.Printf("Auth Server listening at %v", lis.Addr())
 	reflection.Register(s)
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"auth-service/internal/service/oidc"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// newTestProvider start the mock provider and return a relying party registered at it
+func newTestProvider(t *testing.T) *oidc.Provider {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &provider{key: key, codes: make(map[string]*authorization)}
+	server := httptest.NewServer(p.routes())
+	t.Cleanup(server.Close)
+	p.issuer = server.URL
+
+	return oidc.NewProvider(&oidc.Config{
+		Name:        "mock",
+		Issuer:      server.URL,
+		ClientID:    "mini-marketplace",
+		RedirectURL: "http://localhost:8080/auth/oidc/mock/callback",
+	})
+}
+
+// authorize follow the authorization URL of a login and return the code of the redirect back
+func authorize(t *testing.T, rp *oidc.Provider, state, nonce, challenge, loginHint string) string {
+	t.Helper()
+	authURL, err := rp.AuthCodeURL(context.Background(), state, nonce, challenge)
+	if err != nil {
+		t.Fatalf("AuthCodeURL() error = %v", err)
+	}
+	if loginHint != "" {
+		authURL += "&login_hint=" + url.QueryEscape(loginHint)
+	}
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	res, err := client.Get(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusFound {
+		t.Fatalf("authorize status = %d, want %d", res.StatusCode, http.StatusFound)
+	}
+	callback, err := url.Parse(res.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(callback.String(), rp.Config.RedirectURL) {
+		t.Fatalf("redirect to %s, want %s", callback, rp.Config.RedirectURL)
+	}
+	if got := callback.Query().Get("state"); got != state {
+		t.Fatalf("redirect state = %q, want %q", got, state)
+	}
+	return callback.Query().Get("code")
+}
+
+func TestLoginAgainstMockProvider(t *testing.T) {
+	rp := newTestProvider(t)
+	verifier, challenge, err := oidc.NewPKCE()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		loginHint string
+		verifier  string
+		nonce     string
+		wantSub   string
+		wantErr   bool
+	}{
+		{"default user", "", verifier, "nonce-1", defaultLogin, false},
+		{"login hint", "alice", verifier, "nonce-1", "alice", false},
+		{"wrong code verifier", "", verifier + "x", "nonce-1", "", true},
+		{"wrong nonce", "", verifier, "nonce-2", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := authorize(t, rp, "state-1", "nonce-1", challenge, tt.loginHint)
+			claims, err := rp.Exchange(context.Background(), code, tt.verifier, tt.nonce)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Exchange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if claims.Subject != tt.wantSub || claims.PreferredUsername != tt.wantSub {
+				t.Errorf("Exchange() subject = %q, preferred username = %q, want %q", claims.Subject, claims.PreferredUsername, tt.wantSub)
+			}
+			if !claims.EmailVerified || claims.Email != tt.wantSub+"@example.com" {
+				t.Errorf("Exchange() email = %q verified %v", claims.Email, claims.EmailVerified)
+			}
+		})
+	}
+}
+
+func TestCodeIsSingleUse(t *testing.T) {
+	rp := newTestProvider(t)
+	verifier, challenge, err := oidc.NewPKCE()
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := authorize(t, rp, "state-1", "nonce-1", challenge, "")
+	if _, err := rp.Exchange(context.Background(), code, verifier, "nonce-1"); err != nil {
+		t.Fatalf("first Exchange() error = %v", err)
+	}
+	if _, err := rp.Exchange(context.Background(), code, verifier, "nonce-1"); err == nil {
+		t.Error("second Exchange() of the same code succeeded")
+	}
+}
+
+func TestVerifyIDTokenRejectsForeignTokens(t *testing.T) {
+	rp := newTestProvider(t)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sign := func(claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = keyID
+		signed, err := token.SignedString(otherKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+	claims := jwt.MapClaims{
+		"iss":   rp.Config.Issuer,
+		"sub":   defaultLogin,
+		"aud":   rp.Config.ClientID,
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"nonce": "nonce-1",
+	}
+
+	tests := []struct {
+		name    string
+		idToken string
+	}{
+		{"malformed", "not-a-jwt"},
+		{"unsigned", "eyJhbGciOiJub25lIn0.eyJzdWIiOiJtb2NrLXVzZXIifQ."},
+		{"signed by another key", sign(claims)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := rp.VerifyIDToken(context.Background(), tt.idToken, "nonce-1")
+			if !errors.Is(err, oidc.ErrInvalidIDToken) {
+				t.Errorf("VerifyIDToken() error = %v, want %v", err, oidc.ErrInvalidIDToken)
+			}
+		})
+	}
+}
@@ -1,25 +1,22 @@
 package config
 
 import (
-	"errors"
+	"auth-service/internal/service/oidc"
+	"auth-service/internal/service/password"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
 type EnvConfig struct {
-	JWTSecret     string
-	JWTExpireTime time.Duration
-}
-
-// initJWTSecret load env about jwt
-func initJWTSecret() (string, error) {
-	jwtSecret := os.Getenv("JWT_SECRET")
-	if jwtSecret == "" {
-		return "", errors.New("JWT secret not set")
-	}
-	return jwtSecret, nil
+	JWTExpireTime  time.Duration
+	JWKSAddr       string
+	Notifier       string
+	NotifierFile   string
+	OIDCProviders  []*oidc.Config
+	PasswordPolicy *password.Policy
 }
 
 // initJWTExpireTime load env about jwt expire time
@@ -36,20 +33,99 @@ func initJWTExpireTime() (time.Duration, error) {
 	return jwtExpireTime, nil
 }
 
+// initJWKSAddr load env about the address of the HTTP JWKS endpoint
+func initJWKSAddr() string {
+	jwksAddr := os.Getenv("JWKS_ADDR")
+	if jwksAddr == "" {
+		return ":8081"
+	}
+	return jwksAddr
+}
+
+// initNotifier load env about the sink of notifications, see notify.New
+func initNotifier() (string, string) {
+	notifierFile := os.Getenv("NOTIFIER_FILE")
+	if notifierFile == "" {
+		notifierFile = "notifications.log"
+	}
+	return os.Getenv("NOTIFIER"), notifierFile
+}
+
+// initOIDCProviders load env about identity providers. OIDC_PROVIDERS list provider names separated by commas,
+// each name N is configured by OIDC_<N>_ISSUER, OIDC_<N>_CLIENT_ID, OIDC_<N>_CLIENT_SECRET (optional),
+// OIDC_<N>_SCOPES (optional, separated by spaces) and OIDC_<N>_REDIRECT_URL.
+func initOIDCProviders() ([]*oidc.Config, error) {
+	var configs []*oidc.Config
+	for _, name := range strings.Split(os.Getenv("OIDC_PROVIDERS"), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		config := &oidc.Config{
+			Name:         name,
+			Issuer:       os.Getenv(prefix + "ISSUER"),
+			ClientID:     os.Getenv(prefix + "CLIENT_ID"),
+			ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+			Scopes:       strings.Fields(os.Getenv(prefix + "SCOPES")),
+			RedirectURL:  os.Getenv(prefix + "REDIRECT_URL"),
+		}
+		if config.Issuer == "" || config.ClientID == "" || config.RedirectURL == "" {
+			return nil, fmt.Errorf("%sISSUER, %sCLIENT_ID and %sREDIRECT_URL env variables are required", prefix, prefix, prefix)
+		}
+		configs = append(configs, config)
+	}
+	return configs, nil
+}
+
+// initPasswordPolicy load env about the rules of new passwords. PASSWORD_BREACHED_LIST is the path of a breached
+// password list, see password.LoadBreachedList, it is loaded at start and the check is skipped when it is empty.
+func initPasswordPolicy() (*password.Policy, error) {
+	policy := &password.Policy{
+		MinLength:      GetEnvIntWithDefault("PASSWORD_MIN_LENGTH", 8),
+		MaxLength:      GetEnvIntWithDefault("PASSWORD_MAX_LENGTH", password.MaxBcryptLength),
+		RequireUpper:   GetEnvBoolWithDefault("PASSWORD_REQUIRE_UPPER", false),
+		RequireLower:   GetEnvBoolWithDefault("PASSWORD_REQUIRE_LOWER", false),
+		RequireDigit:   GetEnvBoolWithDefault("PASSWORD_REQUIRE_DIGIT", false),
+		RequireSymbol:  GetEnvBoolWithDefault("PASSWORD_REQUIRE_SYMBOL", false),
+		ForbidUsername: GetEnvBoolWithDefault("PASSWORD_FORBID_USERNAME", true),
+	}
+	if path := os.Getenv("PASSWORD_BREACHED_LIST"); path != "" {
+		breached, err := password.LoadBreachedList(path, GetEnvIntWithDefault("PASSWORD_BREACHED_MIN_COUNT", 1))
+		if err != nil {
+			return nil, fmt.Errorf("can not load breached password list, %w", err)
+		}
+		fmt.Printf("Loaded %d breached password hashes from %s\n", breached.Len(), path)
+		policy.Breached = breached
+	}
+	return policy, nil
+}
+
 // NewEnvConfig load env config
 func NewEnvConfig() (*EnvConfig, error) {
-	jwtSecret, err := initJWTSecret()
+	jwtExpireTime, err := initJWTExpireTime()
 	if err != nil {
 		return nil, err
 	}
 
-	jwtExpireTime, err := initJWTExpireTime()
+	notifier, notifierFile := initNotifier()
+
+	oidcProviders, err := initOIDCProviders()
+	if err != nil {
+		return nil, err
+	}
+
+	passwordPolicy, err := initPasswordPolicy()
 	if err != nil {
 		return nil, err
 	}
 
 	return &EnvConfig{
-		JWTSecret:     jwtSecret,
-		JWTExpireTime: jwtExpireTime,
+		JWTExpireTime:  jwtExpireTime,
+		JWKSAddr:       initJWKSAddr(),
+		Notifier:       notifier,
+		NotifierFile:   notifierFile,
+		OIDCProviders:  oidcProviders,
+		PasswordPolicy: passwordPolicy,
 	}, nil
 }
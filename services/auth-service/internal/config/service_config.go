@@ -110,7 +110,8 @@ func initPostgresDB() (*gorm.DB, error) {
 	sqlDB.SetMaxIdleConns(1000)
 	sqlDB.SetMaxOpenConns(1000)
 	sqlDB.SetConnMaxLifetime(time.Hour)
-	db.AutoMigrate(&model.Account{}, &outbox.PwdVersionEvent{})
+	db.AutoMigrate(&model.Account{}, &outbox.PwdVersionEvent{}, &outbox.TokenRevocationEvent{}, &model.RefreshToken{}, &model.AuditLog{}, &model.SigningKey{}, &model.PasswordResetToken{}, &model.TwoFactor{}, &model.RecoveryCode{}, &model.ExternalIdentity{},
+		&model.Permission{}, &model.Role{}, &model.RolePermission{}, &model.Session{})
 
 	fmt.Println("Init postgres db successfully!")
 	return db, nil
@@ -185,3 +186,17 @@ func GetEnvIntWithDefault(key string, defaultValue int) int {
 	}
 	return i
 }
+
+func GetEnvBoolWithDefault(key string, defaultValue bool) bool {
+	str := os.Getenv(key)
+	if str == "" {
+		fmt.Printf("%s env variable not set, using default %v\n", key, defaultValue)
+		return defaultValue
+	}
+	b, err := strconv.ParseBool(str)
+	if err != nil {
+		fmt.Printf("%s env variable setted but not valid, using default %v\n", key, defaultValue)
+		return defaultValue
+	}
+	return b
+}
@@ -32,6 +32,15 @@ func (r *AccountRepository) GetAccountById(ctx context.Context, userID uint64) (
 	return acc, nil
 }
 
+// GetAccountIDsByStoreID get the IDs of the accounts of storeID
+func (r *AccountRepository) GetAccountIDsByStoreID(ctx context.Context, storeID uint64) ([]uint64, error) {
+	var userIDs []uint64
+	if err := r.DB.WithContext(ctx).Model(&model.Account{}).Where("store_id = ?", storeID).Order("id").Pluck("id", &userIDs).Error; err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
 // GetAccountByUsernameRole get account by username and role
 func (r *AccountRepository) GetAccountByUsernameRole(ctx context.Context, username string, role string) (*model.Account, error) {
 	var acc model.Account
@@ -98,3 +107,8 @@ func (r *AccountRepository) UpdateStoreID(ctx context.Context, id uint64, storeI
 	}
 	return nil
 }
+
+// CreateAuditLog save an audit entry
+func (r *AccountRepository) CreateAuditLog(ctx context.Context, auditLog *model.AuditLog) error {
+	return r.DB.WithContext(ctx).Create(auditLog).Error
+}
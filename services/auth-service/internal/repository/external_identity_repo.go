@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"auth-service/pkg/model"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrIdentityLinked is returned when an external identity is already linked to another account
+var ErrIdentityLinked = errors.New("external identity is linked to another account")
+
+// GetExternalIdentity get the identity of subject at issuer, gorm.ErrRecordNotFound when it is not linked
+func (r *AccountRepository) GetExternalIdentity(ctx context.Context, issuer, subject string) (*model.ExternalIdentity, error) {
+	var identity model.ExternalIdentity
+	if err := r.DB.WithContext(ctx).Where("issuer = ? AND subject = ?", issuer, subject).First(&identity).Error; err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
+
+// TouchExternalIdentity record a login through identity
+func (r *AccountRepository) TouchExternalIdentity(ctx context.Context, identity *model.ExternalIdentity) error {
+	return r.DB.WithContext(ctx).Model(identity).Updates(map[string]interface{}{
+		"last_login_at": time.Now(),
+		"email":         identity.Email,
+	}).Error
+}
+
+// UsernameExists check whether any account, of any role, has username
+func (r *AccountRepository) UsernameExists(ctx context.Context, username string) (bool, error) {
+	var count int64
+	if err := r.DB.WithContext(ctx).Model(&model.Account{}).Where("username = ?", username).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// CreateAccountWithExternalIdentity create account and link identity to it in one transaction
+func (r *AccountRepository) CreateAccountWithExternalIdentity(ctx context.Context, account *model.Account, identity *model.ExternalIdentity) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(account).Error; err != nil {
+			return err
+		}
+		identity.UserID = account.ID
+		if err := tx.Create(identity).Error; err != nil {
+			return err
+		}
+		return tx.Create(&model.AuditLog{
+			UserID: account.ID,
+			Event:  model.AuditIdentityLinked,
+			Detail: fmt.Sprintf("account created by %s login", identity.Provider),
+		}).Error
+	})
+}
+
+// LinkExternalIdentity link identity to an existing account, ErrIdentityLinked when another account has it
+func (r *AccountRepository) LinkExternalIdentity(ctx context.Context, identity *model.ExternalIdentity) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing model.ExternalIdentity
+		err := tx.Where("issuer = ? AND subject = ?", identity.Issuer, identity.Subject).First(&existing).Error
+		if err == nil {
+			if existing.UserID != identity.UserID {
+				return ErrIdentityLinked
+			}
+			*identity = existing
+			return nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if err := tx.Create(identity).Error; err != nil {
+			return err
+		}
+		return tx.Create(&model.AuditLog{
+			UserID: identity.UserID,
+			Event:  model.AuditIdentityLinked,
+			Detail: fmt.Sprintf("%s identity linked", identity.Provider),
+		}).Error
+	})
+}
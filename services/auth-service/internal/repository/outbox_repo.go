@@ -16,9 +16,7 @@ func (r *AccountRepository) CreateOrUpdatePwdVersionEvent(tx *gorm.DB, userID ui
 				PwdVersion: pwdVersion,
 				Status:     "PENDING",
 			}
-			if err = tx.Create(pwdVersionEvent).Error; err != nil {
-				return err
-			}
+			return tx.Create(pwdVersionEvent).Error
 		}
 		return err
 	}
@@ -45,3 +43,18 @@ func (r *AccountRepository) UpdatePwdVersionEventStatus(ctx context.Context, use
 	return r.DB.WithContext(ctx).Model(&outbox.PwdVersionEvent{}).Where("user_id = ?", userID).
 		Updates(map[string]interface{}{"status": status}).Error
 }
+
+func (r *AccountRepository) GetTokenRevocationEventNotPublish(limit int) ([]*outbox.TokenRevocationKafkaEvent, error) {
+	var events []*outbox.TokenRevocationKafkaEvent
+	result := r.DB.Model(&outbox.TokenRevocationEvent{}).Where("status IN ?", []string{"PENDING", "FAILED"}).
+		Order("created_at").Limit(limit).Find(&events)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return events, nil
+}
+
+func (r *AccountRepository) UpdateTokenRevocationEventStatus(ctx context.Context, id uint64, status string) error {
+	return r.DB.WithContext(ctx).Model(&outbox.TokenRevocationEvent{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"status": status}).Error
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"auth-service/pkg/model"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrPasswordResetTokenInvalid is returned for a reset token that is unknown, used or expired
+var ErrPasswordResetTokenInvalid = errors.New("invalid or expired password reset token")
+
+// CreatePasswordResetToken save token, the unused tokens requested before it for the same account can no longer be used
+func (r *AccountRepository) CreatePasswordResetToken(ctx context.Context, token *model.PasswordResetToken) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.PasswordResetToken{}).Where("user_id = ? AND used_at IS NULL", token.UserID).
+			Update("used_at", time.Now()).Error; err != nil {
+			return err
+		}
+		return tx.Create(token).Error
+	})
+}
+
+// RedeemPasswordResetToken use the token of tokenHash to set the password returned by hashPassword for its account,
+// and bump PwdVersion through the outbox like UpdatePassword so every session of the account is cut off.
+// Its refresh tokens and sessions are revoked like RevokeAllSessions too, with access tokens denied for accessTokenTTL,
+// so a stolen refresh token can not be rotated after the reset.
+// An error of hashPassword is returned as is and leaves the token unused.
+func (r *AccountRepository) RedeemPasswordResetToken(ctx context.Context, tokenHash string, hashPassword func(*model.Account) (string, error), accessTokenTTL time.Duration) (*model.Account, error) {
+	var account model.Account
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var token model.PasswordResetToken
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("token_hash = ?", tokenHash).First(&token).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrPasswordResetTokenInvalid
+		}
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		if token.UsedAt != nil || !now.Before(token.ExpiresAt) {
+			return ErrPasswordResetTokenInvalid
+		}
+		if err := tx.Where("id = ?", token.UserID).First(&account).Error; err != nil {
+			return err
+		}
+		hashedPassword, err := hashPassword(&account)
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&token).Update("used_at", now).Error; err != nil {
+			return err
+		}
+
+		pwdVersion := (account.PwdVersion + 1) % 100
+		if err := tx.Model(&account).Select("Password", "PwdVersion").
+			Updates(map[string]interface{}{"Password": hashedPassword, "PwdVersion": pwdVersion}).Error; err != nil {
+			return err
+		}
+		if err := r.CreateOrUpdatePwdVersionEvent(tx, account.ID, pwdVersion); err != nil {
+			return err
+		}
+		return revokeAllSessions(tx, account.ID, now, now.Add(accessTokenTTL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"auth-service/pkg/model"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrRoleNotFound is returned for a role that is neither a system role nor a custom role of the store
+	ErrRoleNotFound = errors.New("role not found")
+
+	// ErrRoleInUse is returned when a custom role to delete is still the role of accounts
+	ErrRoleInUse = errors.New("role is in use by accounts")
+)
+
+// SyncSystemRoles write the system roles with exactly rolePermissions, and the permissions they grant
+func (r *AccountRepository) SyncSystemRoles(ctx context.Context, rolePermissions map[string][]string) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for name, permissions := range rolePermissions {
+			for _, permission := range permissions {
+				if err := tx.FirstOrCreate(&model.Permission{Name: permission}).Error; err != nil {
+					return err
+				}
+			}
+			role := model.Role{Name: name, StoreID: 0}
+			if err := tx.Where("name = ? AND store_id = 0", name).FirstOrCreate(&role).Error; err != nil {
+				return err
+			}
+			if err := replaceRolePermissions(tx, role.ID, permissions); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetRole get the role name of an account of storeID, a system role or a custom role of the store,
+// with its permissions
+func (r *AccountRepository) GetRole(ctx context.Context, name string, storeID uint64) (*model.Role, error) {
+	var role model.Role
+	err := r.DB.WithContext(ctx).Preload("Permissions").Where("name = ? AND store_id IN ?", name, []uint64{0, storeID}).
+		Order("store_id").First(&role).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrRoleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+// GetStoreRoles get the custom roles of storeID with their permissions
+func (r *AccountRepository) GetStoreRoles(ctx context.Context, storeID uint64) ([]*model.Role, error) {
+	var roles []*model.Role
+	if err := r.DB.WithContext(ctx).Preload("Permissions").Where("store_id = ?", storeID).Order("name").Find(&roles).Error; err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+// CreateRole create a custom role with its permissions
+func (r *AccountRepository) CreateRole(ctx context.Context, role *model.Role) error {
+	return r.DB.WithContext(ctx).Create(role).Error
+}
+
+// UpdateStoreRole replace the description and permissions of the custom role name of storeID
+func (r *AccountRepository) UpdateStoreRole(ctx context.Context, storeID uint64, name, description string, permissions []string) (*model.Role, error) {
+	var role model.Role
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("name = ? AND store_id = ?", name, storeID).First(&role).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrRoleNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&role).Update("description", description).Error; err != nil {
+			return err
+		}
+		if err := replaceRolePermissions(tx, role.ID, permissions); err != nil {
+			return err
+		}
+		return tx.Preload("Permissions").First(&role, role.ID).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+// DeleteStoreRole delete the custom role name of storeID, ErrRoleInUse while accounts of the store have it
+func (r *AccountRepository) DeleteStoreRole(ctx context.Context, storeID uint64, name string) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var role model.Role
+		err := tx.Where("name = ? AND store_id = ?", name, storeID).First(&role).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrRoleNotFound
+		}
+		if err != nil {
+			return err
+		}
+		var accounts int64
+		if err := tx.Model(&model.Account{}).Where("role = ? AND store_id = ?", name, storeID).Count(&accounts).Error; err != nil {
+			return err
+		}
+		if accounts > 0 {
+			return ErrRoleInUse
+		}
+		if err := tx.Where("role_id = ?", role.ID).Delete(&model.RolePermission{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&role).Error
+	})
+}
+
+// replaceRolePermissions set the permissions of roleID to permissions
+func replaceRolePermissions(tx *gorm.DB, roleID uint64, permissions []string) error {
+	if err := tx.Where("role_id = ?", roleID).Delete(&model.RolePermission{}).Error; err != nil {
+		return err
+	}
+	if len(permissions) == 0 {
+		return nil
+	}
+	rows := make([]*model.RolePermission, 0, len(permissions))
+	for _, permission := range permissions {
+		rows = append(rows, &model.RolePermission{RoleID: roleID, PermissionName: permission})
+	}
+	return tx.Create(rows).Error
+}
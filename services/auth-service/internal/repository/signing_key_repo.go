@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"auth-service/pkg/model"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// GetSigningKeys get the keys that are not retired or were retired after retiredAfter, newest first
+func (r *AccountRepository) GetSigningKeys(ctx context.Context, retiredAfter time.Time) ([]*model.SigningKey, error) {
+	var keys []*model.SigningKey
+	err := r.DB.WithContext(ctx).Where("retired_at IS NULL OR retired_at > ?", retiredAfter).
+		Order("created_at DESC").Find(&keys).Error
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// RotateSigningKey retire the current keys, delete the keys retired before deleteRetiredBefore and save next
+func (r *AccountRepository) RotateSigningKey(ctx context.Context, next *model.SigningKey, deleteRetiredBefore time.Time) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.SigningKey{}).Where("retired_at IS NULL").Update("retired_at", next.CreatedAt).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("retired_at < ?", deleteRetiredBefore).Delete(&model.SigningKey{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(next).Error
+	})
+}
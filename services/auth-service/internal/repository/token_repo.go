@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"auth-service/pkg/model"
+	"auth-service/pkg/outbox"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrRefreshTokenNotFound is returned for a refresh token that was never issued
+var ErrRefreshTokenNotFound = errors.New("refresh token not found")
+
+// ErrRefreshTokenReused is returned when a revoked refresh token is presented again, its family is revoked
+var ErrRefreshTokenReused = errors.New("refresh token reused")
+
+// ErrSessionNotFound is returned for a session that does not exist, is not of the user, or is no longer active
+var ErrSessionNotFound = errors.New("session not found")
+
+// CreateSession save the session of a login with the first refresh token of its family
+func (r *AccountRepository) CreateSession(ctx context.Context, session *model.Session, token *model.RefreshToken) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(session).Error; err != nil {
+			return err
+		}
+		return tx.Create(token).Error
+	})
+}
+
+// GetActiveSessions return the sessions of userID that are neither revoked nor expired, most recently used first
+func (r *AccountRepository) GetActiveSessions(ctx context.Context, userID uint64) ([]*model.Session, error) {
+	var sessions []*model.Session
+	err := r.DB.WithContext(ctx).Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("last_used_at DESC").Find(&sessions).Error
+	return sessions, err
+}
+
+// GetActiveSession return the session id of userID, or ErrSessionNotFound when it is revoked or expired
+func (r *AccountRepository) GetActiveSession(ctx context.Context, userID uint64, id string) (*model.Session, error) {
+	var session model.Session
+	err := r.DB.WithContext(ctx).Where("id = ? AND user_id = ? AND revoked_at IS NULL AND expires_at > ?", id, userID, time.Now()).
+		First(&session).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// RotateRefreshToken revoke the refresh token oldID and save next in its family.
+// A revoked oldID means the token was stolen or replayed, then the whole family is revoked, an audit entry and
+// the revocation event of its access tokens, denied for accessTokenTTL, are written and ErrRefreshTokenReused is returned.
+func (r *AccountRepository) RotateRefreshToken(ctx context.Context, oldID string, next *model.RefreshToken, accessTokenTTL time.Duration) error {
+	reused := false
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var old model.RefreshToken
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", oldID).First(&old).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrRefreshTokenNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if err := continueFamily(&old, next); err != nil {
+			reused = true
+			if err := revokeRefreshTokenFamily(tx, old.FamilyID, now); err != nil {
+				return err
+			}
+			auditLog, events := reuseRecords(&old, now, now.Add(accessTokenTTL))
+			if err := tx.Create(auditLog).Error; err != nil {
+				return err
+			}
+			return tx.Create(events).Error
+		}
+
+		if err := tx.Model(&model.RefreshToken{}).Where("id = ?", old.ID).
+			Updates(map[string]interface{}{"revoked_at": now, "replaced_by": next.ID}).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(next).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.Session{}).Where("id = ?", old.FamilyID).
+			Updates(map[string]interface{}{"last_used_at": now, "expires_at": next.ExpiresAt}).Error
+	})
+	if err != nil {
+		return err
+	}
+	if reused {
+		return ErrRefreshTokenReused
+	}
+	return nil
+}
+
+// continueFamily put next in the family of old, or return ErrRefreshTokenReused when old is revoked,
+// whether it was rotated already or its family was revoked
+func continueFamily(old, next *model.RefreshToken) error {
+	if old.RevokedAt != nil {
+		return ErrRefreshTokenReused
+	}
+	next.FamilyID = old.FamilyID
+	next.UserID = old.UserID
+	return nil
+}
+
+// reuseRecords return the audit entry of the reuse of old and the revocation events of the access tokens of its family,
+// so the gateway denies them like after a logout
+func reuseRecords(old *model.RefreshToken, now, expiresAt time.Time) (*model.AuditLog, []*outbox.TokenRevocationEvent) {
+	return &model.AuditLog{
+		UserID: old.UserID,
+		Event:  model.AuditRefreshTokenReuse,
+		Detail: fmt.Sprintf("token %s of family %s", old.ID, old.FamilyID),
+	}, familyRevocationEvents(old.UserID, []string{old.FamilyID}, now, expiresAt)
+}
+
+// revokeRefreshTokenFamily revoke every token of the family that is not revoked yet, and its session, in tx
+func revokeRefreshTokenFamily(tx *gorm.DB, familyID string, now time.Time) error {
+	if err := tx.Model(&model.RefreshToken{}).Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", now).Error; err != nil {
+		return err
+	}
+	return tx.Model(&model.Session{}).Where("id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", now).Error
+}
+
+// RevokeSession revoke the refresh token family of a login and save the revocation event of its access token
+func (r *AccountRepository) RevokeSession(ctx context.Context, familyID string, event *outbox.TokenRevocationEvent) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := revokeRefreshTokenFamily(tx, familyID, event.IssuedBefore); err != nil {
+			return err
+		}
+		return tx.Create(event).Error
+	})
+}
+
+// RevokeAllSessions revoke every refresh token and session of userID at now, and save the revocation event of the
+// access tokens of each session that was active, denied until expiresAt
+func (r *AccountRepository) RevokeAllSessions(ctx context.Context, userID uint64, now, expiresAt time.Time) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return revokeAllSessions(tx, userID, now, expiresAt)
+	})
+}
+
+// revokeAllSessions is RevokeAllSessions in tx
+func revokeAllSessions(tx *gorm.DB, userID uint64, now, expiresAt time.Time) error {
+	var familyIDs []string
+	if err := tx.Model(&model.Session{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).Pluck("id", &familyIDs).Error; err != nil {
+		return err
+	}
+	if err := tx.Model(&model.RefreshToken{}).Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", now).Error; err != nil {
+		return err
+	}
+	if len(familyIDs) == 0 {
+		return nil
+	}
+	if err := tx.Model(&model.Session{}).Where("id IN ?", familyIDs).Update("revoked_at", now).Error; err != nil {
+		return err
+	}
+	return tx.Create(familyRevocationEvents(userID, familyIDs, now, expiresAt)).Error
+}
+
+// familyRevocationEvents return one revocation event per session of familyIDs. Sessions are revoked by family
+// rather than by issue time, so a token issued in the same second as the revocation is neither denied nor let through.
+func familyRevocationEvents(userID uint64, familyIDs []string, now, expiresAt time.Time) []*outbox.TokenRevocationEvent {
+	events := make([]*outbox.TokenRevocationEvent, 0, len(familyIDs))
+	for _, familyID := range familyIDs {
+		events = append(events, &outbox.TokenRevocationEvent{
+			UserID:       userID,
+			FamilyID:     familyID,
+			IssuedBefore: now,
+			ExpiresAt:    expiresAt,
+			Status:       "PENDING",
+		})
+	}
+	return events
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"auth-service/pkg/model"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestContinueFamily(t *testing.T) {
+	revokedAt := time.Now().Add(-time.Minute)
+	tests := []struct {
+		name    string
+		old     model.RefreshToken
+		wantErr error
+	}{
+		{"active", model.RefreshToken{ID: "t1", FamilyID: "f1", UserID: 7}, nil},
+		{"already rotated", model.RefreshToken{ID: "t1", FamilyID: "f1", UserID: 7, RevokedAt: &revokedAt, ReplacedBy: "t2"}, ErrRefreshTokenReused},
+		{"family revoked", model.RefreshToken{ID: "t1", FamilyID: "f1", UserID: 7, RevokedAt: &revokedAt}, ErrRefreshTokenReused},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// next comes with the family and user of the presented claims, those of the stored token win
+			next := &model.RefreshToken{ID: "t3", FamilyID: "claimed", UserID: 8}
+			err := continueFamily(&tt.old, next)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("continueFamily() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if next.FamilyID != "claimed" || next.UserID != 8 {
+					t.Errorf("continueFamily() changed next of a reused token: %+v", next)
+				}
+
+				// The reuse is audited and the access tokens of the family are revoked at the gateway
+				now := time.Now()
+				expiresAt := now.Add(15 * time.Minute)
+				auditLog, events := reuseRecords(&tt.old, now, expiresAt)
+				if auditLog.UserID != 7 || auditLog.Event != model.AuditRefreshTokenReuse {
+					t.Errorf("reuseRecords() audit = %+v", auditLog)
+				}
+				if len(events) != 1 || events[0].UserID != 7 || events[0].FamilyID != "f1" || events[0].JTI != "" ||
+					!events[0].ExpiresAt.Equal(expiresAt) || events[0].Status != "PENDING" {
+					t.Errorf("reuseRecords() events = %+v, want one PENDING event of family f1", events)
+				}
+				return
+			}
+			if next.FamilyID != tt.old.FamilyID || next.UserID != tt.old.UserID {
+				t.Errorf("next = family %s user %d, want family %s user %d", next.FamilyID, next.UserID, tt.old.FamilyID, tt.old.UserID)
+			}
+		})
+	}
+}
+
+func TestFamilyRevocationEvents(t *testing.T) {
+	now := time.Now()
+	expiresAt := now.Add(15 * time.Minute)
+	events := familyRevocationEvents(7, []string{"f1", "f2"}, now, expiresAt)
+	if len(events) != 2 {
+		t.Fatalf("familyRevocationEvents() = %d events, want 2", len(events))
+	}
+	for i, familyID := range []string{"f1", "f2"} {
+		event := events[i]
+		if event.UserID != 7 || event.FamilyID != familyID || event.JTI != "" {
+			t.Errorf("event %d = user %d family %q jti %q, want user 7 family %q", i, event.UserID, event.FamilyID, event.JTI, familyID)
+		}
+		if !event.IssuedBefore.Equal(now) || !event.ExpiresAt.Equal(expiresAt) || event.Status != "PENDING" {
+			t.Errorf("event %d = %+v", i, event)
+		}
+	}
+	if events := familyRevocationEvents(7, nil, now, expiresAt); len(events) != 0 {
+		t.Errorf("familyRevocationEvents() of no session = %d events", len(events))
+	}
+}
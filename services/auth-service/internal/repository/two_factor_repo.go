@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"auth-service/pkg/model"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GetTwoFactor get the TOTP secret of an account, gorm.ErrRecordNotFound when it has none
+func (r *AccountRepository) GetTwoFactor(ctx context.Context, userID uint64) (*model.TwoFactor, error) {
+	var twoFactor model.TwoFactor
+	if err := r.DB.WithContext(ctx).Where("user_id = ?", userID).First(&twoFactor).Error; err != nil {
+		return nil, err
+	}
+	return &twoFactor, nil
+}
+
+// SaveUnconfirmedTwoFactor save the secret of an enrolment in progress, replacing the previous unconfirmed one
+func (r *AccountRepository) SaveUnconfirmedTwoFactor(ctx context.Context, twoFactor *model.TwoFactor) error {
+	return r.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"secret", "confirmed_at", "last_used_step", "created_at"}),
+	}).Create(twoFactor).Error
+}
+
+// ConfirmTwoFactor enable the TOTP secret of an account with step as last used step,
+// and replace its recovery codes with codeHashes
+func (r *AccountRepository) ConfirmTwoFactor(ctx context.Context, userID uint64, step int64, codeHashes []string) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&model.TwoFactor{}).Where("user_id = ? AND confirmed_at IS NULL", userID).
+			Updates(map[string]interface{}{"confirmed_at": time.Now(), "last_used_step": step})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if err := tx.Where("user_id = ?", userID).Delete(&model.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+		codes := make([]*model.RecoveryCode, 0, len(codeHashes))
+		for _, codeHash := range codeHashes {
+			codes = append(codes, &model.RecoveryCode{UserID: userID, CodeHash: codeHash})
+		}
+		if err := tx.Create(codes).Error; err != nil {
+			return err
+		}
+		return tx.Create(&model.AuditLog{UserID: userID, Event: model.AuditTwoFactorEnabled}).Error
+	})
+}
+
+// UseTOTPStep record step as the last used step of an account, false when a code of step or a later one was already used
+func (r *AccountRepository) UseTOTPStep(ctx context.Context, userID uint64, step int64) (bool, error) {
+	res := r.DB.WithContext(ctx).Model(&model.TwoFactor{}).Where("user_id = ? AND last_used_step < ?", userID, step).
+		Update("last_used_step", step)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// UseRecoveryCode mark the unused recovery code of codeHash as used, false when there is none
+func (r *AccountRepository) UseRecoveryCode(ctx context.Context, userID uint64, codeHash string) (bool, error) {
+	used := false
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&model.RecoveryCode{}).Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+			Update("used_at", time.Now())
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		used = true
+		return tx.Create(&model.AuditLog{UserID: userID, Event: model.AuditRecoveryCodeUsed}).Error
+	})
+	return used, err
+}
+
+// DeleteTwoFactor remove the TOTP secret and recovery codes of an account
+func (r *AccountRepository) DeleteTwoFactor(ctx context.Context, userID uint64) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&model.TwoFactor{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", userID).Delete(&model.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&model.AuditLog{UserID: userID, Event: model.AuditTwoFactorDisabled}).Error
+	})
+}
@@ -2,7 +2,10 @@ package adapter
 
 import (
 	"auth-service/pkg/dto"
+	"auth-service/pkg/model"
 	"auth-service/pkg/pb"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func LoginRequestToInput(req *authpb.LoginRequest) (*dto.LoginInput, error) {
@@ -10,15 +13,23 @@ func LoginRequestToInput(req *authpb.LoginRequest) (*dto.LoginInput, error) {
 		Username: req.GetUsername(),
 		Password: req.GetPassword(),
 		Role:     req.GetRole(),
+		SessionClient: dto.SessionClient{
+			DeviceLabel: req.GetDeviceLabel(),
+			UserAgent:   req.GetUserAgent(),
+			ClientIP:    req.GetClientIp(),
+		},
 	}, nil
 }
 
 func LoginOutputToResponse(output *dto.LoginOutput) (*authpb.LoginResponse, error) {
 	return &authpb.LoginResponse{
-		Message:      output.Message,
-		AccessToken:  output.AccessToken,
-		RefreshToken: output.RefreshToken,
-		Success:      output.Success,
+		Message:              output.Message,
+		AccessToken:          output.AccessToken,
+		RefreshToken:         output.RefreshToken,
+		Success:              output.Success,
+		SecondFactorRequired: output.SecondFactorRequired,
+		EnrollmentRequired:   output.EnrollmentRequired,
+		ChallengeToken:       output.ChallengeToken,
 	}, nil
 }
 
@@ -27,7 +38,7 @@ func RegisterRequestToInput(req *authpb.RegisterRequest) (*dto.RegisterInput, er
 		Username:        req.GetUsername(),
 		Password:        req.GetPassword(),
 		Role:            req.GetRole(),
-		RoleNotRegister: "seller_employee",
+		RoleNotRegister: model.RoleSellerEmployee,
 	}, nil
 }
 
@@ -91,9 +102,356 @@ func GetStoreIDRoleByIdRequestToInput(req *authpb.GetStoreIDRoleByIDRequest) (*d
 }
 func GetStoreIDRoleByIdOutputToResponse(output *dto.GetStoreIDRoleByIdOutput) (*authpb.GetStoreIDRoleByIDResponse, error) {
 	return &authpb.GetStoreIDRoleByIDResponse{
+		Message:     output.Message,
+		Success:     output.Success,
+		Role:        output.Role,
+		StoreId:     output.StoreID,
+		Permissions: output.Permissions,
+	}, nil
+}
+
+func GetStoreAccountIDsRequestToInput(req *authpb.GetStoreAccountIDsRequest) (*dto.GetStoreAccountIDsInput, error) {
+	return &dto.GetStoreAccountIDsInput{
+		StoreID: req.GetStoreId(),
+	}, nil
+}
+func GetStoreAccountIDsOutputToResponse(output *dto.GetStoreAccountIDsOutput) (*authpb.GetStoreAccountIDsResponse, error) {
+	return &authpb.GetStoreAccountIDsResponse{
+		Message: output.Message,
+		Success: output.Success,
+		UserIds: output.UserIDs,
+	}, nil
+}
+
+func LogoutRequestToInput(req *authpb.LogoutRequest) (*dto.LogoutInput, error) {
+	return &dto.LogoutInput{
+		AccessToken: req.GetAccessToken(),
+	}, nil
+}
+
+func LogoutOutputToResponse(output *dto.LogoutOutput) (*authpb.LogoutResponse, error) {
+	return &authpb.LogoutResponse{
+		Message: output.Message,
+		Success: output.Success,
+	}, nil
+}
+
+func LogoutAllRequestToInput(req *authpb.LogoutAllRequest) (*dto.LogoutAllInput, error) {
+	return &dto.LogoutAllInput{
+		AccessToken: req.GetAccessToken(),
+	}, nil
+}
+
+func LogoutAllOutputToResponse(output *dto.LogoutAllOutput) (*authpb.LogoutAllResponse, error) {
+	return &authpb.LogoutAllResponse{
+		Message: output.Message,
+		Success: output.Success,
+	}, nil
+}
+
+func GetJWKSRequestToInput(req *authpb.GetJWKSRequest) (*dto.GetJWKSInput, error) {
+	return &dto.GetJWKSInput{}, nil
+}
+
+func GetJWKSOutputToResponse(output *dto.GetJWKSOutput) (*authpb.GetJWKSResponse, error) {
+	keys := make([]*authpb.JWK, 0, len(output.Keys))
+	for _, key := range output.Keys {
+		keys = append(keys, &authpb.JWK{
+			Kty: key.Kty,
+			Crv: key.Crv,
+			Alg: key.Alg,
+			Use: key.Use,
+			Kid: key.Kid,
+			X:   key.X,
+		})
+	}
+	return &authpb.GetJWKSResponse{
+		Message: "Get JWKS successfully",
+		Success: true,
+		Keys:    keys,
+	}, nil
+}
+
+func UnlockAccountRequestToInput(req *authpb.UnlockAccountRequest) (*dto.UnlockAccountInput, error) {
+	return &dto.UnlockAccountInput{
+		Username: req.GetUsername(),
+		Role:     req.GetRole(),
+		ClientIP: req.GetClientIp(),
+	}, nil
+}
+
+func UnlockAccountOutputToResponse(output *dto.UnlockAccountOutput) (*authpb.UnlockAccountResponse, error) {
+	return &authpb.UnlockAccountResponse{
+		Message: output.Message,
+		Success: output.Success,
+	}, nil
+}
+
+func RequestPasswordResetRequestToInput(req *authpb.RequestPasswordResetRequest) (*dto.RequestPasswordResetInput, error) {
+	return &dto.RequestPasswordResetInput{
+		Username: req.GetUsername(),
+		Role:     req.GetRole(),
+	}, nil
+}
+
+func RequestPasswordResetOutputToResponse(output *dto.RequestPasswordResetOutput) (*authpb.RequestPasswordResetResponse, error) {
+	return &authpb.RequestPasswordResetResponse{
+		Message: output.Message,
+		Success: output.Success,
+	}, nil
+}
+
+func ResetPasswordRequestToInput(req *authpb.ResetPasswordRequest) (*dto.ResetPasswordInput, error) {
+	return &dto.ResetPasswordInput{
+		Token:       req.GetToken(),
+		NewPassword: req.GetNewPassword(),
+	}, nil
+}
+
+func ResetPasswordOutputToResponse(output *dto.ResetPasswordOutput) (*authpb.ResetPasswordResponse, error) {
+	return &authpb.ResetPasswordResponse{
+		Message: output.Message,
+		Success: output.Success,
+	}, nil
+}
+
+func BeginTOTPEnrollmentRequestToInput(req *authpb.BeginTOTPEnrollmentRequest) (*dto.BeginTOTPEnrollmentInput, error) {
+	return &dto.BeginTOTPEnrollmentInput{
+		UserID:         req.GetUserId(),
+		ChallengeToken: req.GetChallengeToken(),
+	}, nil
+}
+
+func BeginTOTPEnrollmentOutputToResponse(output *dto.BeginTOTPEnrollmentOutput) (*authpb.BeginTOTPEnrollmentResponse, error) {
+	return &authpb.BeginTOTPEnrollmentResponse{
+		Message:         output.Message,
+		Success:         output.Success,
+		Secret:          output.Secret,
+		ProvisioningUri: output.ProvisioningURI,
+	}, nil
+}
+
+func ConfirmTOTPEnrollmentRequestToInput(req *authpb.ConfirmTOTPEnrollmentRequest) (*dto.ConfirmTOTPEnrollmentInput, error) {
+	return &dto.ConfirmTOTPEnrollmentInput{
+		UserID:         req.GetUserId(),
+		ChallengeToken: req.GetChallengeToken(),
+		Code:           req.GetCode(),
+		SessionClient: dto.SessionClient{
+			DeviceLabel: req.GetDeviceLabel(),
+			UserAgent:   req.GetUserAgent(),
+			ClientIP:    req.GetClientIp(),
+		},
+	}, nil
+}
+
+func ConfirmTOTPEnrollmentOutputToResponse(output *dto.ConfirmTOTPEnrollmentOutput) (*authpb.ConfirmTOTPEnrollmentResponse, error) {
+	return &authpb.ConfirmTOTPEnrollmentResponse{
+		Message:       output.Message,
+		Success:       output.Success,
+		RecoveryCodes: output.RecoveryCodes,
+		AccessToken:   output.AccessToken,
+		RefreshToken:  output.RefreshToken,
+	}, nil
+}
+
+func VerifySecondFactorRequestToInput(req *authpb.VerifySecondFactorRequest) (*dto.VerifySecondFactorInput, error) {
+	return &dto.VerifySecondFactorInput{
+		ChallengeToken: req.GetChallengeToken(),
+		Code:           req.GetCode(),
+		SessionClient: dto.SessionClient{
+			DeviceLabel: req.GetDeviceLabel(),
+			UserAgent:   req.GetUserAgent(),
+			ClientIP:    req.GetClientIp(),
+		},
+	}, nil
+}
+
+func VerifySecondFactorOutputToResponse(output *dto.VerifySecondFactorOutput) (*authpb.VerifySecondFactorResponse, error) {
+	return &authpb.VerifySecondFactorResponse{
+		Message:      output.Message,
+		AccessToken:  output.AccessToken,
+		RefreshToken: output.RefreshToken,
+		Success:      output.Success,
+	}, nil
+}
+
+func DisableTOTPRequestToInput(req *authpb.DisableTOTPRequest) (*dto.DisableTOTPInput, error) {
+	return &dto.DisableTOTPInput{
+		UserID: req.GetUserId(),
+		Code:   req.GetCode(),
+	}, nil
+}
+
+func DisableTOTPOutputToResponse(output *dto.DisableTOTPOutput) (*authpb.DisableTOTPResponse, error) {
+	return &authpb.DisableTOTPResponse{
+		Message: output.Message,
+		Success: output.Success,
+	}, nil
+}
+
+func BeginOIDCLoginRequestToInput(req *authpb.BeginOIDCLoginRequest) (*dto.BeginOIDCLoginInput, error) {
+	return &dto.BeginOIDCLoginInput{
+		Provider: req.GetProvider(),
+		UserID:   req.GetUserId(),
+	}, nil
+}
+
+func BeginOIDCLoginOutputToResponse(output *dto.BeginOIDCLoginOutput) (*authpb.BeginOIDCLoginResponse, error) {
+	return &authpb.BeginOIDCLoginResponse{
+		Message:          output.Message,
+		Success:          output.Success,
+		AuthorizationUrl: output.AuthorizationURL,
+		State:            output.State,
+	}, nil
+}
+
+func CompleteOIDCLoginRequestToInput(req *authpb.CompleteOIDCLoginRequest) (*dto.CompleteOIDCLoginInput, error) {
+	return &dto.CompleteOIDCLoginInput{
+		Provider: req.GetProvider(),
+		State:    req.GetState(),
+		Code:     req.GetCode(),
+		UserID:   req.GetUserId(),
+		SessionClient: dto.SessionClient{
+			DeviceLabel: req.GetDeviceLabel(),
+			UserAgent:   req.GetUserAgent(),
+			ClientIP:    req.GetClientIp(),
+		},
+	}, nil
+}
+
+func CompleteOIDCLoginOutputToResponse(output *dto.CompleteOIDCLoginOutput) (*authpb.CompleteOIDCLoginResponse, error) {
+	return &authpb.CompleteOIDCLoginResponse{
+		Message:              output.Message,
+		Success:              output.Success,
+		AccessToken:          output.AccessToken,
+		RefreshToken:         output.RefreshToken,
+		SecondFactorRequired: output.SecondFactorRequired,
+		EnrollmentRequired:   output.EnrollmentRequired,
+		ChallengeToken:       output.ChallengeToken,
+		AccountCreated:       output.AccountCreated,
+		Linked:               output.Linked,
+	}, nil
+}
+
+func StoreRoleRequestToDTO(role *authpb.StoreRole) *dto.StoreRole {
+	return &dto.StoreRole{
+		Name:        role.GetName(),
+		Description: role.GetDescription(),
+		Permissions: role.GetPermissions(),
+	}
+}
+
+func StoreRoleDTOToResponse(role *dto.StoreRole) *authpb.StoreRole {
+	return &authpb.StoreRole{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+	}
+}
+
+func ListStoreRolesRequestToInput(req *authpb.ListStoreRolesRequest) (*dto.ListStoreRolesInput, error) {
+	return &dto.ListStoreRolesInput{
+		ActorID: req.GetActorId(),
+		StoreID: req.GetStoreId(),
+	}, nil
+}
+
+func ListStoreRolesOutputToResponse(output *dto.ListStoreRolesOutput) (*authpb.ListStoreRolesResponse, error) {
+	roles := make([]*authpb.StoreRole, 0, len(output.Roles))
+	for _, role := range output.Roles {
+		roles = append(roles, StoreRoleDTOToResponse(role))
+	}
+	return &authpb.ListStoreRolesResponse{
+		Message:               output.Message,
+		Success:               output.Success,
+		Roles:                 roles,
+		AssignablePermissions: output.AssignablePermissions,
+	}, nil
+}
+
+func CreateStoreRoleRequestToInput(req *authpb.CreateStoreRoleRequest) (*dto.CreateStoreRoleInput, error) {
+	return &dto.CreateStoreRoleInput{
+		ActorID: req.GetActorId(),
+		StoreID: req.GetStoreId(),
+		Role:    StoreRoleRequestToDTO(req.GetRole()),
+	}, nil
+}
+
+func CreateStoreRoleOutputToResponse(output *dto.CreateStoreRoleOutput) (*authpb.CreateStoreRoleResponse, error) {
+	return &authpb.CreateStoreRoleResponse{
+		Message: output.Message,
+		Success: output.Success,
+		Role:    StoreRoleDTOToResponse(output.Role),
+	}, nil
+}
+
+func UpdateStoreRoleRequestToInput(req *authpb.UpdateStoreRoleRequest) (*dto.UpdateStoreRoleInput, error) {
+	return &dto.UpdateStoreRoleInput{
+		ActorID: req.GetActorId(),
+		StoreID: req.GetStoreId(),
+		Role:    StoreRoleRequestToDTO(req.GetRole()),
+	}, nil
+}
+
+func UpdateStoreRoleOutputToResponse(output *dto.UpdateStoreRoleOutput) (*authpb.UpdateStoreRoleResponse, error) {
+	return &authpb.UpdateStoreRoleResponse{
+		Message: output.Message,
+		Success: output.Success,
+		Role:    StoreRoleDTOToResponse(output.Role),
+	}, nil
+}
+
+func DeleteStoreRoleRequestToInput(req *authpb.DeleteStoreRoleRequest) (*dto.DeleteStoreRoleInput, error) {
+	return &dto.DeleteStoreRoleInput{
+		ActorID: req.GetActorId(),
+		StoreID: req.GetStoreId(),
+		Name:    req.GetName(),
+	}, nil
+}
+
+func DeleteStoreRoleOutputToResponse(output *dto.DeleteStoreRoleOutput) (*authpb.DeleteStoreRoleResponse, error) {
+	return &authpb.DeleteStoreRoleResponse{
+		Message: output.Message,
+		Success: output.Success,
+	}, nil
+}
+
+func ListSessionsRequestToInput(req *authpb.ListSessionsRequest) (*dto.ListSessionsInput, error) {
+	return &dto.ListSessionsInput{
+		AccessToken: req.GetAccessToken(),
+	}, nil
+}
+
+func ListSessionsOutputToResponse(output *dto.ListSessionsOutput) (*authpb.ListSessionsResponse, error) {
+	sessions := make([]*authpb.Session, 0, len(output.Sessions))
+	for _, session := range output.Sessions {
+		sessions = append(sessions, &authpb.Session{
+			Id:          session.ID,
+			DeviceLabel: session.DeviceLabel,
+			UserAgent:   session.UserAgent,
+			ClientIp:    session.ClientIP,
+			CreatedAt:   timestamppb.New(session.CreatedAt),
+			LastUsedAt:  timestamppb.New(session.LastUsedAt),
+			Current:     session.Current,
+		})
+	}
+	return &authpb.ListSessionsResponse{
+		Message:  output.Message,
+		Success:  output.Success,
+		Sessions: sessions,
+	}, nil
+}
+
+func RevokeSessionRequestToInput(req *authpb.RevokeSessionRequest) (*dto.RevokeSessionInput, error) {
+	return &dto.RevokeSessionInput{
+		AccessToken: req.GetAccessToken(),
+		SessionID:   req.GetSessionId(),
+	}, nil
+}
+
+func RevokeSessionOutputToResponse(output *dto.RevokeSessionOutput) (*authpb.RevokeSessionResponse, error) {
+	return &authpb.RevokeSessionResponse{
 		Message: output.Message,
 		Success: output.Success,
-		Role:    output.Role,
-		StoreId: output.StoreID,
 	}, nil
 }
@@ -1,10 +1,13 @@
 package server
 
 import (
+	"auth-service/internal/repository"
 	"auth-service/internal/server/adapter"
 	"auth-service/internal/service"
+	"auth-service/internal/service/oidc"
 	"auth-service/pkg/pb"
 	"context"
+	"errors"
 
 	"buf.build/go/protovalidate"
 	"go.uber.org/zap"
@@ -34,6 +37,13 @@ func (s *AuthServer) Login(ctx context.Context, req *authpb.LoginRequest) (*auth
 
 	// Get ServiceOutput
 	output, err := s.AuthService.Login(ctx, input)
+	var throttled *service.LoginThrottledError
+	if errors.As(err, &throttled) {
+		return LoginFailResponse("Login throttled in AuthService", err, codes.ResourceExhausted)
+	}
+	if errors.Is(err, service.ErrLoginIncorrect) {
+		return LoginFailResponse("Login error in AuthService", err, codes.Unauthenticated)
+	}
 	if err != nil {
 		s.ZapLogger.Error("AuthServer: Login error in AuthService", zap.Error(err))
 		return LoginFailResponse("Login error in AuthService", err, codes.Internal)
@@ -233,3 +243,714 @@ func (s *AuthServer) GetStoreIDRoleById(ctx context.Context, req *authpb.GetStor
 	// Return valid response
 	return res, nil
 }
+
+// GetStoreAccountIDs handle get store account ids request
+func (s *AuthServer) GetStoreAccountIDs(ctx context.Context, req *authpb.GetStoreAccountIDsRequest) (*authpb.GetStoreAccountIDsResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid request for GetStoreAccountIDs", zap.Error(err))
+		return GetStoreAccountIDsFailResponse("Invalid request for GetStoreAccountIDs", err, codes.InvalidArgument)
+	}
+	input, err := adapter.GetStoreAccountIDsRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse GetStoreAccountIDs request to input error", zap.Error(err))
+		return GetStoreAccountIDsFailResponse("Parse GetStoreAccountIDs request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.AuthService.GetStoreAccountIDs(ctx, input)
+	if err != nil {
+		s.ZapLogger.Error("AuthServer: GetStoreAccountIDs error in AuthService", zap.Error(err))
+		return GetStoreAccountIDsFailResponse("GetStoreAccountIDs error in AuthService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.GetStoreAccountIDsOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse GetStoreAccountIDs output to response error", zap.Error(err))
+		return GetStoreAccountIDsFailResponse("parse GetStoreAccountIDs output to response error", err, codes.Internal)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid response for GetStoreAccountIDs", zap.Error(err))
+		return GetStoreAccountIDsFailResponse("invalid response for GetStoreAccountIDs", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// Logout handle logout request
+func (s *AuthServer) Logout(ctx context.Context, req *authpb.LogoutRequest) (*authpb.LogoutResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid request for Logout", zap.Error(err))
+		return LogoutFailResponse("Invalid request for Logout", err, codes.InvalidArgument)
+	}
+	input, err := adapter.LogoutRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse Logout request to input error", zap.Error(err))
+		return LogoutFailResponse("Parse Logout request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.AuthService.Logout(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: Logout error in AuthService", zap.Error(err))
+		return LogoutFailResponse("Logout error in AuthService", err, codes.Unauthenticated)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.LogoutOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse Logout output to response error", zap.Error(err))
+		return LogoutFailResponse("parse Logout output to response error", err, codes.Internal)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid response for Logout", zap.Error(err))
+		return LogoutFailResponse("invalid response for Logout", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// LogoutAll handle logout all request
+func (s *AuthServer) LogoutAll(ctx context.Context, req *authpb.LogoutAllRequest) (*authpb.LogoutAllResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid request for LogoutAll", zap.Error(err))
+		return LogoutAllFailResponse("Invalid request for LogoutAll", err, codes.InvalidArgument)
+	}
+	input, err := adapter.LogoutAllRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse LogoutAll request to input error", zap.Error(err))
+		return LogoutAllFailResponse("Parse LogoutAll request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.AuthService.LogoutAll(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: LogoutAll error in AuthService", zap.Error(err))
+		return LogoutAllFailResponse("LogoutAll error in AuthService", err, codes.Unauthenticated)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.LogoutAllOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse LogoutAll output to response error", zap.Error(err))
+		return LogoutAllFailResponse("parse LogoutAll output to response error", err, codes.Internal)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid response for LogoutAll", zap.Error(err))
+		return LogoutAllFailResponse("invalid response for LogoutAll", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// GetJWKS handle get JWKS request
+func (s *AuthServer) GetJWKS(ctx context.Context, req *authpb.GetJWKSRequest) (*authpb.GetJWKSResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid request for GetJWKS", zap.Error(err))
+		return GetJWKSFailResponse("Invalid request for GetJWKS", err, codes.InvalidArgument)
+	}
+	input, err := adapter.GetJWKSRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse GetJWKS request to input error", zap.Error(err))
+		return GetJWKSFailResponse("Parse GetJWKS request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.AuthService.GetJWKS(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: GetJWKS error in AuthService", zap.Error(err))
+		return GetJWKSFailResponse("GetJWKS error in AuthService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.GetJWKSOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse GetJWKS output to response error", zap.Error(err))
+		return GetJWKSFailResponse("parse GetJWKS output to response error", err, codes.Internal)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid response for GetJWKS", zap.Error(err))
+		return GetJWKSFailResponse("invalid response for GetJWKS", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// UnlockAccount handle admin unlock account request
+func (s *AuthServer) UnlockAccount(ctx context.Context, req *authpb.UnlockAccountRequest) (*authpb.UnlockAccountResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid request for UnlockAccount", zap.Error(err))
+		return UnlockAccountFailResponse("Invalid request for UnlockAccount", err, codes.InvalidArgument)
+	}
+	input, err := adapter.UnlockAccountRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse UnlockAccount request to input error", zap.Error(err))
+		return UnlockAccountFailResponse("Parse UnlockAccount request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.AuthService.UnlockAccount(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: UnlockAccount error in AuthService", zap.Error(err))
+		return UnlockAccountFailResponse("UnlockAccount error in AuthService", err, codes.InvalidArgument)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.UnlockAccountOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse UnlockAccount output to response error", zap.Error(err))
+		return UnlockAccountFailResponse("parse UnlockAccount output to response error", err, codes.Internal)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid response for UnlockAccount", zap.Error(err))
+		return UnlockAccountFailResponse("invalid response for UnlockAccount", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// RequestPasswordReset handle request password reset request
+func (s *AuthServer) RequestPasswordReset(ctx context.Context, req *authpb.RequestPasswordResetRequest) (*authpb.RequestPasswordResetResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid request for RequestPasswordReset", zap.Error(err))
+		return RequestPasswordResetFailResponse("Invalid request for RequestPasswordReset", err, codes.InvalidArgument)
+	}
+	input, err := adapter.RequestPasswordResetRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse RequestPasswordReset request to input error", zap.Error(err))
+		return RequestPasswordResetFailResponse("Parse RequestPasswordReset request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.AuthService.RequestPasswordReset(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: RequestPasswordReset error in AuthService", zap.Error(err))
+		return RequestPasswordResetFailResponse("RequestPasswordReset error in AuthService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.RequestPasswordResetOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse RequestPasswordReset output to response error", zap.Error(err))
+		return RequestPasswordResetFailResponse("parse RequestPasswordReset output to response error", err, codes.Internal)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid response for RequestPasswordReset", zap.Error(err))
+		return RequestPasswordResetFailResponse("invalid response for RequestPasswordReset", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// ResetPassword handle reset password request
+func (s *AuthServer) ResetPassword(ctx context.Context, req *authpb.ResetPasswordRequest) (*authpb.ResetPasswordResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid request for ResetPassword", zap.Error(err))
+		return ResetPasswordFailResponse("Invalid request for ResetPassword", err, codes.InvalidArgument)
+	}
+	input, err := adapter.ResetPasswordRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse ResetPassword request to input error", zap.Error(err))
+		return ResetPasswordFailResponse("Parse ResetPassword request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.AuthService.ResetPassword(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: ResetPassword error in AuthService", zap.Error(err))
+		return ResetPasswordFailResponse("ResetPassword error in AuthService", err, codes.InvalidArgument)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.ResetPasswordOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse ResetPassword output to response error", zap.Error(err))
+		return ResetPasswordFailResponse("parse ResetPassword output to response error", err, codes.Internal)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid response for ResetPassword", zap.Error(err))
+		return ResetPasswordFailResponse("invalid response for ResetPassword", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// BeginTOTPEnrollment handle begin TOTP enrollment request
+func (s *AuthServer) BeginTOTPEnrollment(ctx context.Context, req *authpb.BeginTOTPEnrollmentRequest) (*authpb.BeginTOTPEnrollmentResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid request for BeginTOTPEnrollment", zap.Error(err))
+		return BeginTOTPEnrollmentFailResponse("Invalid request for BeginTOTPEnrollment", err, codes.InvalidArgument)
+	}
+	input, err := adapter.BeginTOTPEnrollmentRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse BeginTOTPEnrollment request to input error", zap.Error(err))
+		return BeginTOTPEnrollmentFailResponse("Parse BeginTOTPEnrollment request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.AuthService.BeginTOTPEnrollment(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: BeginTOTPEnrollment error in AuthService", zap.Error(err))
+		return BeginTOTPEnrollmentFailResponse("BeginTOTPEnrollment error in AuthService", err, codes.Unauthenticated)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.BeginTOTPEnrollmentOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse BeginTOTPEnrollment output to response error", zap.Error(err))
+		return BeginTOTPEnrollmentFailResponse("parse BeginTOTPEnrollment output to response error", err, codes.Internal)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid response for BeginTOTPEnrollment", zap.Error(err))
+		return BeginTOTPEnrollmentFailResponse("invalid response for BeginTOTPEnrollment", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// ConfirmTOTPEnrollment handle confirm TOTP enrollment request
+func (s *AuthServer) ConfirmTOTPEnrollment(ctx context.Context, req *authpb.ConfirmTOTPEnrollmentRequest) (*authpb.ConfirmTOTPEnrollmentResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid request for ConfirmTOTPEnrollment", zap.Error(err))
+		return ConfirmTOTPEnrollmentFailResponse("Invalid request for ConfirmTOTPEnrollment", err, codes.InvalidArgument)
+	}
+	input, err := adapter.ConfirmTOTPEnrollmentRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse ConfirmTOTPEnrollment request to input error", zap.Error(err))
+		return ConfirmTOTPEnrollmentFailResponse("Parse ConfirmTOTPEnrollment request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.AuthService.ConfirmTOTPEnrollment(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: ConfirmTOTPEnrollment error in AuthService", zap.Error(err))
+		return ConfirmTOTPEnrollmentFailResponse("ConfirmTOTPEnrollment error in AuthService", err, codes.Unauthenticated)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.ConfirmTOTPEnrollmentOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse ConfirmTOTPEnrollment output to response error", zap.Error(err))
+		return ConfirmTOTPEnrollmentFailResponse("parse ConfirmTOTPEnrollment output to response error", err, codes.Internal)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid response for ConfirmTOTPEnrollment", zap.Error(err))
+		return ConfirmTOTPEnrollmentFailResponse("invalid response for ConfirmTOTPEnrollment", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// VerifySecondFactor handle verify second factor request
+func (s *AuthServer) VerifySecondFactor(ctx context.Context, req *authpb.VerifySecondFactorRequest) (*authpb.VerifySecondFactorResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid request for VerifySecondFactor", zap.Error(err))
+		return VerifySecondFactorFailResponse("Invalid request for VerifySecondFactor", err, codes.InvalidArgument)
+	}
+	input, err := adapter.VerifySecondFactorRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse VerifySecondFactor request to input error", zap.Error(err))
+		return VerifySecondFactorFailResponse("Parse VerifySecondFactor request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.AuthService.VerifySecondFactor(ctx, input)
+	var throttled *service.LoginThrottledError
+	if errors.As(err, &throttled) {
+		return VerifySecondFactorFailResponse("VerifySecondFactor throttled in AuthService", err, codes.ResourceExhausted)
+	}
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: VerifySecondFactor error in AuthService", zap.Error(err))
+		return VerifySecondFactorFailResponse("VerifySecondFactor error in AuthService", err, codes.Unauthenticated)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.VerifySecondFactorOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse VerifySecondFactor output to response error", zap.Error(err))
+		return VerifySecondFactorFailResponse("parse VerifySecondFactor output to response error", err, codes.Internal)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid response for VerifySecondFactor", zap.Error(err))
+		return VerifySecondFactorFailResponse("invalid response for VerifySecondFactor", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// DisableTOTP handle disable TOTP request
+func (s *AuthServer) DisableTOTP(ctx context.Context, req *authpb.DisableTOTPRequest) (*authpb.DisableTOTPResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid request for DisableTOTP", zap.Error(err))
+		return DisableTOTPFailResponse("Invalid request for DisableTOTP", err, codes.InvalidArgument)
+	}
+	input, err := adapter.DisableTOTPRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse DisableTOTP request to input error", zap.Error(err))
+		return DisableTOTPFailResponse("Parse DisableTOTP request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.AuthService.DisableTOTP(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: DisableTOTP error in AuthService", zap.Error(err))
+		return DisableTOTPFailResponse("DisableTOTP error in AuthService", err, codes.Unauthenticated)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.DisableTOTPOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse DisableTOTP output to response error", zap.Error(err))
+		return DisableTOTPFailResponse("parse DisableTOTP output to response error", err, codes.Internal)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid response for DisableTOTP", zap.Error(err))
+		return DisableTOTPFailResponse("invalid response for DisableTOTP", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// BeginOIDCLogin handle begin OIDC login request
+func (s *AuthServer) BeginOIDCLogin(ctx context.Context, req *authpb.BeginOIDCLoginRequest) (*authpb.BeginOIDCLoginResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid request for BeginOIDCLogin", zap.Error(err))
+		return BeginOIDCLoginFailResponse("Invalid request for BeginOIDCLogin", err, codes.InvalidArgument)
+	}
+	input, err := adapter.BeginOIDCLoginRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse BeginOIDCLogin request to input error", zap.Error(err))
+		return BeginOIDCLoginFailResponse("Parse BeginOIDCLogin request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.AuthService.BeginOIDCLogin(ctx, input)
+	if errors.Is(err, oidc.ErrUnknownProvider) {
+		return BeginOIDCLoginFailResponse("BeginOIDCLogin error in AuthService", err, codes.InvalidArgument)
+	}
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: BeginOIDCLogin error in AuthService", zap.Error(err))
+		return BeginOIDCLoginFailResponse("BeginOIDCLogin error in AuthService", err, codes.Unavailable)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.BeginOIDCLoginOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse BeginOIDCLogin output to response error", zap.Error(err))
+		return BeginOIDCLoginFailResponse("parse BeginOIDCLogin output to response error", err, codes.Internal)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid response for BeginOIDCLogin", zap.Error(err))
+		return BeginOIDCLoginFailResponse("invalid response for BeginOIDCLogin", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// CompleteOIDCLogin handle complete OIDC login request
+func (s *AuthServer) CompleteOIDCLogin(ctx context.Context, req *authpb.CompleteOIDCLoginRequest) (*authpb.CompleteOIDCLoginResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid request for CompleteOIDCLogin", zap.Error(err))
+		return CompleteOIDCLoginFailResponse("Invalid request for CompleteOIDCLogin", err, codes.InvalidArgument)
+	}
+	input, err := adapter.CompleteOIDCLoginRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse CompleteOIDCLogin request to input error", zap.Error(err))
+		return CompleteOIDCLoginFailResponse("Parse CompleteOIDCLogin request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.AuthService.CompleteOIDCLogin(ctx, input)
+	if errors.Is(err, repository.ErrIdentityLinked) {
+		return CompleteOIDCLoginFailResponse("CompleteOIDCLogin error in AuthService", err, codes.AlreadyExists)
+	}
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: CompleteOIDCLogin error in AuthService", zap.Error(err))
+		return CompleteOIDCLoginFailResponse("CompleteOIDCLogin error in AuthService", err, codes.Unauthenticated)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.CompleteOIDCLoginOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse CompleteOIDCLogin output to response error", zap.Error(err))
+		return CompleteOIDCLoginFailResponse("parse CompleteOIDCLogin output to response error", err, codes.Internal)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid response for CompleteOIDCLogin", zap.Error(err))
+		return CompleteOIDCLoginFailResponse("invalid response for CompleteOIDCLogin", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// ListStoreRoles handle list store roles request
+func (s *AuthServer) ListStoreRoles(ctx context.Context, req *authpb.ListStoreRolesRequest) (*authpb.ListStoreRolesResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid request for ListStoreRoles", zap.Error(err))
+		return ListStoreRolesFailResponse("Invalid request for ListStoreRoles", err, codes.InvalidArgument)
+	}
+	input, err := adapter.ListStoreRolesRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse ListStoreRoles request to input error", zap.Error(err))
+		return ListStoreRolesFailResponse("Parse ListStoreRoles request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.AuthService.ListStoreRoles(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: ListStoreRoles error in AuthService", zap.Error(err))
+		return ListStoreRolesFailResponse("ListStoreRoles error in AuthService", err, storeRoleErrorCode(err))
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.ListStoreRolesOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse ListStoreRoles output to response error", zap.Error(err))
+		return ListStoreRolesFailResponse("parse ListStoreRoles output to response error", err, codes.Internal)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid response for ListStoreRoles", zap.Error(err))
+		return ListStoreRolesFailResponse("invalid response for ListStoreRoles", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// CreateStoreRole handle create store role request
+func (s *AuthServer) CreateStoreRole(ctx context.Context, req *authpb.CreateStoreRoleRequest) (*authpb.CreateStoreRoleResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid request for CreateStoreRole", zap.Error(err))
+		return CreateStoreRoleFailResponse("Invalid request for CreateStoreRole", err, codes.InvalidArgument)
+	}
+	input, err := adapter.CreateStoreRoleRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse CreateStoreRole request to input error", zap.Error(err))
+		return CreateStoreRoleFailResponse("Parse CreateStoreRole request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.AuthService.CreateStoreRole(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: CreateStoreRole error in AuthService", zap.Error(err))
+		return CreateStoreRoleFailResponse("CreateStoreRole error in AuthService", err, storeRoleErrorCode(err))
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.CreateStoreRoleOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse CreateStoreRole output to response error", zap.Error(err))
+		return CreateStoreRoleFailResponse("parse CreateStoreRole output to response error", err, codes.Internal)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid response for CreateStoreRole", zap.Error(err))
+		return CreateStoreRoleFailResponse("invalid response for CreateStoreRole", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// UpdateStoreRole handle update store role request
+func (s *AuthServer) UpdateStoreRole(ctx context.Context, req *authpb.UpdateStoreRoleRequest) (*authpb.UpdateStoreRoleResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid request for UpdateStoreRole", zap.Error(err))
+		return UpdateStoreRoleFailResponse("Invalid request for UpdateStoreRole", err, codes.InvalidArgument)
+	}
+	input, err := adapter.UpdateStoreRoleRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse UpdateStoreRole request to input error", zap.Error(err))
+		return UpdateStoreRoleFailResponse("Parse UpdateStoreRole request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.AuthService.UpdateStoreRole(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: UpdateStoreRole error in AuthService", zap.Error(err))
+		return UpdateStoreRoleFailResponse("UpdateStoreRole error in AuthService", err, storeRoleErrorCode(err))
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.UpdateStoreRoleOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse UpdateStoreRole output to response error", zap.Error(err))
+		return UpdateStoreRoleFailResponse("parse UpdateStoreRole output to response error", err, codes.Internal)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid response for UpdateStoreRole", zap.Error(err))
+		return UpdateStoreRoleFailResponse("invalid response for UpdateStoreRole", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// DeleteStoreRole handle delete store role request
+func (s *AuthServer) DeleteStoreRole(ctx context.Context, req *authpb.DeleteStoreRoleRequest) (*authpb.DeleteStoreRoleResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid request for DeleteStoreRole", zap.Error(err))
+		return DeleteStoreRoleFailResponse("Invalid request for DeleteStoreRole", err, codes.InvalidArgument)
+	}
+	input, err := adapter.DeleteStoreRoleRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse DeleteStoreRole request to input error", zap.Error(err))
+		return DeleteStoreRoleFailResponse("Parse DeleteStoreRole request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.AuthService.DeleteStoreRole(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: DeleteStoreRole error in AuthService", zap.Error(err))
+		return DeleteStoreRoleFailResponse("DeleteStoreRole error in AuthService", err, storeRoleErrorCode(err))
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.DeleteStoreRoleOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse DeleteStoreRole output to response error", zap.Error(err))
+		return DeleteStoreRoleFailResponse("parse DeleteStoreRole output to response error", err, codes.Internal)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid response for DeleteStoreRole", zap.Error(err))
+		return DeleteStoreRoleFailResponse("invalid response for DeleteStoreRole", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// storeRoleErrorCode return the status code of an error of the store role methods of AuthService
+func storeRoleErrorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, service.ErrPermissionDenied):
+		return codes.PermissionDenied
+	case errors.Is(err, repository.ErrRoleNotFound):
+		return codes.NotFound
+	case errors.Is(err, repository.ErrRoleInUse):
+		return codes.FailedPrecondition
+	default:
+		return codes.InvalidArgument
+	}
+}
+
+// ListSessions handle list sessions request
+func (s *AuthServer) ListSessions(ctx context.Context, req *authpb.ListSessionsRequest) (*authpb.ListSessionsResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid request for ListSessions", zap.Error(err))
+		return ListSessionsFailResponse("Invalid request for ListSessions", err, codes.InvalidArgument)
+	}
+	input, err := adapter.ListSessionsRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse ListSessions request to input error", zap.Error(err))
+		return ListSessionsFailResponse("Parse ListSessions request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.AuthService.ListSessions(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: ListSessions error in AuthService", zap.Error(err))
+		return ListSessionsFailResponse("ListSessions error in AuthService", err, codes.Unauthenticated)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.ListSessionsOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse ListSessions output to response error", zap.Error(err))
+		return ListSessionsFailResponse("parse ListSessions output to response error", err, codes.Internal)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid response for ListSessions", zap.Error(err))
+		return ListSessionsFailResponse("invalid response for ListSessions", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// RevokeSession handle revoke session request
+func (s *AuthServer) RevokeSession(ctx context.Context, req *authpb.RevokeSessionRequest) (*authpb.RevokeSessionResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid request for RevokeSession", zap.Error(err))
+		return RevokeSessionFailResponse("Invalid request for RevokeSession", err, codes.InvalidArgument)
+	}
+	input, err := adapter.RevokeSessionRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse RevokeSession request to input error", zap.Error(err))
+		return RevokeSessionFailResponse("Parse RevokeSession request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.AuthService.RevokeSession(ctx, input)
+	if errors.Is(err, repository.ErrSessionNotFound) {
+		return RevokeSessionFailResponse("RevokeSession error in AuthService", err, codes.NotFound)
+	}
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: RevokeSession error in AuthService", zap.Error(err))
+		return RevokeSessionFailResponse("RevokeSession error in AuthService", err, codes.Unauthenticated)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.RevokeSessionOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: parse RevokeSession output to response error", zap.Error(err))
+		return RevokeSessionFailResponse("parse RevokeSession output to response error", err, codes.Internal)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("AuthServer: invalid response for RevokeSession", zap.Error(err))
+		return RevokeSessionFailResponse("invalid response for RevokeSession", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
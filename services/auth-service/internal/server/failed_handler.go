@@ -1,12 +1,45 @@
 package server
 
 import (
+	"auth-service/internal/service/password"
 	"auth-service/pkg/pb"
+	"errors"
 
+	"buf.build/go/protovalidate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// fieldStatusError return the status error of err like status.Error. Invalid requests and passwords refused by
+// the password policy are InvalidArgument with BadRequest details, so clients can show errors by field.
+func fieldStatusError(code codes.Code, err error) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+	var policyErr *password.PolicyError
+	var validationErr *protovalidate.ValidationError
+	switch {
+	case errors.As(err, &policyErr):
+		for _, violation := range policyErr.Violations {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: policyErr.Field, Description: violation})
+		}
+	case errors.As(err, &validationErr):
+		for _, violation := range validationErr.Violations {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       protovalidate.FieldPathString(violation.Proto.GetField()),
+				Description: violation.Proto.GetMessage(),
+			})
+		}
+	default:
+		return status.Error(code, err.Error())
+	}
+
+	st, detailsErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if detailsErr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st.Err()
+}
+
 func LoginFailResponse(message string, err error, code codes.Code) (*authpb.LoginResponse, error) {
 	return &authpb.LoginResponse{
 		Message:      message,
@@ -20,14 +53,14 @@ func RegisterFailResponse(message string, err error, code codes.Code) (*authpb.R
 	return &authpb.RegisterResponse{
 		Message: message,
 		Success: false,
-	}, status.Error(code, err.Error())
+	}, fieldStatusError(code, err)
 }
 
 func ChangePasswordFailResponse(message string, err error, code codes.Code) (*authpb.ChangePasswordResponse, error) {
 	return &authpb.ChangePasswordResponse{
 		Message: message,
 		Success: false,
-	}, status.Error(code, err.Error())
+	}, fieldStatusError(code, err)
 }
 
 func RefreshTokenFailResponse(message string, err error, code codes.Code) (*authpb.RefreshTokenResponse, error) {
@@ -43,7 +76,7 @@ func RegisterSellerRolesFailResponse(message string, err error, code codes.Code)
 	return &authpb.RegisterSellerRolesResponse{
 		Message: message,
 		Success: false,
-	}, status.Error(code, err.Error())
+	}, fieldStatusError(code, err)
 }
 
 func GetStoreIDRoleByIdFailResponse(message string, err error, code codes.Code) (*authpb.GetStoreIDRoleByIDResponse, error) {
@@ -52,3 +85,136 @@ func GetStoreIDRoleByIdFailResponse(message string, err error, code codes.Code)
 		Success: false,
 	}, status.Error(code, err.Error())
 }
+
+func GetStoreAccountIDsFailResponse(message string, err error, code codes.Code) (*authpb.GetStoreAccountIDsResponse, error) {
+	return &authpb.GetStoreAccountIDsResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func LogoutFailResponse(message string, err error, code codes.Code) (*authpb.LogoutResponse, error) {
+	return &authpb.LogoutResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func LogoutAllFailResponse(message string, err error, code codes.Code) (*authpb.LogoutAllResponse, error) {
+	return &authpb.LogoutAllResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func GetJWKSFailResponse(message string, err error, code codes.Code) (*authpb.GetJWKSResponse, error) {
+	return &authpb.GetJWKSResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func UnlockAccountFailResponse(message string, err error, code codes.Code) (*authpb.UnlockAccountResponse, error) {
+	return &authpb.UnlockAccountResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func RequestPasswordResetFailResponse(message string, err error, code codes.Code) (*authpb.RequestPasswordResetResponse, error) {
+	return &authpb.RequestPasswordResetResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func ResetPasswordFailResponse(message string, err error, code codes.Code) (*authpb.ResetPasswordResponse, error) {
+	return &authpb.ResetPasswordResponse{
+		Message: message,
+		Success: false,
+	}, fieldStatusError(code, err)
+}
+
+func BeginTOTPEnrollmentFailResponse(message string, err error, code codes.Code) (*authpb.BeginTOTPEnrollmentResponse, error) {
+	return &authpb.BeginTOTPEnrollmentResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func ConfirmTOTPEnrollmentFailResponse(message string, err error, code codes.Code) (*authpb.ConfirmTOTPEnrollmentResponse, error) {
+	return &authpb.ConfirmTOTPEnrollmentResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func VerifySecondFactorFailResponse(message string, err error, code codes.Code) (*authpb.VerifySecondFactorResponse, error) {
+	return &authpb.VerifySecondFactorResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func DisableTOTPFailResponse(message string, err error, code codes.Code) (*authpb.DisableTOTPResponse, error) {
+	return &authpb.DisableTOTPResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func BeginOIDCLoginFailResponse(message string, err error, code codes.Code) (*authpb.BeginOIDCLoginResponse, error) {
+	return &authpb.BeginOIDCLoginResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func CompleteOIDCLoginFailResponse(message string, err error, code codes.Code) (*authpb.CompleteOIDCLoginResponse, error) {
+	return &authpb.CompleteOIDCLoginResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func ListStoreRolesFailResponse(message string, err error, code codes.Code) (*authpb.ListStoreRolesResponse, error) {
+	return &authpb.ListStoreRolesResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func CreateStoreRoleFailResponse(message string, err error, code codes.Code) (*authpb.CreateStoreRoleResponse, error) {
+	return &authpb.CreateStoreRoleResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func UpdateStoreRoleFailResponse(message string, err error, code codes.Code) (*authpb.UpdateStoreRoleResponse, error) {
+	return &authpb.UpdateStoreRoleResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func DeleteStoreRoleFailResponse(message string, err error, code codes.Code) (*authpb.DeleteStoreRoleResponse, error) {
+	return &authpb.DeleteStoreRoleResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func ListSessionsFailResponse(message string, err error, code codes.Code) (*authpb.ListSessionsResponse, error) {
+	return &authpb.ListSessionsResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func RevokeSessionFailResponse(message string, err error, code codes.Code) (*authpb.RevokeSessionResponse, error) {
+	return &authpb.RevokeSessionResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
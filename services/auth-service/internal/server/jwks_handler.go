@@ -0,0 +1,31 @@
+package server
+
+import (
+	"auth-service/pkg/dto"
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+// JWKSPath is the well-known path of the HTTP JWKS endpoint
+const JWKSPath = "/.well-known/jwks.json"
+
+// JWKSHandler serve the JWKS over HTTP for verifiers that do not use gRPC
+func (s *AuthServer) JWKSHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	output, err := s.AuthService.GetJWKS(r.Context(), &dto.GetJWKSInput{})
+	if err != nil {
+		s.ZapLogger.Warn("AuthServer: GetJWKS error in AuthService", zap.Error(err))
+		http.Error(w, "can not get JWKS", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "max-age=60")
+	if err := json.NewEncoder(w).Encode(output); err != nil {
+		s.ZapLogger.Warn("AuthServer: write JWKS error", zap.Error(err))
+	}
+}
@@ -10,6 +10,7 @@ func AccountModelToTokenRequest(account *model.Account) *dto.TokenRequest {
 		UserID:     account.ID,
 		Username:   account.Username,
 		Role:       account.Role,
+		StoreID:    account.StoreID,
 		PwdVersion: account.PwdVersion,
 	}
 }
@@ -5,14 +5,18 @@ import (
 	"auth-service/internal/config/messagequeue/kafkaimpl"
 	"auth-service/internal/repository"
 	"auth-service/internal/service/adapter"
+	"auth-service/internal/service/notify"
+	"auth-service/internal/service/oidc"
+	"auth-service/internal/service/password"
 	"auth-service/pkg/dto"
 	"auth-service/pkg/model"
 	"context"
 	"errors"
-	"fmt"
 	"log"
+	"slices"
 	"time"
 
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
@@ -20,27 +24,35 @@ import (
 
 // AuthService is responsible for interacting with AuthServer and AccountRepository
 type AuthService struct {
-	AccountRepo   *repository.AccountRepository
-	JWTSecret     string
-	JWTExpireTime time.Duration
-	MQProducer    messagequeue.Producer
-	MQConsumer    messagequeue.Consumer
-	KafkaClient   *kafkaimpl.KafkaClient
-	ZapLogger     *zap.Logger
+	AccountRepo    *repository.AccountRepository
+	JWTExpireTime  time.Duration
+	RedisClient    *redis.Client
+	Notifier       notify.Notifier
+	OIDCProviders  map[string]*oidc.Provider // by configured name
+	PasswordPolicy *password.Policy
+	MQProducer     messagequeue.Producer
+	MQConsumer     messagequeue.Consumer
+	KafkaClient    *kafkaimpl.KafkaClient
+	ZapLogger      *zap.Logger
+
+	signingKeys signingKeyCache
 }
 
 // NewAuthService create new AuthService
-func NewAuthService(accountRepo *repository.AccountRepository, jwtSecret string, jwtExpireTime time.Duration, logger *zap.Logger,
-	producer messagequeue.Producer, consumer messagequeue.Consumer, kafkaClient *kafkaimpl.KafkaClient) *AuthService {
+func NewAuthService(accountRepo *repository.AccountRepository, jwtExpireTime time.Duration, redisClient *redis.Client, notifier notify.Notifier,
+	oidcProviders map[string]*oidc.Provider, passwordPolicy *password.Policy, logger *zap.Logger, producer messagequeue.Producer, consumer messagequeue.Consumer, kafkaClient *kafkaimpl.KafkaClient) *AuthService {
 
 	return &AuthService{
-		AccountRepo:   accountRepo,
-		JWTSecret:     jwtSecret,
-		JWTExpireTime: jwtExpireTime,
-		MQProducer:    producer,
-		MQConsumer:    consumer,
-		KafkaClient:   kafkaClient,
-		ZapLogger:     logger,
+		AccountRepo:    accountRepo,
+		JWTExpireTime:  jwtExpireTime,
+		RedisClient:    redisClient,
+		Notifier:       notifier,
+		OIDCProviders:  oidcProviders,
+		PasswordPolicy: passwordPolicy,
+		MQProducer:     producer,
+		MQConsumer:     consumer,
+		KafkaClient:    kafkaClient,
+		ZapLogger:      logger,
 	}
 }
 
@@ -51,6 +63,9 @@ func (s *AuthService) Register(ctx context.Context, input *dto.RegisterInput) (*
 	if input.Role == input.RoleNotRegister {
 		return nil, errors.New("can not register role seller_employee")
 	}
+	if err := s.checkPassword("password", input.Password, input.Username); err != nil {
+		return nil, err
+	}
 
 	// Check account existed
 	existingAccount, err := s.AccountRepo.GetAccountByUsernameRole(ctx, input.Username, input.Role)
@@ -93,11 +108,18 @@ func (s *AuthService) Register(ctx context.Context, input *dto.RegisterInput) (*
 // Login handle logic login
 func (s *AuthService) Login(ctx context.Context, req *dto.LoginInput) (*dto.LoginOutput, error) {
 
-	// Check account existed
+	// Refuse attempts of a delayed or locked account or IP, whatever the password
+	if err := s.checkLoginAllowed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	// Check account existed, a missing account costs the same bcrypt comparison and failure as a wrong password
 	account, err := s.AccountRepo.GetAccountByUsernameRole(ctx, req.Username, req.Role)
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		s.ZapLogger.Warn("AuthService: account not found", zap.String("username", req.Username))
-		return nil, errors.New("username or password is incorrect")
+		_ = bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(req.Password))
+		s.recordLoginFailure(ctx, req, 0)
+		return nil, ErrLoginIncorrect
 	}
 	if account == nil {
 		s.ZapLogger.Error("AuthService: DB error", zap.Error(err))
@@ -107,15 +129,51 @@ func (s *AuthService) Login(ctx context.Context, req *dto.LoginInput) (*dto.Logi
 	// Check password
 	if err := bcrypt.CompareHashAndPassword([]byte(account.Password), []byte(req.Password)); err != nil || account.Role != req.Role {
 		s.ZapLogger.Warn("AuthService: wrong password", zap.Error(err))
-		return nil, errors.New("username or password is incorrect")
+		s.recordLoginFailure(ctx, req, account.ID)
+		return nil, ErrLoginIncorrect
+	}
+	s.resetLoginFailures(ctx, req)
+
+	return s.completeLogin(ctx, account, &req.SessionClient)
+}
+
+// completeLogin finish the login of an authenticated account from client. Accounts with a second factor, or whose role
+// requires one, get a challenge instead of tokens.
+func (s *AuthService) completeLogin(ctx context.Context, account *model.Account, client *dto.SessionClient) (*dto.LoginOutput, error) {
+	enrolled, err := s.hasSecondFactor(ctx, account.ID)
+	if err != nil {
+		return nil, err
+	}
+	required, err := s.requiresSecondFactor(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	if enrolled || required {
+		// A right password does not give new attempts at the codes of a locked second factor
+		if err := s.checkSecondFactorAllowed(ctx, account.ID); err != nil {
+			return nil, err
+		}
+		challengeToken, err := s.generateChallengeToken(ctx, adapter.AccountModelToTokenRequest(account))
+		if err != nil {
+			s.ZapLogger.Warn("AuthService: challenge token generation failure", zap.Error(err))
+			return nil, err
+		}
+		message := "Second factor required"
+		if !enrolled {
+			message = "Second factor enrolment required"
+		}
+		return &dto.LoginOutput{
+			Message:              message,
+			Success:              true,
+			SecondFactorRequired: true,
+			EnrollmentRequired:   !enrolled,
+			ChallengeToken:       challengeToken,
+		}, nil
 	}
 
 	// Create token
-	tokenRequest := adapter.AccountModelToTokenRequest(account)
-	fmt.Printf("Account\n: %v", account)
-	signedAccessToken, signedRefreshToken, err := s.generateToken(ctx, tokenRequest)
+	signedAccessToken, signedRefreshToken, err := s.issueLoginTokens(ctx, account, client)
 	if err != nil {
-		s.ZapLogger.Warn("AuthService: token generation failure")
 		return nil, err
 	}
 
@@ -127,7 +185,7 @@ func (s *AuthService) Login(ctx context.Context, req *dto.LoginInput) (*dto.Logi
 	}, nil
 }
 
-// RegisterSellerRoles handle logic create accounts for seller_admin or seller_employee role
+// RegisterSellerRoles handle logic create accounts of store staff, with a store staff system role or a custom role of the store
 func (s *AuthService) RegisterSellerRoles(ctx context.Context, input *dto.RegisterSellerRolesInput) (*dto.RegisterSellerRolesOutput, error) {
 
 	// Validate Role and Account
@@ -141,9 +199,19 @@ func (s *AuthService) RegisterSellerRoles(ctx context.Context, input *dto.Regist
 		return nil, err
 	}
 
-	// Only SellerAdmin have Store can create this role
-	if acc.Role != "seller_admin" || acc.StoreID == 0 {
-		return nil, errors.New("this account can not take this action")
+	// Only accounts with a store and the permission can register its staff
+	allowed, err := s.accountHasPermission(ctx, acc, model.PermissionStaffWrite)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed || acc.StoreID == 0 {
+		return nil, ErrPermissionDenied
+	}
+	if !slices.Contains(model.StoreStaffRoles, input.Role) {
+		role, err := s.AccountRepo.GetRole(ctx, input.Role, acc.StoreID)
+		if err != nil || role.StoreID != acc.StoreID {
+			return nil, errors.New("role is not a staff role of the store")
+		}
 	}
 
 	log.Printf("Store ID: %d\n", acc.StoreID)
@@ -153,7 +221,7 @@ func (s *AuthService) RegisterSellerRoles(ctx context.Context, input *dto.Regist
 		Password:        input.Password,
 		Role:            input.Role,
 		StoreID:         acc.StoreID,
-		RoleNotRegister: "buyer",
+		RoleNotRegister: model.RoleBuyer,
 	})
 	if err != nil {
 		return nil, err
@@ -174,11 +242,30 @@ func (s *AuthService) GetStoreIDRoleById(ctx context.Context, input *dto.GetStor
 		s.ZapLogger.Warn("AuthService: Get account error", zap.Error(err))
 		return nil, err
 	}
+	permissions, err := s.rolePermissions(ctx, acc.Role, acc.StoreID)
+	if err != nil {
+		return nil, err
+	}
 	return &dto.GetStoreIDRoleByIdOutput{
-		Message: acc.Username,
-		Success: true,
-		Role:    acc.Role,
-		StoreID: acc.StoreID,
+		Message:     acc.Username,
+		Success:     true,
+		Role:        acc.Role,
+		StoreID:     acc.StoreID,
+		Permissions: permissions,
 	}, nil
 
 }
+
+// GetStoreAccountIDs return the IDs of the accounts of a store, services use them to find the products of a store
+func (s *AuthService) GetStoreAccountIDs(ctx context.Context, input *dto.GetStoreAccountIDsInput) (*dto.GetStoreAccountIDsOutput, error) {
+	userIDs, err := s.AccountRepo.GetAccountIDsByStoreID(ctx, input.StoreID)
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: get accounts of store error", zap.Uint64("storeID", input.StoreID), zap.Error(err))
+		return nil, err
+	}
+	return &dto.GetStoreAccountIDsOutput{
+		Message: "Get accounts of store successfully",
+		Success: true,
+		UserIDs: userIDs,
+	}, nil
+}
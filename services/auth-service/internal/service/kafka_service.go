@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/segmentio/kafka-go"
@@ -82,6 +83,76 @@ func (s *AuthService) producerPwdVersionKafkaEvent(ctx context.Context, eventMod
 	return nil
 }
 
+// ProducerTokRevKafkaEventWorker publish pending TokenRevocationEvent to topic every interval
+func (s *AuthService) ProducerTokRevKafkaEventWorker(ctx context.Context, interval time.Duration, limit int, topic string) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			// Cancel by context
+			case <-ctx.Done():
+				s.ZapLogger.Info("AuthService: Worker send TokenRevocation Kafka event stop by context")
+				return
+			// Interval time
+			case <-ticker.C:
+				if err := s.producerTokenRevocationKafkaEventBatch(ctx, limit, topic); err != nil {
+					s.ZapLogger.Warn("AuthService: error in procedure TokRevKafkaEvent batch", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+func (s *AuthService) producerTokenRevocationKafkaEventBatch(ctx context.Context, limit int, topic string) error {
+	// Create context for function
+	ctxEachEvent, cancel := context.WithTimeout(ctx, 9*time.Second)
+	defer cancel()
+
+	// Get models from DB
+	eventsModel, err := s.AccountRepo.GetTokenRevocationEventNotPublish(limit)
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: can not get TokenRevocation events from OutboxDB", zap.Error(err))
+		return err
+	}
+
+	var firstErr error
+	for _, eventModel := range eventsModel {
+		if err := s.producerTokenRevocationKafkaEvent(ctxEachEvent, eventModel, topic); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (s *AuthService) producerTokenRevocationKafkaEvent(ctx context.Context, eventModel *outbox.TokenRevocationKafkaEvent, topic string) error {
+	// Parse event model to json
+	eventJson, err := json.Marshal(eventModel)
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: can not marshal TokenRevocation event", zap.Uint64("eventID", eventModel.ID), zap.Error(err))
+		return err
+	}
+	// Publish event, keyed by user so the revocations of a user stay ordered
+	key := []byte(strconv.FormatUint(eventModel.UserID, 10))
+	if err := s.MQProducer.Publish(ctx, &kafka.Hash{}, topic, key, eventJson); err != nil {
+		s.ZapLogger.Warn("AuthService: publish to Kafka failure", zap.Error(err))
+		if err2 := s.AccountRepo.UpdateTokenRevocationEventStatus(ctx, eventModel.ID, "FAILED"); err2 != nil {
+			s.ZapLogger.Warn("AuthService: publish to Kafka failure and can not update OutboxDB")
+			return err2
+		}
+		return err
+	}
+	// Update OutboxDB if procedure successfully
+	if err := s.AccountRepo.UpdateTokenRevocationEventStatus(ctx, eventModel.ID, "SUCCESS"); err != nil {
+		s.ZapLogger.Warn("AuthService: publish to Kafka success but update to OutboxDB failed")
+		return err
+	}
+
+	s.ZapLogger.Info("AuthService: publish TokenRevocation event to Kafka success", zap.Uint64("eventID", eventModel.ID))
+	return nil
+}
+
 func (s *AuthService) UpdateStoreIDFromKafka(ctx context.Context, msg *kafka.Message) error {
 
 	fmt.Println("UpdateStoreIDFromKafka")
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"auth-service/pkg/dto"
+	"auth-service/pkg/model"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// TopicLoginLocked receive a dto.LoginLockedKafkaEvent when failed logins lock an account or an IP
+const TopicLoginLocked = "auth.login_locked"
+
+// ErrLoginIncorrect is the only failure of a login with wrong credentials, whether the account exists or not
+var ErrLoginIncorrect = errors.New("username or password is incorrect")
+
+// LoginThrottledError is returned for a login attempt while its account or IP is delayed or locked
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottledError) Error() string {
+	return fmt.Sprintf("too many failed login attempts, retry in %d seconds", int64(e.RetryAfter.Round(time.Second)/time.Second))
+}
+
+// dummyPasswordHash is compared for logins of missing accounts, so they take as long as a wrong password
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("dummy-password"), bcrypt.DefaultCost)
+
+const (
+	loginFailureWindow = 15 * time.Minute // failures are counted within this time of the first one
+	loginLockout       = 15 * time.Minute
+	loginMaxDelay      = time.Minute
+)
+
+// loginLimit throttle the failed logins of an account or an IP. After freeFailures, each failure delays
+// the next attempt twice as long as the previous one, lockFailures lock out for loginLockout.
+type loginLimit struct {
+	scope        string
+	freeFailures int64
+	lockFailures int64
+}
+
+var (
+	accountLoginLimit = loginLimit{scope: "account", freeFailures: 3, lockFailures: 10}
+	ipLoginLimit      = loginLimit{scope: "ip", freeFailures: 10, lockFailures: 100} // an IP may try many accounts
+)
+
+func (l loginLimit) failuresKey(id string) string {
+	return fmt.Sprintf("login_failures:%s:%s", l.scope, id)
+}
+
+func (l loginLimit) blockedKey(id string) string {
+	return fmt.Sprintf("login_blocked:%s:%s", l.scope, id)
+}
+
+// delay return how long the attempt after failures is refused
+func (l loginLimit) delay(failures int64) time.Duration {
+	if failures <= l.freeFailures {
+		return 0
+	}
+	shift := failures - l.freeFailures - 1
+	if shift >= 6 {
+		return loginMaxDelay
+	}
+	return min(time.Second<<shift, loginMaxDelay)
+}
+
+// loginAccountID identify the account of a login by username and role, it does not need the account to exist
+func loginAccountID(username, role string) string {
+	return role + ":" + username
+}
+
+// loginLimits return the limits and their IDs that apply to a login
+func loginLimits(input *dto.LoginInput) map[loginLimit]string {
+	limits := map[loginLimit]string{accountLoginLimit: loginAccountID(input.Username, input.Role)}
+	if input.ClientIP != "" {
+		limits[ipLoginLimit] = input.ClientIP
+	}
+	return limits
+}
+
+// checkLoginAllowed return a LoginThrottledError when the account or the IP of input is delayed or locked.
+// Logins are allowed when Redis can not be reached, so it does not take logins down with it.
+func (s *AuthService) checkLoginAllowed(ctx context.Context, input *dto.LoginInput) error {
+	var retryAfter time.Duration
+	for limit, id := range loginLimits(input) {
+		ttl, err := s.RedisClient.PTTL(ctx, limit.blockedKey(id)).Result()
+		if err != nil {
+			s.ZapLogger.Warn("AuthService: check login throttle failure", zap.String("scope", limit.scope), zap.Error(err))
+			continue
+		}
+		retryAfter = max(retryAfter, ttl)
+	}
+	if retryAfter > 0 {
+		s.ZapLogger.Warn("AuthService: login throttled", zap.String("username", input.Username), zap.String("ip", input.ClientIP))
+		return &LoginThrottledError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// recordLoginFailure count a failed login of input for its account and IP, then delay or lock them.
+// userID is 0 when the account does not exist.
+func (s *AuthService) recordLoginFailure(ctx context.Context, input *dto.LoginInput, userID uint64) {
+	for limit, id := range loginLimits(input) {
+		failuresKey := limit.failuresKey(id)
+		failures, err := s.RedisClient.Incr(ctx, failuresKey).Result()
+		if err != nil {
+			s.ZapLogger.Warn("AuthService: count failed login failure", zap.String("scope", limit.scope), zap.Error(err))
+			continue
+		}
+		if failures == 1 {
+			if err := s.RedisClient.Expire(ctx, failuresKey, loginFailureWindow).Err(); err != nil {
+				s.ZapLogger.Warn("AuthService: set failed login window failure", zap.String("key", failuresKey), zap.Error(err))
+				s.RedisClient.Del(ctx, failuresKey)
+			}
+		}
+
+		if failures >= limit.lockFailures {
+			if err := s.RedisClient.Set(ctx, limit.blockedKey(id), "locked", loginLockout).Err(); err != nil {
+				s.ZapLogger.Warn("AuthService: lock login failure", zap.String("scope", limit.scope), zap.Error(err))
+				continue
+			}
+			s.RedisClient.Del(ctx, failuresKey)
+			event := &dto.LoginLockedKafkaEvent{
+				Scope:       limit.scope,
+				Failures:    failures,
+				LockedUntil: time.Now().Add(loginLockout),
+			}
+			if limit == accountLoginLimit {
+				event.UserID, event.Username, event.Role = userID, input.Username, input.Role
+			} else {
+				event.ClientIP = input.ClientIP
+			}
+			s.raiseLoginLocked(ctx, event)
+			continue
+		}
+		if delay := limit.delay(failures); delay > 0 {
+			if err := s.RedisClient.Set(ctx, limit.blockedKey(id), "delayed", delay).Err(); err != nil {
+				s.ZapLogger.Warn("AuthService: delay login failure", zap.String("scope", limit.scope), zap.Error(err))
+			}
+		}
+	}
+}
+
+// resetLoginFailures forget the failed logins of the account of input after a successful login
+func (s *AuthService) resetLoginFailures(ctx context.Context, input *dto.LoginInput) {
+	if err := s.RedisClient.Del(ctx, accountLoginLimit.failuresKey(loginAccountID(input.Username, input.Role))).Err(); err != nil {
+		s.ZapLogger.Warn("AuthService: reset failed logins failure", zap.Error(err))
+	}
+}
+
+// raiseLoginLocked audit a locked account or second factor and publish event to TopicLoginLocked
+func (s *AuthService) raiseLoginLocked(ctx context.Context, event *dto.LoginLockedKafkaEvent) {
+	s.ZapLogger.Warn("AuthService: login locked", zap.String("scope", event.Scope), zap.String("username", event.Username),
+		zap.String("ip", event.ClientIP), zap.Int64("failures", event.Failures))
+	if event.UserID != 0 {
+		failed := "failed logins"
+		if event.Scope == "second_factor" {
+			failed = "wrong second factor codes"
+		}
+		if err := s.AccountRepo.CreateAuditLog(ctx, &model.AuditLog{
+			UserID: event.UserID,
+			Event:  model.AuditLoginLocked,
+			Detail: fmt.Sprintf("%d %s, locked until %s", event.Failures, failed, event.LockedUntil.Format(time.RFC3339)),
+		}); err != nil {
+			s.ZapLogger.Warn("AuthService: audit login locked failure", zap.Error(err))
+		}
+	}
+
+	eventJson, err := json.Marshal(event)
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: can not marshal LoginLocked event", zap.Error(err))
+		return
+	}
+	if err := s.MQProducer.Publish(ctx, &kafka.LeastBytes{}, TopicLoginLocked, []byte(event.Scope), eventJson); err != nil {
+		s.ZapLogger.Warn("AuthService: publish LoginLocked event failure", zap.Error(err))
+	}
+}
+
+// UnlockAccount clear the failed logins, delay and lockout of an account, of an IP, or of both.
+// The wrong second factor codes of the account are cleared too.
+func (s *AuthService) UnlockAccount(ctx context.Context, input *dto.UnlockAccountInput) (*dto.UnlockAccountOutput, error) {
+	if input.Username != "" && input.Role == "" {
+		return nil, errors.New("role is required with username")
+	}
+	var keys []string
+	if input.Username != "" {
+		id := loginAccountID(input.Username, input.Role)
+		keys = append(keys, accountLoginLimit.failuresKey(id), accountLoginLimit.blockedKey(id))
+	}
+	if input.ClientIP != "" {
+		keys = append(keys, ipLoginLimit.failuresKey(input.ClientIP), ipLoginLimit.blockedKey(input.ClientIP))
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("username or client ip is required")
+	}
+	if err := s.RedisClient.Del(ctx, keys...).Err(); err != nil {
+		s.ZapLogger.Warn("AuthService: unlock login failure", zap.Error(err))
+		return nil, err
+	}
+
+	if input.Username != "" {
+		account, err := s.AccountRepo.GetAccountByUsernameRole(ctx, input.Username, input.Role)
+		if err == nil && account != nil {
+			s.resetSecondFactorFailures(ctx, account.ID)
+			if err := s.AccountRepo.CreateAuditLog(ctx, &model.AuditLog{
+				UserID: account.ID,
+				Event:  model.AuditLoginUnlocked,
+			}); err != nil {
+				s.ZapLogger.Warn("AuthService: audit login unlocked failure", zap.Error(err))
+			}
+		}
+	}
+	s.ZapLogger.Info("AuthService: login unlocked", zap.String("username", input.Username), zap.String("ip", input.ClientIP))
+	return &dto.UnlockAccountOutput{
+		Message: "Unlocked successfully",
+		Success: true,
+	}, nil
+}
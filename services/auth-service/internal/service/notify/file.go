@@ -0,0 +1,43 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+// FileNotifier append notifications to a file as JSON lines, it is meant for local runs and manual tests
+type FileNotifier struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// NewFileNotifier create new FileNotifier writing to path
+func NewFileNotifier(path string) *FileNotifier {
+	return &FileNotifier{Path: path}
+}
+
+func (n *FileNotifier) Notify(ctx context.Context, notification *Notification) error {
+	line, err := json.Marshal(struct {
+		*Notification
+		SentAt time.Time `json:"sent_at"`
+	}{notification, time.Now()})
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	file, err := os.OpenFile(n.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
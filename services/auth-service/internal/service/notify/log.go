@@ -0,0 +1,29 @@
+package notify
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// LogNotifier write notifications to the service log, it is meant for local runs only
+// since notifications may carry secrets such as reset tokens
+type LogNotifier struct {
+	Logger *zap.Logger
+}
+
+// NewLogNotifier create new LogNotifier
+func NewLogNotifier(logger *zap.Logger) *LogNotifier {
+	return &LogNotifier{Logger: logger}
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, notification *Notification) error {
+	n.Logger.Info("Notifier: notification",
+		zap.String("kind", notification.Kind),
+		zap.Uint64("userID", notification.UserID),
+		zap.String("username", notification.Username),
+		zap.String("subject", notification.Subject),
+		zap.String("body", notification.Body),
+	)
+	return nil
+}
@@ -0,0 +1,59 @@
+// Package notify defines how messages reach account owners. Accounts have no contact details in auth-service,
+// a Notifier is given the account and delivers to wherever it knows to reach it.
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// Notifier sinks
+const (
+	SinkLog  = "log"
+	SinkFile = "file"
+)
+
+// ErrUnknownSink is returned by New for a sink that is not implemented
+var ErrUnknownSink = errors.New("unknown notifier sink")
+
+// Notification kinds
+const (
+	KindPasswordReset = "password_reset"
+)
+
+// Notification is a message for the owner of an account
+type Notification struct {
+	Kind     string `json:"kind"`
+	UserID   uint64 `json:"user_id"`
+	Username string `json:"username"`
+	Role     string `json:"role"`
+	Subject  string `json:"subject"`
+	Body     string `json:"body"`
+}
+
+// Notifier deliver notifications
+type Notifier interface {
+	Notify(ctx context.Context, notification *Notification) error
+}
+
+// Config select and configure a sink
+type Config struct {
+	Sink     string
+	FilePath string
+}
+
+// New create the Notifier of config.Sink
+func New(config *Config, logger *zap.Logger) (Notifier, error) {
+	switch strings.ToLower(config.Sink) {
+	case "", SinkLog:
+		return NewLogNotifier(logger), nil
+	case SinkFile:
+		return NewFileNotifier(config.FilePath), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSink, config.Sink)
+	}
+}
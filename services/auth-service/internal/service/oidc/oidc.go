@@ -0,0 +1,361 @@
+// Package oidc implements the relying party side of the OpenID Connect authorization code flow with PKCE.
+// Provider endpoints are read from the discovery document of the issuer, ID tokens are verified with its JWKS.
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultScopes are requested when a provider is configured without scopes
+var DefaultScopes = []string{"openid", "email", "profile"}
+
+var (
+	// ErrUnknownProvider is returned for a provider name that is not configured
+	ErrUnknownProvider = errors.New("unknown identity provider")
+
+	// ErrInvalidIDToken is returned for an ID token that fails verification
+	ErrInvalidIDToken = errors.New("invalid id token")
+)
+
+const (
+	// metadataReload is how long a discovery document and JWKS are used before they are fetched again
+	metadataReload = time.Hour
+
+	// keysMinReload is the least time between two JWKS fetches caused by an unknown kid
+	keysMinReload = 10 * time.Second
+)
+
+// Config is the registration of auth-service at a provider
+type Config struct {
+	Name         string // name of the provider in routes, e.g. google
+	Issuer       string
+	ClientID     string
+	ClientSecret string // empty for a public client, PKCE protects the code
+	Scopes       []string
+	RedirectURL  string // callback registered at the provider
+}
+
+// Claims are the claims of a verified ID token
+type Claims struct {
+	Nonce             string `json:"nonce"`
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	PreferredUsername string `json:"preferred_username"`
+	Name              string `json:"name"`
+	jwt.RegisteredClaims
+}
+
+// metadata is the part of the discovery document that is used
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider is a configured identity provider, its metadata and keys are fetched on first use.
+// A fetch is shared by the logins waiting for it and does not hold the cache.
+type Provider struct {
+	Config *Config
+	Client *http.Client
+
+	fetches       singleflight.Group
+	mu            sync.Mutex
+	metadata      *metadata
+	metadataAt    time.Time
+	keys          map[string]any
+	keysFetchedAt time.Time
+}
+
+// NewProvider create new Provider of config
+func NewProvider(config *Config) *Provider {
+	if len(config.Scopes) == 0 {
+		config.Scopes = DefaultScopes
+	}
+	return &Provider{
+		Config: config,
+		Client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// NewProviders create the Providers of configs by name
+func NewProviders(configs []*Config) map[string]*Provider {
+	providers := make(map[string]*Provider, len(configs))
+	for _, config := range configs {
+		providers[config.Name] = NewProvider(config)
+	}
+	return providers
+}
+
+// NewPKCE generate a code verifier and its S256 code challenge (RFC 7636)
+func NewPKCE() (string, string, error) {
+	verifier, err := RandomString(32)
+	if err != nil {
+		return "", "", err
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	return verifier, base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+// RandomString return n random bytes in unpadded base64url, for states, nonces and verifiers
+func RandomString(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// AuthCodeURL return the URL the user agent is sent to for login at the provider
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	md, err := p.loadMetadata(ctx)
+	if err != nil {
+		return "", err
+	}
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", p.Config.ClientID)
+	query.Set("redirect_uri", p.Config.RedirectURL)
+	query.Set("scope", strings.Join(p.Config.Scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", "S256")
+
+	separator := "?"
+	if strings.Contains(md.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return md.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+// Exchange redeem code at the token endpoint, then verify the returned ID token against nonce
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*Claims, error) {
+	md, err := p.loadMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.Config.RedirectURL)
+	form.Set("client_id", p.Config.ClientID)
+	form.Set("code_verifier", codeVerifier)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, md.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.Config.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.Config.ClientID), url.QueryEscape(p.Config.ClientSecret))
+	}
+
+	var response struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	status, err := p.doJSON(req, &response)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK || response.Error != "" {
+		return nil, fmt.Errorf("token endpoint returned %d %s %s", status, response.Error, response.ErrorDescription)
+	}
+	if response.IDToken == "" {
+		return nil, errors.New("token endpoint returned no id token")
+	}
+	return p.VerifyIDToken(ctx, response.IDToken, nonce)
+}
+
+// VerifyIDToken check the signature, issuer, audience, expiry and nonce of an ID token
+func (p *Provider) VerifyIDToken(ctx context.Context, idToken, nonce string) (*Claims, error) {
+	md, err := p.loadMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	claims := &Claims{}
+	_, err = jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.key(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "EdDSA"}),
+		jwt.WithIssuer(md.Issuer),
+		jwt.WithAudience(p.Config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrInvalidIDToken, err)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w, missing subject", ErrInvalidIDToken)
+	}
+	if claims.Nonce != nonce {
+		return nil, fmt.Errorf("%w, nonce mismatch", ErrInvalidIDToken)
+	}
+	return claims, nil
+}
+
+// loadMetadata return the cached discovery document, fetched when missing or older than metadataReload
+func (p *Provider) loadMetadata(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	md := p.metadata
+	fresh := md != nil && time.Since(p.metadataAt) < metadataReload
+	p.mu.Unlock()
+	if fresh {
+		return md, nil
+	}
+
+	// The fetch outlives a login that gives up waiting, the others may still wait for it
+	fetched, err, _ := p.fetches.Do("metadata", func() (any, error) {
+		md, err := p.fetchMetadata(context.WithoutCancel(ctx))
+		if err != nil {
+			return nil, err
+		}
+		p.mu.Lock()
+		p.metadata, p.metadataAt = md, time.Now()
+		p.keys = nil
+		p.mu.Unlock()
+		return md, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fetched.(*metadata), nil
+}
+
+// fetchMetadata get and check the discovery document of the issuer
+func (p *Provider) fetchMetadata(ctx context.Context) (*metadata, error) {
+	discoveryURL := strings.TrimRight(p.Config.Issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	var md metadata
+	status, err := p.doJSON(req, &md)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("discovery of %s returned %d", p.Config.Issuer, status)
+	}
+	if strings.TrimRight(md.Issuer, "/") != strings.TrimRight(p.Config.Issuer, "/") {
+		return nil, fmt.Errorf("discovery of %s returned issuer %s", p.Config.Issuer, md.Issuer)
+	}
+	if md.AuthorizationEndpoint == "" || md.TokenEndpoint == "" || md.JWKSURI == "" {
+		return nil, fmt.Errorf("discovery of %s is missing endpoints", p.Config.Issuer)
+	}
+	return &md, nil
+}
+
+// key return the public key of kid, the JWKS is fetched again for an unknown kid at most every keysMinReload
+func (p *Provider) key(ctx context.Context, kid string) (any, error) {
+	md, err := p.loadMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	key, ok := p.lookupKey(kid)
+	reload := !ok && time.Since(p.keysFetchedAt) >= keysMinReload
+	p.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+	if reload {
+		if _, err, _ := p.fetches.Do("jwks", func() (any, error) {
+			return nil, p.reloadKeys(context.WithoutCancel(ctx), md.JWKSURI)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+// reloadKeys fetch the JWKS at uri and replace the cached keys. Logins arriving during the fetch wait for it,
+// keysMinReload counts from its end whether it succeeds or not.
+func (p *Provider) reloadKeys(ctx context.Context, uri string) error {
+	defer func() {
+		p.mu.Lock()
+		p.keysFetchedAt = time.Now()
+		p.mu.Unlock()
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return err
+	}
+	var set struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	status, err := p.doJSON(req, &set)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("jwks of %s returned %d", p.Config.Issuer, status)
+	}
+	keys := make(map[string]any, len(set.Keys))
+	for _, raw := range set.Keys {
+		keyID, key, err := parseJWK(raw)
+		if err != nil {
+			continue // keys of unsupported types or uses are skipped
+		}
+		keys[keyID] = key
+	}
+	p.mu.Lock()
+	p.keys = keys
+	p.mu.Unlock()
+	return nil
+}
+
+// lookupKey find kid in the fetched keys, a token without kid matches the only key of a single key JWKS
+func (p *Provider) lookupKey(kid string) (any, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+// doJSON send req and decode a JSON response body into out whatever the status
+func (p *Provider) doJSON(req *http.Request, out any) (int, error) {
+	res, err := p.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return res.StatusCode, err
+	}
+	if err := json.Unmarshal(body, out); err != nil && res.StatusCode == http.StatusOK {
+		return res.StatusCode, err
+	}
+	return res.StatusCode, nil
+}
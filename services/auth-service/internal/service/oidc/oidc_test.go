@@ -0,0 +1,194 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewPKCE(t *testing.T) {
+	verifier, challenge, err := NewPKCE()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(verifier) < 43 || len(verifier) > 128 {
+		t.Errorf("verifier length = %d, RFC 7636 wants 43 to 128", len(verifier))
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	if want := base64.RawURLEncoding.EncodeToString(sum[:]); challenge != want {
+		t.Errorf("challenge = %q, want S256 of verifier %q", challenge, want)
+	}
+	other, _, err := NewPKCE()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == verifier {
+		t.Error("NewPKCE() returned the same verifier twice")
+	}
+}
+
+func b64(buf []byte) string {
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+func mustJSON(t *testing.T, v any) json.RawMessage {
+	t.Helper()
+	buf, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf
+}
+
+func TestParseJWK(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edPublic, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaE := b64(big.NewInt(int64(rsaKey.E)).Bytes())
+
+	tests := []struct {
+		name    string
+		jwk     map[string]string
+		wantErr bool
+	}{
+		{"rsa", map[string]string{"kty": "RSA", "kid": "a", "n": b64(rsaKey.N.Bytes()), "e": rsaE}, false},
+		{"rsa for signing", map[string]string{"kty": "RSA", "kid": "a", "use": "sig", "n": b64(rsaKey.N.Bytes()), "e": rsaE}, false},
+		{"rsa for encryption", map[string]string{"kty": "RSA", "kid": "a", "use": "enc", "n": b64(rsaKey.N.Bytes()), "e": rsaE}, true},
+		{"ec p-256", map[string]string{"kty": "EC", "kid": "b", "crv": "P-256", "x": b64(ecKey.X.Bytes()), "y": b64(ecKey.Y.Bytes())}, false},
+		{"ec point off curve", map[string]string{"kty": "EC", "kid": "b", "crv": "P-256", "x": b64(ecKey.X.Bytes()), "y": b64(ecKey.X.Bytes())}, true},
+		{"ec unknown curve", map[string]string{"kty": "EC", "kid": "b", "crv": "P-521", "x": b64(ecKey.X.Bytes()), "y": b64(ecKey.Y.Bytes())}, true},
+		{"ed25519", map[string]string{"kty": "OKP", "kid": "c", "crv": "Ed25519", "x": b64(edPublic)}, false},
+		{"ed25519 short key", map[string]string{"kty": "OKP", "kid": "c", "crv": "Ed25519", "x": b64(edPublic[:16])}, true},
+		{"x25519", map[string]string{"kty": "OKP", "kid": "c", "crv": "X25519", "x": b64(edPublic)}, true},
+		{"symmetric", map[string]string{"kty": "oct", "kid": "d", "k": b64([]byte("secret"))}, true},
+		{"bad base64", map[string]string{"kty": "RSA", "kid": "a", "n": "***", "e": rsaE}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kid, key, err := parseJWK(mustJSON(t, tt.jwk))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseJWK() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if kid != tt.jwk["kid"] || key == nil {
+				t.Errorf("parseJWK() = %q, %v", kid, key)
+			}
+		})
+	}
+}
+
+// newTestIssuer serve a discovery document and a JWKS of one Ed25519 key named kid,
+// each JWKS fetch waits for release when it is not nil
+func newTestIssuer(t *testing.T, kid string, release chan struct{}) (*Provider, *atomic.Int32) {
+	t.Helper()
+	public, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var jwksFetches atomic.Int32
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 server.URL,
+			"authorization_endpoint": server.URL + "/authorize",
+			"token_endpoint":         server.URL + "/token",
+			"jwks_uri":               server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, r *http.Request) {
+		jwksFetches.Add(1)
+		if release != nil {
+			<-release
+		}
+		json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{
+			{"kty": "OKP", "crv": "Ed25519", "kid": kid, "x": b64(public)},
+		}})
+	})
+	return NewProvider(&Config{Name: "test", Issuer: server.URL, ClientID: "client"}), &jwksFetches
+}
+
+func TestProviderKeyFetchDoesNotBlockCachedKeys(t *testing.T) {
+	release := make(chan struct{})
+	p, fetches := newTestIssuer(t, "key-2", release)
+	ctx := context.Background()
+	if _, err := p.loadMetadata(ctx); err != nil {
+		t.Fatal(err)
+	}
+	p.keys = map[string]any{"key-1": ed25519.PublicKey(make([]byte, ed25519.PublicKeySize))}
+
+	// Logins with the rotated kid wait for one shared fetch
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := p.key(ctx, "key-2")
+			errs <- err
+		}()
+	}
+	for fetches.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// Meanwhile logins with the cached kid go on
+	done := make(chan error, 1)
+	go func() {
+		_, err := p.key(ctx, "key-1")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("key() of cached kid error = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Error("key() of cached kid waited for the fetch")
+	}
+
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("key() of rotated kid error = %v", err)
+		}
+	}
+	if got := fetches.Load(); got != 1 {
+		t.Errorf("JWKS fetches = %d, want 1 shared fetch", got)
+	}
+
+	// A made up kid right after is refused without fetching again
+	if _, err := p.key(ctx, "key-3"); err == nil {
+		t.Error("key() of unknown kid succeeded")
+	}
+	if got := fetches.Load(); got != 1 {
+		t.Errorf("JWKS fetches = %d, want 1 within keysMinReload", got)
+	}
+}
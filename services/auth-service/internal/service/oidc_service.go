@@ -0,0 +1,258 @@
+package service
+
+import (
+	"auth-service/internal/service/oidc"
+	"auth-service/pkg/dto"
+	"auth-service/pkg/model"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// oidcStateTTL is how long a login begun at a provider can be completed
+const oidcStateTTL = 10 * time.Minute
+
+var (
+	// ErrOIDCStateInvalid is returned for a state that is unknown, used, expired or of another provider
+	ErrOIDCStateInvalid = errors.New("invalid or expired login state")
+
+	// ErrOIDCLinkUnauthenticated is returned when a link is completed by a caller logged in as another account
+	// than the one that began it
+	ErrOIDCLinkUnauthenticated = errors.New("linking was begun by another account")
+)
+
+// oidcState is what a login keeps in Redis between BeginOIDCLogin and CompleteOIDCLogin
+type oidcState struct {
+	Provider     string `json:"provider"`
+	CodeVerifier string `json:"code_verifier"`
+	Nonce        string `json:"nonce"`
+	LinkUserID   uint64 `json:"link_user_id"`
+}
+
+func oidcStateKey(state string) string {
+	return "oidc_state:" + state
+}
+
+// BeginOIDCLogin return the authorization URL of a provider for a new login, or for linking an identity
+// to input.UserID. The PKCE verifier and nonce stay in Redis under the returned state.
+func (s *AuthService) BeginOIDCLogin(ctx context.Context, input *dto.BeginOIDCLoginInput) (*dto.BeginOIDCLoginOutput, error) {
+	provider, ok := s.OIDCProviders[input.Provider]
+	if !ok {
+		return nil, oidc.ErrUnknownProvider
+	}
+	if input.UserID != 0 {
+		if _, err := s.AccountRepo.GetAccountById(ctx, input.UserID); err != nil {
+			s.ZapLogger.Warn("AuthService: account not found", zap.Uint64("userID", input.UserID), zap.Error(err))
+			return nil, errors.New("user_id is invalid")
+		}
+	}
+
+	state, err := oidc.RandomString(32)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := oidc.RandomString(32)
+	if err != nil {
+		return nil, err
+	}
+	codeVerifier, codeChallenge, err := oidc.NewPKCE()
+	if err != nil {
+		return nil, err
+	}
+	authorizationURL, err := provider.AuthCodeURL(ctx, state, nonce, codeChallenge)
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: identity provider discovery failure", zap.String("provider", input.Provider), zap.Error(err))
+		return nil, err
+	}
+
+	stateJson, err := json.Marshal(&oidcState{
+		Provider:     input.Provider,
+		CodeVerifier: codeVerifier,
+		Nonce:        nonce,
+		LinkUserID:   input.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.RedisClient.Set(ctx, oidcStateKey(state), stateJson, oidcStateTTL).Err(); err != nil {
+		s.ZapLogger.Warn("AuthService: save login state failure", zap.Error(err))
+		return nil, err
+	}
+
+	return &dto.BeginOIDCLoginOutput{
+		Message:          "Continue login at the identity provider",
+		Success:          true,
+		AuthorizationURL: authorizationURL,
+		State:            state,
+	}, nil
+}
+
+// CompleteOIDCLogin redeem the code returned by a provider with the state of BeginOIDCLogin. The identity is linked
+// to the account saved in the state when the login began for linking, otherwise its account is logged in like Login,
+// and created on first login.
+func (s *AuthService) CompleteOIDCLogin(ctx context.Context, input *dto.CompleteOIDCLoginInput) (*dto.CompleteOIDCLoginOutput, error) {
+
+	// A state is used once, whether the code is good or not
+	stateJson, err := s.RedisClient.GetDel(ctx, oidcStateKey(input.State)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrOIDCStateInvalid
+	}
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: read login state failure", zap.Error(err))
+		return nil, err
+	}
+	var state oidcState
+	if err := json.Unmarshal(stateJson, &state); err != nil || state.Provider != input.Provider {
+		return nil, ErrOIDCStateInvalid
+	}
+	provider, ok := s.OIDCProviders[state.Provider]
+	if !ok {
+		return nil, oidc.ErrUnknownProvider
+	}
+
+	if err := checkLinkCaller(state.LinkUserID, input.UserID); err != nil {
+		s.ZapLogger.Warn("AuthService: link completed by another caller", zap.Uint64("userID", state.LinkUserID),
+			zap.Uint64("callerID", input.UserID))
+		return nil, ErrOIDCLinkUnauthenticated
+	}
+
+	// Redeem code and verify the ID token
+	claims, err := provider.Exchange(ctx, input.Code, state.CodeVerifier, state.Nonce)
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: identity provider login failure", zap.String("provider", state.Provider), zap.Error(err))
+		return nil, err
+	}
+	identity := &model.ExternalIdentity{
+		UserID:      state.LinkUserID,
+		Provider:    state.Provider,
+		Issuer:      claims.Issuer,
+		Subject:     claims.Subject,
+		LastLoginAt: time.Now(),
+	}
+	if claims.EmailVerified {
+		identity.Email = claims.Email
+	}
+
+	// Link to the account that began the flow
+	if state.LinkUserID != 0 {
+		if err := s.AccountRepo.LinkExternalIdentity(ctx, identity); err != nil {
+			s.ZapLogger.Warn("AuthService: link identity failure", zap.Uint64("userID", state.LinkUserID), zap.Error(err))
+			return nil, err
+		}
+		return &dto.CompleteOIDCLoginOutput{
+			Message: "Identity linked successfully",
+			Success: true,
+			Linked:  true,
+		}, nil
+	}
+
+	// Log in the linked account, or create a buyer account on first login
+	created := false
+	var account *model.Account
+	existing, err := s.AccountRepo.GetExternalIdentity(ctx, identity.Issuer, identity.Subject)
+	switch {
+	case err == nil:
+		existing.Email = identity.Email
+		if err := s.AccountRepo.TouchExternalIdentity(ctx, existing); err != nil {
+			s.ZapLogger.Warn("AuthService: update identity failure", zap.Error(err))
+		}
+		account, err = s.AccountRepo.GetAccountById(ctx, existing.UserID)
+		if err != nil {
+			s.ZapLogger.Warn("AuthService: account of identity not found", zap.Uint64("userID", existing.UserID), zap.Error(err))
+			return nil, err
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		account, err = s.createExternalAccount(ctx, identity, claims)
+		if err != nil {
+			return nil, err
+		}
+		created = true
+	default:
+		s.ZapLogger.Error("AuthService: DB error", zap.Error(err))
+		return nil, err
+	}
+
+	output, err := s.completeLogin(ctx, account, &input.SessionClient)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.CompleteOIDCLoginOutput{
+		Message:              output.Message,
+		Success:              output.Success,
+		AccessToken:          output.AccessToken,
+		RefreshToken:         output.RefreshToken,
+		SecondFactorRequired: output.SecondFactorRequired,
+		EnrollmentRequired:   output.EnrollmentRequired,
+		ChallengeToken:       output.ChallengeToken,
+		AccountCreated:       created,
+	}, nil
+}
+
+// checkLinkCaller return ErrOIDCLinkUnauthenticated when a link of linkUserID is completed by callerID logged in as
+// another account. The callback is a browser redirect from the provider that carries no access token, so an anonymous
+// caller is accepted: the gateway only passes the state of the state cookie set in the browser that began the link,
+// which keeps a link URL sent to someone else from linking their identity to the account that began it.
+func checkLinkCaller(linkUserID, callerID uint64) error {
+	if linkUserID != 0 && callerID != 0 && callerID != linkUserID {
+		return ErrOIDCLinkUnauthenticated
+	}
+	return nil
+}
+
+// createExternalAccount create a buyer account linked to identity. Its username is the preferred username or the
+// verified email when free, otherwise one derived from the identity. Its password is random, the owner can set one
+// with a password reset.
+func (s *AuthService) createExternalAccount(ctx context.Context, identity *model.ExternalIdentity, claims *oidc.Claims) (*model.Account, error) {
+	sum := sha256.Sum256([]byte(identity.Issuer + "\x00" + identity.Subject))
+	candidates := []string{claims.PreferredUsername, identity.Email, identity.Provider + "_" + hex.EncodeToString(sum[:6])}
+	username := ""
+	for _, candidate := range candidates {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "" {
+			continue
+		}
+		exists, err := s.AccountRepo.UsernameExists(ctx, candidate)
+		if err != nil {
+			s.ZapLogger.Error("AuthService: DB error", zap.Error(err))
+			return nil, err
+		}
+		if !exists {
+			username = candidate
+			break
+		}
+	}
+	if username == "" {
+		return nil, errors.New("no free username for identity")
+	}
+
+	password, err := oidc.RandomString(32)
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: bcrypt password error", zap.Error(err))
+		return nil, err
+	}
+	account := &model.Account{
+		Username: username,
+		Password: string(hashedPassword),
+		Role:     model.RoleBuyer,
+	}
+	if err := s.AccountRepo.CreateAccountWithExternalIdentity(ctx, account, identity); err != nil {
+		s.ZapLogger.Warn("AuthService: failed to create account of identity", zap.String("provider", identity.Provider), zap.Error(err))
+		return nil, err
+	}
+	s.ZapLogger.Info("AuthService: account created by identity provider login", zap.Uint64("userID", account.ID),
+		zap.String("provider", identity.Provider))
+	return account, nil
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckLinkCaller(t *testing.T) {
+	tests := []struct {
+		name       string
+		linkUserID uint64
+		callerID   uint64
+		wantErr    error
+	}{
+		{"login", 0, 0, nil},
+		{"login of a logged in caller", 0, 9, nil},
+		{"link from the redirect of the browser", 7, 0, nil},
+		{"link with the access token of its account", 7, 7, nil},
+		{"link with the access token of another account", 7, 9, ErrOIDCLinkUnauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkLinkCaller(tt.linkUserID, tt.callerID); !errors.Is(err, tt.wantErr) {
+				t.Errorf("checkLinkCaller() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
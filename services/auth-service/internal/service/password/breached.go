@@ -0,0 +1,120 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	// hashPrefixLength is the length of the SHA-1 prefix of a range, as in k-anonymity range queries
+	hashPrefixLength = 5
+
+	// hashLength is the length of a SHA-1 hash in hex
+	hashLength = 40
+)
+
+// BreachedList is a local list of breached passwords kept by SHA-1 hash prefix, like the ranges of the
+// Pwned Passwords k-anonymity API. Only hashes are loaded, never passwords.
+type BreachedList struct {
+	MinCount int // least breach count for a password to be refused
+
+	ranges map[string]map[string]int // hash suffix and breach count, by hash prefix
+}
+
+// LoadBreachedList read the list at path. It has one hash per line in upper or lower case hex, either as a full
+// SHA-1 with an optional count, "HASH:COUNT" as in the downloads of Pwned Passwords, or split at the prefix,
+// "PREFIX:SUFFIX:COUNT" as range responses are stored under their prefix. Empty lines and lines starting with #
+// are skipped.
+func LoadBreachedList(path string, minCount int) (*BreachedList, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	list := &BreachedList{MinCount: max(minCount, 1), ranges: make(map[string]map[string]int)}
+	scanner := bufio.NewScanner(file)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		hash, count, err := parseBreachedLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s line %d, %w", path, n, err)
+		}
+		list.add(hash, count)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// parseBreachedLine return the upper case hash and count of a line of LoadBreachedList
+func parseBreachedLine(line string) (string, int, error) {
+	fields := strings.Split(line, ":")
+	hash, countField := fields[0], ""
+	switch len(fields) {
+	case 1:
+	case 2:
+		if len(fields[0]) == hashPrefixLength {
+			hash = fields[0] + fields[1]
+		} else {
+			countField = fields[1]
+		}
+	case 3:
+		hash, countField = fields[0]+fields[1], fields[2]
+	default:
+		return "", 0, fmt.Errorf("too many fields")
+	}
+
+	hash = strings.ToUpper(hash)
+	if len(hash) != hashLength {
+		return "", 0, fmt.Errorf("hash is not a SHA-1 hash")
+	}
+	if _, err := hex.DecodeString(hash); err != nil {
+		return "", 0, fmt.Errorf("hash is not hex")
+	}
+	count := 1
+	if countField != "" {
+		var err error
+		if count, err = strconv.Atoi(strings.TrimSpace(countField)); err != nil || count < 0 {
+			return "", 0, fmt.Errorf("count is not a number")
+		}
+	}
+	return hash, count, nil
+}
+
+func (l *BreachedList) add(hash string, count int) {
+	prefix, suffix := hash[:hashPrefixLength], hash[hashPrefixLength:]
+	suffixes, ok := l.ranges[prefix]
+	if !ok {
+		suffixes = make(map[string]int)
+		l.ranges[prefix] = suffixes
+	}
+	suffixes[suffix] += count
+}
+
+// Len return the number of hashes of the list
+func (l *BreachedList) Len() int {
+	n := 0
+	for _, suffixes := range l.ranges {
+		n += len(suffixes)
+	}
+	return n
+}
+
+// Contains check whether password was breached at least MinCount times. Only the range of its hash prefix is
+// looked at, as a range query would.
+func (l *BreachedList) Contains(password string) bool {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	count, ok := l.ranges[hash[:hashPrefixLength]][hash[hashPrefixLength:]]
+	return ok && count >= l.MinCount
+}
@@ -0,0 +1,94 @@
+package password
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// passwordHash is the SHA-1 of "password"
+const passwordHash = "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8"
+
+func writeList(t *testing.T, lines ...string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadBreachedList(t *testing.T) {
+	tests := []struct {
+		name     string
+		line     string
+		minCount int
+		want     bool
+	}{
+		{"full hash", passwordHash, 1, true},
+		{"lower case", strings.ToLower(passwordHash), 1, true},
+		{"hash and count", passwordHash + ":9545824", 1, true},
+		{"hash and count with spaces", "  " + passwordHash + ": 3 ", 1, true},
+		{"prefix and suffix", passwordHash[:5] + ":" + passwordHash[5:], 1, true},
+		{"prefix, suffix and count", passwordHash[:5] + ":" + passwordHash[5:] + ":3", 1, true},
+		{"count under minimum", passwordHash + ":2", 3, false},
+		{"count at minimum", passwordHash + ":3", 3, true},
+		{"zero count", passwordHash + ":0", 0, false},
+		{"other hash", strings.Repeat("A", hashLength), 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := LoadBreachedList(writeList(t, "# breached", "", tt.line), tt.minCount)
+			if err != nil {
+				t.Fatalf("LoadBreachedList() error = %v", err)
+			}
+			if list.Len() != 1 {
+				t.Errorf("Len() = %d, want 1", list.Len())
+			}
+			if got := list.Contains("password"); got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+			if list.Contains("Password") {
+				t.Error("Contains() matched a password of another hash")
+			}
+		})
+	}
+}
+
+func TestLoadBreachedListCounts(t *testing.T) {
+	list, err := LoadBreachedList(writeList(t, passwordHash+":2", passwordHash[:5]+":"+passwordHash[5:]+":2"), 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.Len() != 1 || !list.Contains("password") {
+		t.Errorf("counts of the same hash are not added up, Len() = %d", list.Len())
+	}
+}
+
+func TestLoadBreachedListRejectsMalformedLines(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+	}{
+		{"short hash", passwordHash[:39]},
+		{"long hash", passwordHash + "0"},
+		{"not hex", strings.Repeat("G", hashLength)},
+		{"plain password", "password"},
+		{"count not a number", passwordHash + ":many"},
+		{"negative count", passwordHash + ":-1"},
+		{"short suffix", passwordHash[:5] + ":" + passwordHash[6:] + ":1"},
+		{"too many fields", passwordHash[:5] + ":" + passwordHash[5:] + ":1:1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadBreachedList(writeList(t, passwordHash, tt.line), 1)
+			if err == nil || !strings.Contains(err.Error(), "line 2") {
+				t.Errorf("LoadBreachedList() error = %v, want an error of line 2", err)
+			}
+		})
+	}
+	if _, err := LoadBreachedList(filepath.Join(t.TempDir(), "missing.txt"), 1); err == nil {
+		t.Error("LoadBreachedList() of a missing file succeeded")
+	}
+}
@@ -0,0 +1,88 @@
+// Package password checks new passwords against a configurable policy, and against a local list of breached
+// passwords so the check works offline.
+package password
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxBcryptLength is the longest password bcrypt hashes, longer passwords are refused rather than truncated
+const MaxBcryptLength = 72
+
+// Policy is the rules a new password must follow
+type Policy struct {
+	MinLength      int // in characters
+	MaxLength      int // in bytes, at most MaxBcryptLength
+	RequireUpper   bool
+	RequireLower   bool
+	RequireDigit   bool
+	RequireSymbol  bool
+	ForbidUsername bool          // refuse passwords that contain the username, whatever the case
+	Breached       *BreachedList // refuse passwords of the list, nil to skip
+}
+
+// PolicyError is a password refused by a Policy, Violations are readable reasons for the user
+type PolicyError struct {
+	Field      string
+	Violations []string
+}
+
+func (e *PolicyError) Error() string {
+	return e.Field + " " + strings.Join(e.Violations, ", ")
+}
+
+// Check return a *PolicyError naming field with every rule of p that password breaks, nil when it follows them all
+func (p *Policy) Check(field, password, username string) error {
+	var violations []string
+	if utf8.RuneCountInString(password) < p.MinLength {
+		violations = append(violations, fmt.Sprintf("must be at least %d characters long", p.MinLength))
+	}
+	maxLength := p.MaxLength
+	if maxLength <= 0 || maxLength > MaxBcryptLength {
+		maxLength = MaxBcryptLength
+	}
+	if len(password) > maxLength {
+		violations = append(violations, fmt.Sprintf("must be at most %d bytes long", maxLength))
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+	if p.RequireUpper && !upper {
+		violations = append(violations, "must contain an uppercase letter")
+	}
+	if p.RequireLower && !lower {
+		violations = append(violations, "must contain a lowercase letter")
+	}
+	if p.RequireDigit && !digit {
+		violations = append(violations, "must contain a digit")
+	}
+	if p.RequireSymbol && !symbol {
+		violations = append(violations, "must contain a symbol")
+	}
+
+	if p.ForbidUsername && username != "" && strings.Contains(strings.ToLower(password), strings.ToLower(username)) {
+		violations = append(violations, "must not contain the username")
+	}
+	if p.Breached != nil && p.Breached.Contains(password) {
+		violations = append(violations, "appears in a list of breached passwords, choose another one")
+	}
+
+	if len(violations) > 0 {
+		return &PolicyError{Field: field, Violations: violations}
+	}
+	return nil
+}
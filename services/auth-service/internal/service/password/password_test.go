@@ -0,0 +1,76 @@
+package password
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPolicyCheck(t *testing.T) {
+	breached, err := LoadBreachedList(writeList(t, passwordHash), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	strict := &Policy{
+		MinLength:      8,
+		RequireUpper:   true,
+		RequireLower:   true,
+		RequireDigit:   true,
+		RequireSymbol:  true,
+		ForbidUsername: true,
+	}
+
+	tests := []struct {
+		name     string
+		policy   *Policy
+		password string
+		username string
+		want     []string
+	}{
+		{"follows every rule", strict, "Correct-Horse-7", "jane", nil},
+		{"no rule", &Policy{}, "", "", nil},
+		{"too short", strict, "Ab1!", "jane", []string{"must be at least 8 characters long"}},
+		{"length in characters", &Policy{MinLength: 4}, "ééé", "", []string{"must be at least 4 characters long"}},
+		{"multibyte long enough", &Policy{MinLength: 3}, "ééé", "", nil},
+		{"no upper", strict, "correct-horse-7", "jane", []string{"must contain an uppercase letter"}},
+		{"no lower", strict, "CORRECT-HORSE-7", "jane", []string{"must contain a lowercase letter"}},
+		{"no digit", strict, "Correct-Horse", "jane", []string{"must contain a digit"}},
+		{"space is a symbol", strict, "Correct Horse 7", "jane", nil},
+		{"no symbol", strict, "CorrectHorse7", "jane", []string{"must contain a symbol"}},
+		{"username", strict, "Jane-Horse-7", "jane", []string{"must not contain the username"}},
+		{"username in another case", strict, "x-JANE-Horse-7", "Jane", []string{"must not contain the username"}},
+		{"username allowed", &Policy{}, "jane", "jane", nil},
+		{"breached", &Policy{Breached: breached}, "password", "", []string{"appears in a list of breached passwords, choose another one"}},
+		{"not breached", &Policy{Breached: breached}, "passw0rd", "", nil},
+		{"over bcrypt length", &Policy{}, strings.Repeat("a", MaxBcryptLength+1), "", []string{"must be at most 72 bytes long"}},
+		{"at bcrypt length", &Policy{}, strings.Repeat("a", MaxBcryptLength), "", nil},
+		{"max over bcrypt length", &Policy{MaxLength: 100}, strings.Repeat("a", MaxBcryptLength+1), "", []string{"must be at most 72 bytes long"}},
+		{"max in bytes", &Policy{MaxLength: 5}, "ééé", "", []string{"must be at most 5 bytes long"}},
+		{"every violation", strict, "jane", "jane", []string{
+			"must be at least 8 characters long",
+			"must contain an uppercase letter",
+			"must contain a digit",
+			"must contain a symbol",
+			"must not contain the username",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Check("password", tt.password, tt.username)
+			if tt.want == nil {
+				if err != nil {
+					t.Errorf("Check() error = %v, want nil", err)
+				}
+				return
+			}
+			var policyErr *PolicyError
+			if !errors.As(err, &policyErr) {
+				t.Fatalf("Check() error = %v, want a *PolicyError", err)
+			}
+			if policyErr.Field != "password" || !reflect.DeepEqual(policyErr.Violations, tt.want) {
+				t.Errorf("Check() = %s %q, want password %q", policyErr.Field, policyErr.Violations, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"auth-service/internal/repository"
+	"auth-service/internal/service/notify"
+	"auth-service/pkg/dto"
+	"auth-service/pkg/model"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// passwordResetTTL is how long a password reset token can be redeemed
+const passwordResetTTL = 15 * time.Minute
+
+// hashPasswordResetToken return the stored form of a reset token
+func hashPasswordResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// RequestPasswordReset send a password reset token to the owner of the account through the Notifier.
+// The response is the same whether the account exists or not.
+func (s *AuthService) RequestPasswordReset(ctx context.Context, input *dto.RequestPasswordResetInput) (*dto.RequestPasswordResetOutput, error) {
+	output := &dto.RequestPasswordResetOutput{
+		Message: "If the account exists, a password reset token has been sent",
+		Success: true,
+	}
+
+	account, err := s.AccountRepo.GetAccountByUsernameRole(ctx, input.Username, input.Role)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		s.ZapLogger.Info("AuthService: password reset for unknown account", zap.String("username", input.Username))
+		return output, nil
+	}
+	if err != nil {
+		s.ZapLogger.Error("AuthService: DB error", zap.Error(err))
+		return nil, err
+	}
+
+	// Create token, only its hash is saved
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	if err := s.AccountRepo.CreatePasswordResetToken(ctx, &model.PasswordResetToken{
+		UserID:    account.ID,
+		TokenHash: hashPasswordResetToken(token),
+		ExpiresAt: time.Now().Add(passwordResetTTL),
+	}); err != nil {
+		s.ZapLogger.Warn("AuthService: save password reset token failure", zap.Error(err))
+		return nil, err
+	}
+
+	// Send token, a failed delivery is not told to the caller either
+	if err := s.Notifier.Notify(ctx, &notify.Notification{
+		Kind:     notify.KindPasswordReset,
+		UserID:   account.ID,
+		Username: account.Username,
+		Role:     account.Role,
+		Subject:  "Reset your password",
+		Body: fmt.Sprintf("Use this token to reset your password within %d minutes: %s",
+			int(passwordResetTTL/time.Minute), token),
+	}); err != nil {
+		s.ZapLogger.Warn("AuthService: send password reset token failure", zap.Uint64("userID", account.ID), zap.Error(err))
+	}
+	return output, nil
+}
+
+// ResetPassword redeem a password reset token to set a new password, every session of the account is cut off
+func (s *AuthService) ResetPassword(ctx context.Context, input *dto.ResetPasswordInput) (*dto.ResetPasswordOutput, error) {
+	// The password is checked once the account of the token is known, a refused password keeps the token usable
+	hashPassword := func(account *model.Account) (string, error) {
+		if err := s.checkPassword("new_password", input.NewPassword, account.Username); err != nil {
+			return "", err
+		}
+		hashedNewPassword, err := bcrypt.GenerateFromPassword([]byte(input.NewPassword), bcrypt.DefaultCost)
+		if err != nil {
+			s.ZapLogger.Warn("AuthService: new password hash failure")
+			return "", err
+		}
+		return string(hashedNewPassword), nil
+	}
+
+	account, err := s.AccountRepo.RedeemPasswordResetToken(ctx, hashPasswordResetToken(input.Token), hashPassword, s.JWTExpireTime)
+	if errors.Is(err, repository.ErrPasswordResetTokenInvalid) {
+		s.ZapLogger.Warn("AuthService: invalid password reset token")
+		return nil, err
+	}
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: reset password failure", zap.Error(err))
+		return nil, err
+	}
+
+	s.ZapLogger.Info("AuthService: password reset", zap.Uint64("userID", account.ID))
+	return &dto.ResetPasswordOutput{
+		Message: "Reset password successfully",
+		Success: true,
+	}, nil
+}
@@ -8,6 +8,18 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+// checkPassword check a new password of username against the password policy, errors name field
+func (s *AuthService) checkPassword(field, newPassword, username string) error {
+	if s.PasswordPolicy == nil {
+		return nil
+	}
+	if err := s.PasswordPolicy.Check(field, newPassword, username); err != nil {
+		s.ZapLogger.Warn("AuthService: password refused by policy", zap.String("username", username), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 // ChangePassword update new password
 func (s *AuthService) ChangePassword(ctx context.Context, req *dto.ChangePasswordInput) (*dto.ChangePasswordOutput, error) {
 
@@ -24,6 +36,10 @@ func (s *AuthService) ChangePassword(ctx context.Context, req *dto.ChangePasswor
 		s.ZapLogger.Warn("AuthService: old password compare failure")
 		return nil, err
 	}
+	if err := s.checkPassword("new_password", req.NewPassword, acc.Username); err != nil {
+		return nil, err
+	}
+
 	// Update password
 	hashedNewPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
 	if err != nil {
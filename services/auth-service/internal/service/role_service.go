@@ -0,0 +1,228 @@
+package service
+
+import (
+	"auth-service/internal/repository"
+	"auth-service/pkg/dto"
+	"auth-service/pkg/model"
+	"context"
+	"errors"
+	"regexp"
+	"slices"
+
+	"go.uber.org/zap"
+)
+
+// ErrPermissionDenied is returned when the account of a request lacks the permission for it
+var ErrPermissionDenied = errors.New("this account can not take this action")
+
+// storeRoleNamePattern is the format of custom role names, they are used as the role of login requests
+var storeRoleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{2,31}$`)
+
+// SyncSystemRoles write model.SystemRolePermissions to DB, it runs at start so DB follows the code
+func (s *AuthService) SyncSystemRoles(ctx context.Context) error {
+	return s.AccountRepo.SyncSystemRoles(ctx, model.SystemRolePermissions)
+}
+
+// rolePermissions return the permissions of role for an account of storeID. Changes to a role reach
+// access tokens when they are refreshed.
+func (s *AuthService) rolePermissions(ctx context.Context, role string, storeID uint64) ([]string, error) {
+	found, err := s.AccountRepo.GetRole(ctx, role, storeID)
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: get role permissions failure", zap.String("role", role), zap.Uint64("storeID", storeID), zap.Error(err))
+		return nil, err
+	}
+	return rolePermissionNames(found), nil
+}
+
+func rolePermissionNames(role *model.Role) []string {
+	permissions := make([]string, 0, len(role.Permissions))
+	for _, permission := range role.Permissions {
+		permissions = append(permissions, permission.PermissionName)
+	}
+	slices.Sort(permissions)
+	return permissions
+}
+
+// accountHasPermission check whether the role of account grants permission
+func (s *AuthService) accountHasPermission(ctx context.Context, account *model.Account, permission string) (bool, error) {
+	permissions, err := s.rolePermissions(ctx, account.Role, account.StoreID)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(permissions, permission), nil
+}
+
+// requiresSecondFactor check whether the role of account grants one of model.SecondFactorRequiredPermissions
+func (s *AuthService) requiresSecondFactor(ctx context.Context, account *model.Account) (bool, error) {
+	permissions, err := s.rolePermissions(ctx, account.Role, account.StoreID)
+	if err != nil {
+		return false, err
+	}
+	for _, permission := range model.SecondFactorRequiredPermissions {
+		if slices.Contains(permissions, permission) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// storeRoleStore return the store whose roles actorID manages, see roleStoreOf
+func (s *AuthService) storeRoleStore(ctx context.Context, actorID, storeID uint64) (uint64, error) {
+	actor, err := s.AccountRepo.GetAccountById(ctx, actorID)
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: account not found", zap.Uint64("userID", actorID), zap.Error(err))
+		return 0, errors.New("user_id is invalid")
+	}
+	permissions, err := s.rolePermissions(ctx, actor.Role, actor.StoreID)
+	if err != nil {
+		return 0, err
+	}
+	return roleStoreOf(actor, permissions, storeID)
+}
+
+// roleStoreOf return the store whose roles actor, granted permissions, manages: its own store for store staff,
+// storeID for accounts granted model.PermissionStoreRoleAny. Any other account without store, such as a seller
+// admin whose store is not created yet, is denied.
+func roleStoreOf(actor *model.Account, permissions []string, storeID uint64) (uint64, error) {
+	if !slices.Contains(permissions, model.PermissionStoreRoleWrite) {
+		return 0, ErrPermissionDenied
+	}
+	if actor.StoreID != 0 {
+		if storeID != 0 && storeID != actor.StoreID {
+			return 0, ErrPermissionDenied
+		}
+		return actor.StoreID, nil
+	}
+	if !slices.Contains(permissions, model.PermissionStoreRoleAny) {
+		return 0, ErrPermissionDenied
+	}
+	if storeID == 0 {
+		return 0, errors.New("store_id is required")
+	}
+	return storeID, nil
+}
+
+// validateStoreRole check the name of a custom role and that it grants only model.StorePermissions,
+// and return its permissions without duplicates
+func validateStoreRole(name string, permissions []string) ([]string, error) {
+	if !storeRoleNamePattern.MatchString(name) {
+		return nil, errors.New("role name must be 3 to 32 lowercase letters, digits or underscores")
+	}
+	if _, ok := model.SystemRolePermissions[name]; ok {
+		return nil, errors.New("role name is reserved for a system role")
+	}
+	if len(permissions) == 0 {
+		return nil, errors.New("a role needs at least one permission")
+	}
+	unique := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		if !slices.Contains(model.StorePermissions, permission) {
+			return nil, errors.New("a store role can only grant product, inventory and answer permissions")
+		}
+		if !slices.Contains(unique, permission) {
+			unique = append(unique, permission)
+		}
+	}
+	slices.Sort(unique)
+	return unique, nil
+}
+
+func roleModelToDTO(role *model.Role) *dto.StoreRole {
+	return &dto.StoreRole{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: rolePermissionNames(role),
+	}
+}
+
+// ListStoreRoles return the custom roles of a store, and the permissions they can grant
+func (s *AuthService) ListStoreRoles(ctx context.Context, input *dto.ListStoreRolesInput) (*dto.ListStoreRolesOutput, error) {
+	storeID, err := s.storeRoleStore(ctx, input.ActorID, input.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := s.AccountRepo.GetStoreRoles(ctx, storeID)
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: get store roles failure", zap.Uint64("storeID", storeID), zap.Error(err))
+		return nil, err
+	}
+	rolesDTO := make([]*dto.StoreRole, 0, len(roles))
+	for _, role := range roles {
+		rolesDTO = append(rolesDTO, roleModelToDTO(role))
+	}
+	return &dto.ListStoreRolesOutput{
+		Message:               "Get store roles successfully",
+		Success:               true,
+		Roles:                 rolesDTO,
+		AssignablePermissions: model.StorePermissions,
+	}, nil
+}
+
+// CreateStoreRole create a custom role of a store
+func (s *AuthService) CreateStoreRole(ctx context.Context, input *dto.CreateStoreRoleInput) (*dto.CreateStoreRoleOutput, error) {
+	storeID, err := s.storeRoleStore(ctx, input.ActorID, input.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	permissions, err := validateStoreRole(input.Role.Name, input.Role.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.AccountRepo.GetRole(ctx, input.Role.Name, storeID); err == nil {
+		return nil, errors.New("role already exists")
+	} else if !errors.Is(err, repository.ErrRoleNotFound) {
+		return nil, err
+	}
+
+	role := &model.Role{Name: input.Role.Name, StoreID: storeID, Description: input.Role.Description}
+	for _, permission := range permissions {
+		role.Permissions = append(role.Permissions, model.RolePermission{PermissionName: permission})
+	}
+	if err := s.AccountRepo.CreateRole(ctx, role); err != nil {
+		s.ZapLogger.Warn("AuthService: create store role failure", zap.Uint64("storeID", storeID), zap.Error(err))
+		return nil, err
+	}
+	return &dto.CreateStoreRoleOutput{
+		Message: "Role created successfully",
+		Success: true,
+		Role:    roleModelToDTO(role),
+	}, nil
+}
+
+// UpdateStoreRole replace the description and permissions of a custom role of a store
+func (s *AuthService) UpdateStoreRole(ctx context.Context, input *dto.UpdateStoreRoleInput) (*dto.UpdateStoreRoleOutput, error) {
+	storeID, err := s.storeRoleStore(ctx, input.ActorID, input.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	permissions, err := validateStoreRole(input.Role.Name, input.Role.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	role, err := s.AccountRepo.UpdateStoreRole(ctx, storeID, input.Role.Name, input.Role.Description, permissions)
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: update store role failure", zap.Uint64("storeID", storeID), zap.Error(err))
+		return nil, err
+	}
+	return &dto.UpdateStoreRoleOutput{
+		Message: "Role updated successfully",
+		Success: true,
+		Role:    roleModelToDTO(role),
+	}, nil
+}
+
+// DeleteStoreRole delete a custom role of a store that no account has
+func (s *AuthService) DeleteStoreRole(ctx context.Context, input *dto.DeleteStoreRoleInput) (*dto.DeleteStoreRoleOutput, error) {
+	storeID, err := s.storeRoleStore(ctx, input.ActorID, input.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.AccountRepo.DeleteStoreRole(ctx, storeID, input.Name); err != nil {
+		s.ZapLogger.Warn("AuthService: delete store role failure", zap.Uint64("storeID", storeID), zap.Error(err))
+		return nil, err
+	}
+	return &dto.DeleteStoreRoleOutput{
+		Message: "Role deleted successfully",
+		Success: true,
+	}, nil
+}
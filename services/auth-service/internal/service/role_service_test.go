@@ -0,0 +1,82 @@
+package service
+
+import (
+	"auth-service/pkg/model"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestValidateStoreRole(t *testing.T) {
+	tests := []struct {
+		name        string
+		role        string
+		permissions []string
+		want        []string
+		wantErr     bool
+	}{
+		{"valid", "packer", []string{model.PermissionInventoryWrite, model.PermissionInventoryRead},
+			[]string{model.PermissionInventoryRead, model.PermissionInventoryWrite}, false},
+		{"duplicates removed", "packer", []string{model.PermissionProductWrite, model.PermissionProductWrite},
+			[]string{model.PermissionProductWrite}, false},
+		{"name too short", "pk", []string{model.PermissionProductWrite}, nil, true},
+		{"name uppercase", "Packer", []string{model.PermissionProductWrite}, nil, true},
+		{"name starts with digit", "1packer", []string{model.PermissionProductWrite}, nil, true},
+		{"name too long", "a23456789012345678901234567890123", []string{model.PermissionProductWrite}, nil, true},
+		{"system role name", model.RoleSellerAdmin, []string{model.PermissionProductWrite}, nil, true},
+		{"no permission", "packer", nil, nil, true},
+		{"staff permission", "packer", []string{model.PermissionStaffWrite}, nil, true},
+		{"role permission", "packer", []string{model.PermissionStoreRoleWrite}, nil, true},
+		{"unknown permission", "packer", []string{"product:everything"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validateStoreRole(tt.role, tt.permissions)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateStoreRole() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("validateStoreRole() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoleStoreOf(t *testing.T) {
+	sellerAdmin := model.SystemRolePermissions[model.RoleSellerAdmin]
+	admin := model.SystemRolePermissions[model.RoleAdmin]
+	tests := []struct {
+		name        string
+		actor       *model.Account
+		permissions []string
+		storeID     uint64
+		want        uint64
+		wantErr     error
+	}{
+		{"staff own store", &model.Account{Role: model.RoleSellerAdmin, StoreID: 7}, sellerAdmin, 0, 7, nil},
+		{"staff names own store", &model.Account{Role: model.RoleSellerAdmin, StoreID: 7}, sellerAdmin, 7, 7, nil},
+		{"staff names other store", &model.Account{Role: model.RoleSellerAdmin, StoreID: 7}, sellerAdmin, 8, 0, ErrPermissionDenied},
+		{"seller admin without store names a store", &model.Account{Role: model.RoleSellerAdmin}, sellerAdmin, 8, 0, ErrPermissionDenied},
+		{"seller admin without store", &model.Account{Role: model.RoleSellerAdmin}, sellerAdmin, 0, 0, ErrPermissionDenied},
+		{"employee", &model.Account{Role: model.RoleSellerEmployee, StoreID: 7},
+			model.SystemRolePermissions[model.RoleSellerEmployee], 0, 0, ErrPermissionDenied},
+		{"buyer names a store", &model.Account{Role: model.RoleBuyer},
+			model.SystemRolePermissions[model.RoleBuyer], 8, 0, ErrPermissionDenied},
+		{"admin names a store", &model.Account{Role: model.RoleAdmin}, admin, 8, 8, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := roleStoreOf(tt.actor, tt.permissions, tt.storeID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("roleStoreOf() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("roleStoreOf() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := roleStoreOf(&model.Account{Role: model.RoleAdmin}, admin, 0); err == nil {
+		t.Error("roleStoreOf() of admin without store_id succeeded")
+	}
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"auth-service/internal/repository"
+	"auth-service/pkg/dto"
+	"auth-service/pkg/outbox"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// userAgentBrowsers and userAgentSystems name the client of a session without device label, first match wins
+var (
+	userAgentBrowsers = [][2]string{
+		{"Edg/", "Edge"}, {"EdgiOS/", "Edge"}, {"OPR/", "Opera"}, {"Firefox/", "Firefox"}, {"FxiOS/", "Firefox"},
+		{"Chrome/", "Chrome"}, {"CriOS/", "Chrome"}, {"Safari/", "Safari"},
+	}
+	userAgentSystems = [][2]string{
+		{"Android", "Android"}, {"iPhone", "iOS"}, {"iPad", "iPadOS"}, {"Windows", "Windows"}, {"CrOS", "ChromeOS"},
+		{"Mac OS X", "macOS"}, {"Linux", "Linux"},
+	}
+)
+
+// sessionDeviceLabel return the device label of client, or one like "Firefox on Linux" derived from its user agent
+func sessionDeviceLabel(client *dto.SessionClient) string {
+	if label := strings.TrimSpace(client.DeviceLabel); label != "" {
+		return label
+	}
+	match := func(names [][2]string) string {
+		for _, name := range names {
+			if strings.Contains(client.UserAgent, name[0]) {
+				return name[1]
+			}
+		}
+		return ""
+	}
+	browser, system := match(userAgentBrowsers), match(userAgentSystems)
+	switch {
+	case browser != "" && system != "":
+		return browser + " on " + system
+	case browser != "":
+		return browser
+	case system != "":
+		return system
+	}
+	return "Unknown device"
+}
+
+// ListSessions return the active sessions of the user of the access token, the one of the token is marked current
+func (s *AuthService) ListSessions(ctx context.Context, input *dto.ListSessionsInput) (*dto.ListSessionsOutput, error) {
+	authClaim, err := s.parseAccessToken(ctx, input.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.AccountRepo.GetActiveSessions(ctx, authClaim.UserID)
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: get sessions failure", zap.Uint64("userID", authClaim.UserID), zap.Error(err))
+		return nil, err
+	}
+
+	sessionsDTO := make([]*dto.Session, 0, len(sessions))
+	for _, session := range sessions {
+		sessionsDTO = append(sessionsDTO, &dto.Session{
+			ID:          session.ID,
+			DeviceLabel: session.DeviceLabel,
+			UserAgent:   session.UserAgent,
+			ClientIP:    session.ClientIP,
+			CreatedAt:   session.CreatedAt,
+			LastUsedAt:  session.LastUsedAt,
+			Current:     session.ID == authClaim.FamilyID,
+		})
+	}
+	return &dto.ListSessionsOutput{
+		Message:  "Get sessions successfully",
+		Success:  true,
+		Sessions: sessionsDTO,
+	}, nil
+}
+
+// RevokeSession log out one session of the user of the access token. Its refresh tokens are revoked in DB,
+// its access tokens through a revocation event of its family that the gateway keeps until they expire.
+func (s *AuthService) RevokeSession(ctx context.Context, input *dto.RevokeSessionInput) (*dto.RevokeSessionOutput, error) {
+	authClaim, err := s.parseAccessToken(ctx, input.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.AccountRepo.GetActiveSession(ctx, authClaim.UserID, input.SessionID)
+	if err != nil {
+		if !errors.Is(err, repository.ErrSessionNotFound) {
+			s.ZapLogger.Warn("AuthService: get session failure", zap.Uint64("userID", authClaim.UserID), zap.Error(err))
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	event := &outbox.TokenRevocationEvent{
+		UserID:       authClaim.UserID,
+		FamilyID:     session.ID,
+		IssuedBefore: now,
+		ExpiresAt:    now.Add(s.JWTExpireTime),
+		Status:       "PENDING",
+	}
+	if err := s.AccountRepo.RevokeSession(ctx, session.ID, event); err != nil {
+		s.ZapLogger.Warn("AuthService: revoke session failure", zap.Uint64("userID", authClaim.UserID), zap.Error(err))
+		return nil, err
+	}
+	return &dto.RevokeSessionOutput{
+		Message: "Session revoked successfully",
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"auth-service/pkg/dto"
+	"testing"
+)
+
+func TestSessionDeviceLabel(t *testing.T) {
+	tests := []struct {
+		name   string
+		client dto.SessionClient
+		want   string
+	}{
+		{"named by the user", dto.SessionClient{DeviceLabel: "  Work laptop ", UserAgent: "Mozilla/5.0 (X11; Linux x86_64) Firefox/128.0"}, "Work laptop"},
+		{"blank name", dto.SessionClient{DeviceLabel: "  ", UserAgent: "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0"}, "Firefox on Linux"},
+		{"chrome on windows", dto.SessionClient{UserAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36"}, "Chrome on Windows"},
+		{"edge on windows", dto.SessionClient{UserAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36 Edg/126.0.2592.87"}, "Edge on Windows"},
+		{"opera on macos", dto.SessionClient{UserAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36 OPR/112.0.0.0"}, "Opera on macOS"},
+		{"safari on macos", dto.SessionClient{UserAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.5 Safari/605.1.15"}, "Safari on macOS"},
+		{"safari on iphone", dto.SessionClient{UserAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.5 Mobile/15E148 Safari/604.1"}, "Safari on iOS"},
+		{"chrome on iphone", dto.SessionClient{UserAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/126.0.6478.54 Mobile/15E148 Safari/604.1"}, "Chrome on iOS"},
+		{"firefox on ipad", dto.SessionClient{UserAgent: "Mozilla/5.0 (iPad; CPU OS 17_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) FxiOS/127.0 Mobile/15E148 Safari/605.1.15"}, "Firefox on iPadOS"},
+		{"edge on iphone", dto.SessionClient{UserAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) EdgiOS/126.0.2592.56 Version/17.0 Mobile/15E148 Safari/604.1"}, "Edge on iOS"},
+		{"chrome on android", dto.SessionClient{UserAgent: "Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.6478.71 Mobile Safari/537.36"}, "Chrome on Android"},
+		{"chrome on chromeos", dto.SessionClient{UserAgent: "Mozilla/5.0 (X11; CrOS x86_64 14541.0.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36"}, "Chrome on ChromeOS"},
+		{"browser only", dto.SessionClient{UserAgent: "Firefox/128.0"}, "Firefox"},
+		{"system only", dto.SessionClient{UserAgent: "okhttp/4.12.0 (Android 14)"}, "Android"},
+		{"unknown client", dto.SessionClient{UserAgent: "curl/8.8.0"}, "Unknown device"},
+		{"no user agent", dto.SessionClient{}, "Unknown device"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sessionDeviceLabel(&tt.client); got != tt.want {
+				t.Errorf("sessionDeviceLabel() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
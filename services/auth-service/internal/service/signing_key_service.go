@@ -0,0 +1,153 @@
+package service
+
+import (
+	"auth-service/pkg/dto"
+	"auth-service/pkg/model"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ErrNoSigningKey is returned when DB has no signing key that is not retired, see EnsureSigningKey
+var ErrNoSigningKey = errors.New("no active signing key")
+
+const (
+	// signingKeyReload is how long loaded keys are used before they are read again,
+	// so a rotation done by another instance or by the rotate-keys command is picked up
+	signingKeyReload = time.Minute
+
+	// signingKeyMinReload is the least time between two reads caused by an unknown kid
+	signingKeyMinReload = 5 * time.Second
+)
+
+// signingKeyCache is the signing keys read from DB, newest first
+type signingKeyCache struct {
+	mu       sync.RWMutex
+	keys     []*model.SigningKey
+	loadedAt time.Time
+}
+
+// signingKeyRetention is how long a retired key stays published, the lifetime of the longest lived token it signed
+func (s *AuthService) signingKeyRetention() time.Duration {
+	return s.JWTExpireTime * 2
+}
+
+// loadSigningKeys return the cached keys, read from DB when they are older than maxAge
+func (s *AuthService) loadSigningKeys(ctx context.Context, maxAge time.Duration) ([]*model.SigningKey, error) {
+	s.signingKeys.mu.RLock()
+	keys, loadedAt := s.signingKeys.keys, s.signingKeys.loadedAt
+	s.signingKeys.mu.RUnlock()
+	if len(keys) > 0 && time.Since(loadedAt) < maxAge {
+		return keys, nil
+	}
+
+	keys, err := s.AccountRepo.GetSigningKeys(ctx, time.Now().Add(-s.signingKeyRetention()))
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: load signing keys failure", zap.Error(err))
+		return nil, err
+	}
+	s.signingKeys.mu.Lock()
+	s.signingKeys.keys = keys
+	s.signingKeys.loadedAt = time.Now()
+	s.signingKeys.mu.Unlock()
+	return keys, nil
+}
+
+// currentSigningKey return the key that signs new tokens
+func (s *AuthService) currentSigningKey(ctx context.Context) (*model.SigningKey, error) {
+	keys, err := s.loadSigningKeys(ctx, signingKeyReload)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if key.RetiredAt == nil {
+			return key, nil
+		}
+	}
+	return nil, ErrNoSigningKey
+}
+
+// verificationKey return the public key of kid, keys are read again for a kid signed by a key rotated in meanwhile
+func (s *AuthService) verificationKey(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+	for _, maxAge := range []time.Duration{signingKeyReload, signingKeyMinReload} {
+		keys, err := s.loadSigningKeys(ctx, maxAge)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			if key.KID == kid {
+				return ed25519.PublicKey(key.PublicKey), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// EnsureSigningKey create the first signing key when DB has none that is not retired
+func (s *AuthService) EnsureSigningKey(ctx context.Context) error {
+	if _, err := s.loadSigningKeys(ctx, 0); err != nil {
+		return err
+	}
+	_, err := s.currentSigningKey(ctx)
+	if errors.Is(err, ErrNoSigningKey) {
+		_, err = s.RotateSigningKey(ctx)
+	}
+	return err
+}
+
+// RotateSigningKey create a new key that signs new tokens from now on, the previous key is retired
+// and stays published until the tokens it signed expire
+func (s *AuthService) RotateSigningKey(ctx context.Context) (*model.SigningKey, error) {
+	kid, err := newTokenID()
+	if err != nil {
+		return nil, err
+	}
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	key := &model.SigningKey{
+		KID:        kid,
+		Algorithm:  model.SigningKeyAlgEdDSA,
+		PrivateKey: privateKey.Seed(),
+		PublicKey:  publicKey,
+		CreatedAt:  now,
+	}
+	if err := s.AccountRepo.RotateSigningKey(ctx, key, now.Add(-s.signingKeyRetention())); err != nil {
+		s.ZapLogger.Warn("AuthService: rotate signing key failure", zap.Error(err))
+		return nil, err
+	}
+	if _, err := s.loadSigningKeys(ctx, 0); err != nil {
+		return nil, err
+	}
+	s.ZapLogger.Info("AuthService: signing key rotated", zap.String("kid", kid))
+	return key, nil
+}
+
+// GetJWKS return the public keys that verify tokens, the current key and the retired keys still in use
+func (s *AuthService) GetJWKS(ctx context.Context, input *dto.GetJWKSInput) (*dto.GetJWKSOutput, error) {
+	keys, err := s.loadSigningKeys(ctx, signingKeyReload)
+	if err != nil {
+		return nil, err
+	}
+	output := &dto.GetJWKSOutput{Keys: make([]*dto.JWK, 0, len(keys))}
+	for _, key := range keys {
+		output.Keys = append(output.Keys, &dto.JWK{
+			Kty: "OKP",
+			Crv: "Ed25519",
+			Alg: key.Algorithm,
+			Use: "sig",
+			Kid: key.KID,
+			X:   base64.RawURLEncoding.EncodeToString(key.PublicKey),
+		})
+	}
+	return output, nil
+}
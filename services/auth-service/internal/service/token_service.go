@@ -1,9 +1,16 @@
 package service
 
 import (
+	"auth-service/internal/repository"
+	"auth-service/internal/service/adapter"
 	"auth-service/pkg/dto"
 	"auth-service/pkg/model"
+	"auth-service/pkg/outbox"
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
@@ -11,17 +18,99 @@ import (
 	"go.uber.org/zap"
 )
 
-// generateToken generate token from password
-func (s *AuthService) generateToken(ctx context.Context, tokenRequest *dto.TokenRequest) (string, string, error) {
-	// Create claims
+// newTokenID generate a random ID for a jti claim or a token family
+func newTokenID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// newRefreshToken create the row of the next refresh token of familyID for userID, it is saved by the caller
+func (s *AuthService) newRefreshToken(userID uint64, familyID string) (*model.RefreshToken, error) {
+	id, err := newTokenID()
+	if err != nil {
+		return nil, err
+	}
+	return &model.RefreshToken{
+		ID:        id,
+		FamilyID:  familyID,
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(s.JWTExpireTime * 2),
+	}, nil
+}
+
+// issueLoginTokens start a new refresh token family and its session for account from client,
+// and return its access and refresh token
+func (s *AuthService) issueLoginTokens(ctx context.Context, account *model.Account, client *dto.SessionClient) (string, string, error) {
+	familyID, err := newTokenID()
+	if err != nil {
+		return "", "", err
+	}
+	refreshToken, err := s.newRefreshToken(account.ID, familyID)
+	if err != nil {
+		return "", "", err
+	}
+	signedAccessToken, signedRefreshToken, err := s.generateToken(ctx, adapter.AccountModelToTokenRequest(account), refreshToken)
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: token generation failure")
+		return "", "", err
+	}
+	now := time.Now()
+	session := &model.Session{
+		ID:          familyID,
+		UserID:      account.ID,
+		DeviceLabel: sessionDeviceLabel(client),
+		UserAgent:   client.UserAgent,
+		ClientIP:    client.ClientIP,
+		CreatedAt:   now,
+		LastUsedAt:  now,
+		ExpiresAt:   refreshToken.ExpiresAt,
+	}
+	if err := s.AccountRepo.CreateSession(ctx, session, refreshToken); err != nil {
+		s.ZapLogger.Warn("AuthService: save session failure", zap.Error(err))
+		return "", "", err
+	}
+	return signedAccessToken, signedRefreshToken, nil
+}
+
+// signClaims sign claims with the current signing key
+func (s *AuthService) signClaims(ctx context.Context, claims *model.AuthClaim) (string, error) {
+	signingKey, err := s.currentSigningKey(ctx)
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: get signing key failure", zap.Error(err))
+		return "", err
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = signingKey.KID
+	return token.SignedString(ed25519.NewKeyFromSeed(signingKey.PrivateKey))
+}
+
+// generateToken generate access token and the refresh token of refreshToken from password
+func (s *AuthService) generateToken(ctx context.Context, tokenRequest *dto.TokenRequest, refreshToken *model.RefreshToken) (string, string, error) {
+	// Create claims, every token has a jti so it can be revoked, access tokens carry the permissions of the role
+	accessID, err := newTokenID()
+	if err != nil {
+		return "", "", err
+	}
+	permissions, err := s.rolePermissions(ctx, tokenRequest.Role, tokenRequest.StoreID)
+	if err != nil {
+		return "", "", err
+	}
+	now := time.Now()
 	accessClaims := &model.AuthClaim{
-		UserID:     tokenRequest.UserID,
-		Username:   tokenRequest.Username,
-		Role:       tokenRequest.Role,
-		PwdVersion: tokenRequest.PwdVersion,
-		Type:       "access",
+		UserID:      tokenRequest.UserID,
+		Username:    tokenRequest.Username,
+		Role:        tokenRequest.Role,
+		PwdVersion:  tokenRequest.PwdVersion,
+		Type:        "access",
+		FamilyID:    refreshToken.FamilyID,
+		Permissions: permissions,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(s.JWTExpireTime)),
+			ID:        accessID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.JWTExpireTime)),
 		},
 	}
 	refreshClaims := &model.AuthClaim{
@@ -30,22 +119,21 @@ func (s *AuthService) generateToken(ctx context.Context, tokenRequest *dto.Token
 		Role:       tokenRequest.Role,
 		PwdVersion: tokenRequest.PwdVersion,
 		Type:       "refresh",
+		FamilyID:   refreshToken.FamilyID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(s.JWTExpireTime * 2)),
+			ID:        refreshToken.ID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(refreshToken.ExpiresAt),
 		},
 	}
 
-	// Create token
-	accessToken := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims)
-	refreshToken := jwt.NewWithClaims(jwt.SigningMethodHS256, refreshClaims)
-
-	// Signed token
-	signedAccessToken, err := accessToken.SignedString([]byte(s.JWTSecret))
+	// Signed token with the kid of the current signing key
+	signedAccessToken, err := s.signClaims(ctx, accessClaims)
 	if err != nil {
 		s.ZapLogger.Warn("AuthService: token signed failure")
 		return "", "", err
 	}
-	signedRefreshToken, err := refreshToken.SignedString([]byte(s.JWTSecret))
+	signedRefreshToken, err := s.signClaims(ctx, refreshClaims)
 	if err != nil {
 		return "", "", err
 	}
@@ -54,17 +142,16 @@ func (s *AuthService) generateToken(ctx context.Context, tokenRequest *dto.Token
 }
 
 // parseToken return claims from token
-func (s *AuthService) parseToken(signedToken string) (*model.AuthClaim, error) {
+func (s *AuthService) parseToken(ctx context.Context, signedToken string) (*model.AuthClaim, error) {
 
-	// Validate token
+	// Validate token with the signing key of its kid
 	token, err := jwt.ParseWithClaims(signedToken, &model.AuthClaim{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			s.ZapLogger.Error("Error unexpected signing method",
-				zap.String("alg", token.Header["alg"].(string)),
-			)
+		if _, ok := token.Method.(*jwt.SigningMethodEd25519); !ok {
+			s.ZapLogger.Warn("Error unexpected signing method", zap.Any("alg", token.Header["alg"]))
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return []byte(s.JWTSecret), nil
+		kid, _ := token.Header["kid"].(string)
+		return s.verificationKey(ctx, kid)
 	})
 	if err != nil {
 		s.ZapLogger.Warn("AuthService: token parse failure")
@@ -89,7 +176,7 @@ func (s *AuthService) parseToken(signedToken string) (*model.AuthClaim, error) {
 func (s *AuthService) RefreshToken(ctx context.Context, input *dto.RefreshTokenInput) (*dto.RefreshTokenOutput, error) {
 
 	// Parse and validate token
-	authClaim, err := s.parseToken(input.RefreshToken)
+	authClaim, err := s.parseToken(ctx, input.RefreshToken)
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +184,10 @@ func (s *AuthService) RefreshToken(ctx context.Context, input *dto.RefreshTokenI
 		s.ZapLogger.Warn("AuthService: refresh token only")
 		return nil, fmt.Errorf("refresh token only")
 	}
+	if authClaim.ID == "" {
+		s.ZapLogger.Warn("AuthService: refresh token without jti", zap.Uint64("userID", authClaim.UserID))
+		return nil, fmt.Errorf("refresh token is no longer supported, login again")
+	}
 
 	// Check if password updated
 	acc, err := s.AccountRepo.GetAccountByUsernameRole(ctx, authClaim.Username, authClaim.Role)
@@ -113,15 +204,34 @@ func (s *AuthService) RefreshToken(ctx context.Context, input *dto.RefreshTokenI
 		UserID:     authClaim.UserID,
 		Username:   authClaim.Username,
 		Role:       authClaim.Role,
+		StoreID:    acc.StoreID,
 		PwdVersion: authClaim.PwdVersion,
 	}
 
-	// Create and sign new token
-	signedAccessToken, signedRefreshToken, err := s.generateToken(ctx, tokenRequest)
+	// Create and sign new token, then rotate the presented one
+	refreshToken, err := s.newRefreshToken(authClaim.UserID, authClaim.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+	signedAccessToken, signedRefreshToken, err := s.generateToken(ctx, tokenRequest, refreshToken)
 	if err != nil {
 		s.ZapLogger.Warn("AuthService: token generation failure")
 		return nil, err
 	}
+	err = s.AccountRepo.RotateRefreshToken(ctx, authClaim.ID, refreshToken, s.JWTExpireTime)
+	if errors.Is(err, repository.ErrRefreshTokenReused) {
+		s.ZapLogger.Warn("AuthService: revoked refresh token reused, token family revoked",
+			zap.Uint64("userID", authClaim.UserID), zap.String("familyID", authClaim.FamilyID))
+		return nil, fmt.Errorf("refresh token was already used, login again")
+	}
+	if errors.Is(err, repository.ErrRefreshTokenNotFound) {
+		s.ZapLogger.Warn("AuthService: unknown refresh token", zap.Uint64("userID", authClaim.UserID))
+		return nil, err
+	}
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: rotate refresh token failure", zap.Error(err))
+		return nil, err
+	}
 	return &dto.RefreshTokenOutput{
 		Message:      "Refresh token successfully",
 		AccessToken:  signedAccessToken,
@@ -129,3 +239,65 @@ func (s *AuthService) RefreshToken(ctx context.Context, input *dto.RefreshTokenI
 		Success:      true,
 	}, nil
 }
+
+// parseAccessToken return claims of a revocable access token
+func (s *AuthService) parseAccessToken(ctx context.Context, signedToken string) (*model.AuthClaim, error) {
+	authClaim, err := s.parseToken(ctx, signedToken)
+	if err != nil {
+		return nil, err
+	}
+	if authClaim.Type != "access" {
+		s.ZapLogger.Warn("AuthService: access token only")
+		return nil, fmt.Errorf("access token only")
+	}
+	if authClaim.ID == "" || authClaim.FamilyID == "" || authClaim.ExpiresAt == nil {
+		s.ZapLogger.Warn("AuthService: access token without jti", zap.Uint64("userID", authClaim.UserID))
+		return nil, fmt.Errorf("access token is no longer supported, login again")
+	}
+	return authClaim, nil
+}
+
+// Logout revoke the login of the access token: its refresh token family and session in DB, and the access tokens
+// of the family through a revocation event that the gateway keeps in its denylist until they expire
+func (s *AuthService) Logout(ctx context.Context, input *dto.LogoutInput) (*dto.LogoutOutput, error) {
+	authClaim, err := s.parseAccessToken(ctx, input.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &outbox.TokenRevocationEvent{
+		UserID:       authClaim.UserID,
+		JTI:          authClaim.ID,
+		FamilyID:     authClaim.FamilyID,
+		IssuedBefore: time.Now(),
+		ExpiresAt:    authClaim.ExpiresAt.Time,
+		Status:       "PENDING",
+	}
+	if err := s.AccountRepo.RevokeSession(ctx, authClaim.FamilyID, event); err != nil {
+		s.ZapLogger.Warn("AuthService: logout failure", zap.Uint64("userID", authClaim.UserID), zap.Error(err))
+		return nil, err
+	}
+	return &dto.LogoutOutput{
+		Message: "Logged out successfully",
+		Success: true,
+	}, nil
+}
+
+// LogoutAll revoke every login of the user of the access token. The access tokens of each session are denied
+// by the gateway for as long as a new access token is valid, which outlives all of them.
+func (s *AuthService) LogoutAll(ctx context.Context, input *dto.LogoutAllInput) (*dto.LogoutAllOutput, error) {
+	authClaim, err := s.parseAccessToken(ctx, input.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := s.AccountRepo.RevokeAllSessions(ctx, authClaim.UserID, now, now.Add(s.JWTExpireTime)); err != nil {
+		s.ZapLogger.Warn("AuthService: logout all failure", zap.Uint64("userID", authClaim.UserID), zap.Error(err))
+		return nil, err
+	}
+	return &dto.LogoutAllOutput{
+		Message: "Logged out of all sessions successfully",
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,111 @@
+package totp
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA1 secret of the test vectors of RFC 4226 and RFC 6238
+var rfcSecret = []byte("12345678901234567890")
+
+func TestCodeAtRFC6238(t *testing.T) {
+	// RFC 6238 appendix B lists 8 digit codes, the 6 digit codes are their last 6 digits
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		step := Step(time.Unix(tt.unix, 0))
+		if got := CodeAt(rfcSecret, step); got != tt.want {
+			t.Errorf("CodeAt() at %d = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestCodeAtRFC4226(t *testing.T) {
+	want := []string{"755224", "287082", "359152", "969429", "338314", "254676", "287922", "162583", "399871", "520489"}
+	for counter, code := range want {
+		if got := CodeAt(rfcSecret, int64(counter)); got != code {
+			t.Errorf("CodeAt() of counter %d = %s, want %s", counter, got, code)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	current := Step(now)
+	tests := []struct {
+		name         string
+		code         string
+		lastUsedStep int64
+		wantStep     int64
+		wantOK       bool
+	}{
+		{"current step", CodeAt(rfcSecret, current), 0, current, true},
+		{"with spaces", " " + CodeAt(rfcSecret, current) + "\n", 0, current, true},
+		{"previous step", CodeAt(rfcSecret, current-1), 0, current - 1, true},
+		{"next step", CodeAt(rfcSecret, current+1), 0, current + 1, true},
+		{"two steps ago", CodeAt(rfcSecret, current-2), 0, 0, false},
+		{"two steps ahead", CodeAt(rfcSecret, current+2), 0, 0, false},
+		{"replayed", CodeAt(rfcSecret, current), current, 0, false},
+		{"earlier than last used", CodeAt(rfcSecret, current-1), current, 0, false},
+		{"after a late code", CodeAt(rfcSecret, current), current + 1, 0, false},
+		{"after an earlier code", CodeAt(rfcSecret, current), current - 1, current, true},
+		{"wrong code", "000000", 0, 0, false},
+		{"short", CodeAt(rfcSecret, current)[:5], 0, 0, false},
+		{"8 digits", "07081804", 0, 0, false},
+		{"empty", "", 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := Validate(rfcSecret, tt.code, now, tt.lastUsedStep)
+			if ok != tt.wantOK || step != tt.wantStep {
+				t.Errorf("Validate() = %d, %v, want %d, %v", step, ok, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+	if _, ok := Validate([]byte("another secret"), CodeAt(rfcSecret, current), now, 0); ok {
+		t.Error("Validate() accepted a code of another secret")
+	}
+}
+
+func TestStep(t *testing.T) {
+	if Step(time.Unix(29, 0)) != 0 || Step(time.Unix(30, 0)) != 1 || Step(time.Unix(59, 999)) != 1 {
+		t.Error("Step() does not cut time in 30 second steps")
+	}
+}
+
+func TestProvisioningURI(t *testing.T) {
+	secret, err := NewSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(secret) != SecretSize {
+		t.Fatalf("NewSecret() size = %d, want %d", len(secret), SecretSize)
+	}
+	encoded := EncodeSecret(secret)
+	if strings.Contains(encoded, "=") {
+		t.Errorf("EncodeSecret() = %q, want no padding", encoded)
+	}
+
+	uri, err := url.Parse(ProvisioningURI("Shop", "jane doe", secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uri.Scheme != "otpauth" || uri.Host != "totp" || uri.Path != "/Shop:jane doe" {
+		t.Errorf("ProvisioningURI() = %s", uri)
+	}
+	query := uri.Query()
+	if query.Get("secret") != encoded || query.Get("issuer") != "Shop" || query.Get("digits") != "6" || query.Get("period") != "30" {
+		t.Errorf("ProvisioningURI() query = %v", query)
+	}
+}
@@ -0,0 +1,405 @@
+package service
+
+import (
+	"auth-service/internal/service/totp"
+	"auth-service/pkg/dto"
+	"auth-service/pkg/model"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	// totpIssuer is the issuer shown by authenticator apps
+	totpIssuer = "mini-marketplace"
+
+	// challengeTokenTTL is how long a challenge token returned by Login can be exchanged
+	challengeTokenTTL = 5 * time.Minute
+
+	// challengeMaxFailures is the number of wrong codes after which a challenge token is refused
+	challengeMaxFailures = 5
+
+	// secondFactorMaxFailures is the number of wrong codes of an account, over all its challenges, that lock its
+	// second factor for secondFactorLockout. Only a right code resets them, a right password does not.
+	secondFactorMaxFailures = 10
+	secondFactorLockout     = 24 * time.Hour
+
+	// recoveryCodeCount is the number of recovery codes given at enrolment
+	recoveryCodeCount = 10
+)
+
+// ErrSecondFactorInvalid is returned for a wrong, replayed or used code
+var ErrSecondFactorInvalid = errors.New("invalid second factor code")
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// hasSecondFactor return whether the account has a confirmed TOTP secret
+func (s *AuthService) hasSecondFactor(ctx context.Context, userID uint64) (bool, error) {
+	twoFactor, err := s.AccountRepo.GetTwoFactor(ctx, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		s.ZapLogger.Error("AuthService: DB error", zap.Error(err))
+		return false, err
+	}
+	return twoFactor.ConfirmedAt != nil, nil
+}
+
+// generateChallengeToken sign a token that proves the password of tokenRequest was verified,
+// it is only exchanged by VerifySecondFactor or ConfirmTOTPEnrollment
+func (s *AuthService) generateChallengeToken(ctx context.Context, tokenRequest *dto.TokenRequest) (string, error) {
+	id, err := newTokenID()
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	return s.signClaims(ctx, &model.AuthClaim{
+		UserID:     tokenRequest.UserID,
+		Username:   tokenRequest.Username,
+		Role:       tokenRequest.Role,
+		PwdVersion: tokenRequest.PwdVersion,
+		Type:       "challenge",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        id,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(challengeTokenTTL)),
+		},
+	})
+}
+
+// parseChallengeToken return claims of a challenge token that is neither used nor out of attempts
+func (s *AuthService) parseChallengeToken(ctx context.Context, signedToken string) (*model.AuthClaim, error) {
+	claim, err := s.parseToken(ctx, signedToken)
+	if err != nil {
+		return nil, err
+	}
+	if claim.Type != "challenge" || claim.ID == "" {
+		s.ZapLogger.Warn("AuthService: challenge token only")
+		return nil, fmt.Errorf("challenge token only")
+	}
+	pipe := s.RedisClient.Pipeline()
+	used := pipe.Exists(ctx, "challenge_used:"+claim.ID)
+	failures := pipe.Get(ctx, "challenge_failures:"+claim.ID)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+	if n, _ := failures.Int64(); used.Val() > 0 || n >= challengeMaxFailures {
+		s.ZapLogger.Warn("AuthService: challenge token used or out of attempts", zap.Uint64("userID", claim.UserID))
+		return nil, fmt.Errorf("challenge expired, login again")
+	}
+	return claim, nil
+}
+
+// failChallenge count a wrong code for a challenge token
+func (s *AuthService) failChallenge(ctx context.Context, claim *model.AuthClaim) {
+	key := "challenge_failures:" + claim.ID
+	if err := s.RedisClient.Incr(ctx, key).Err(); err != nil {
+		s.ZapLogger.Warn("AuthService: count challenge failure", zap.Error(err))
+		return
+	}
+	s.RedisClient.ExpireAt(ctx, key, claim.ExpiresAt.Time)
+}
+
+func secondFactorFailuresKey(userID uint64) string {
+	return fmt.Sprintf("second_factor_failures:%d", userID)
+}
+
+// checkSecondFactorAllowed return a LoginThrottledError when wrong codes locked the second factor of the account
+func (s *AuthService) checkSecondFactorAllowed(ctx context.Context, userID uint64) error {
+	pipe := s.RedisClient.Pipeline()
+	failures := pipe.Get(ctx, secondFactorFailuresKey(userID))
+	ttl := pipe.PTTL(ctx, secondFactorFailuresKey(userID))
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+	if n, _ := failures.Int64(); n >= secondFactorMaxFailures {
+		s.ZapLogger.Warn("AuthService: second factor locked", zap.Uint64("userID", userID))
+		return &LoginThrottledError{RetryAfter: ttl.Val()}
+	}
+	return nil
+}
+
+// failSecondFactor count a wrong code for the account, and for its challenge token when there is one,
+// then lock the second factor of the account after secondFactorMaxFailures
+func (s *AuthService) failSecondFactor(ctx context.Context, account *model.Account, claim *model.AuthClaim) {
+	if claim != nil {
+		s.failChallenge(ctx, claim)
+	}
+	key := secondFactorFailuresKey(account.ID)
+	failures, err := s.RedisClient.Incr(ctx, key).Result()
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: count second factor failure", zap.Error(err))
+		return
+	}
+	if failures != 1 && failures != secondFactorMaxFailures {
+		return
+	}
+	// Failures are counted for secondFactorLockout from the first one, a lock lasts as long from the last one
+	if err := s.RedisClient.Expire(ctx, key, secondFactorLockout).Err(); err != nil {
+		s.ZapLogger.Warn("AuthService: set second factor failures window failure", zap.String("key", key), zap.Error(err))
+	}
+	if failures == secondFactorMaxFailures {
+		s.raiseLoginLocked(ctx, &dto.LoginLockedKafkaEvent{
+			Scope:       "second_factor",
+			UserID:      account.ID,
+			Username:    account.Username,
+			Role:        account.Role,
+			Failures:    failures,
+			LockedUntil: time.Now().Add(secondFactorLockout),
+		})
+	}
+}
+
+// resetSecondFactorFailures forget the wrong codes of an account after a right one
+func (s *AuthService) resetSecondFactorFailures(ctx context.Context, userID uint64) {
+	if err := s.RedisClient.Del(ctx, secondFactorFailuresKey(userID)).Err(); err != nil {
+		s.ZapLogger.Warn("AuthService: reset second factor failures failure", zap.Error(err))
+	}
+}
+
+// consumeChallenge mark a challenge token as used, false when it was used meanwhile
+func (s *AuthService) consumeChallenge(ctx context.Context, claim *model.AuthClaim) (bool, error) {
+	return s.RedisClient.SetNX(ctx, "challenge_used:"+claim.ID, claim.UserID, time.Until(claim.ExpiresAt.Time)).Result()
+}
+
+// twoFactorAccount return the account of an enrolment request, by challenge token during login or by user ID
+func (s *AuthService) twoFactorAccount(ctx context.Context, userID uint64, challengeToken string) (*model.Account, *model.AuthClaim, error) {
+	var claim *model.AuthClaim
+	if challengeToken != "" {
+		var err error
+		if claim, err = s.parseChallengeToken(ctx, challengeToken); err != nil {
+			return nil, nil, err
+		}
+		userID = claim.UserID
+	}
+	if userID == 0 {
+		return nil, nil, errors.New("user_id or challenge_token is required")
+	}
+	if err := s.checkSecondFactorAllowed(ctx, userID); err != nil {
+		return nil, nil, err
+	}
+	account, err := s.AccountRepo.GetAccountById(ctx, userID)
+	if err != nil {
+		s.ZapLogger.Warn("AuthService: get account failure", zap.Uint64("userID", userID), zap.Error(err))
+		return nil, nil, err
+	}
+	if claim != nil && claim.PwdVersion != account.PwdVersion {
+		return nil, nil, errors.New("password changed, login again")
+	}
+	return account, claim, nil
+}
+
+// newRecoveryCodes generate recovery codes formatted as xxxxx-xxxxx and their hashes
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, 7)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(recoveryCodeEncoding.EncodeToString(b))[:10]
+		codes = append(codes, code[:5]+"-"+code[5:])
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode return the stored form of a recovery code, as typed with or without dash and in any case
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// verifySecondFactor check a TOTP code, or a recovery code, of an account with a confirmed secret
+func (s *AuthService) verifySecondFactor(ctx context.Context, twoFactor *model.TwoFactor, code string) error {
+	if step, ok := totp.Validate(twoFactor.Secret, code, time.Now(), twoFactor.LastUsedStep); ok {
+		fresh, err := s.AccountRepo.UseTOTPStep(ctx, twoFactor.UserID, step)
+		if err != nil {
+			return err
+		}
+		if !fresh {
+			s.ZapLogger.Warn("AuthService: replayed TOTP code", zap.Uint64("userID", twoFactor.UserID))
+			return ErrSecondFactorInvalid
+		}
+		return nil
+	}
+	used, err := s.AccountRepo.UseRecoveryCode(ctx, twoFactor.UserID, hashRecoveryCode(code))
+	if err != nil {
+		return err
+	}
+	if !used {
+		return ErrSecondFactorInvalid
+	}
+	s.ZapLogger.Info("AuthService: recovery code used", zap.Uint64("userID", twoFactor.UserID))
+	return nil
+}
+
+// BeginTOTPEnrollment create a TOTP secret for the account, it is used once confirmed by ConfirmTOTPEnrollment
+func (s *AuthService) BeginTOTPEnrollment(ctx context.Context, input *dto.BeginTOTPEnrollmentInput) (*dto.BeginTOTPEnrollmentOutput, error) {
+	account, _, err := s.twoFactorAccount(ctx, input.UserID, input.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+	enrolled, err := s.hasSecondFactor(ctx, account.ID)
+	if err != nil {
+		return nil, err
+	}
+	if enrolled {
+		return nil, errors.New("second factor is already enabled")
+	}
+
+	secret, err := totp.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.AccountRepo.SaveUnconfirmedTwoFactor(ctx, &model.TwoFactor{
+		UserID:    account.ID,
+		Secret:    secret,
+		CreatedAt: time.Now(),
+	}); err != nil {
+		s.ZapLogger.Warn("AuthService: save TOTP secret failure", zap.Error(err))
+		return nil, err
+	}
+	return &dto.BeginTOTPEnrollmentOutput{
+		Message:         "Scan the provisioning URI and confirm with a code",
+		Success:         true,
+		Secret:          totp.EncodeSecret(secret),
+		ProvisioningURI: totp.ProvisioningURI(totpIssuer, account.Username, secret),
+	}, nil
+}
+
+// ConfirmTOTPEnrollment enable the TOTP secret with a first code and return the recovery codes, shown only once.
+// When confirmed with a challenge token, the login completes and tokens are returned too.
+func (s *AuthService) ConfirmTOTPEnrollment(ctx context.Context, input *dto.ConfirmTOTPEnrollmentInput) (*dto.ConfirmTOTPEnrollmentOutput, error) {
+	account, claim, err := s.twoFactorAccount(ctx, input.UserID, input.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+	twoFactor, err := s.AccountRepo.GetTwoFactor(ctx, account.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && twoFactor.ConfirmedAt != nil) {
+		return nil, errors.New("no second factor enrolment in progress")
+	}
+	if err != nil {
+		return nil, err
+	}
+	step, ok := totp.Validate(twoFactor.Secret, input.Code, time.Now(), twoFactor.LastUsedStep)
+	if !ok {
+		s.failSecondFactor(ctx, account, claim)
+		return nil, ErrSecondFactorInvalid
+	}
+	s.resetSecondFactorFailures(ctx, account.ID)
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.AccountRepo.ConfirmTwoFactor(ctx, account.ID, step, hashes); err != nil {
+		s.ZapLogger.Warn("AuthService: confirm TOTP failure", zap.Error(err))
+		return nil, err
+	}
+	output := &dto.ConfirmTOTPEnrollmentOutput{
+		Message:       "Second factor enabled",
+		Success:       true,
+		RecoveryCodes: codes,
+	}
+	if claim != nil {
+		if ok, err := s.consumeChallenge(ctx, claim); err != nil || !ok {
+			return output, err
+		}
+		if output.AccessToken, output.RefreshToken, err = s.issueLoginTokens(ctx, account, &input.SessionClient); err != nil {
+			return nil, err
+		}
+	}
+	return output, nil
+}
+
+// VerifySecondFactor exchange the challenge token of a login and a TOTP or recovery code for an access and refresh token
+func (s *AuthService) VerifySecondFactor(ctx context.Context, input *dto.VerifySecondFactorInput) (*dto.VerifySecondFactorOutput, error) {
+	account, claim, err := s.twoFactorAccount(ctx, 0, input.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+	twoFactor, err := s.AccountRepo.GetTwoFactor(ctx, account.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && twoFactor.ConfirmedAt == nil) {
+		return nil, errors.New("second factor is not enabled, enrol first")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.verifySecondFactor(ctx, twoFactor, input.Code); err != nil {
+		if errors.Is(err, ErrSecondFactorInvalid) {
+			s.failSecondFactor(ctx, account, claim)
+		}
+		return nil, err
+	}
+	s.resetSecondFactorFailures(ctx, account.ID)
+	ok, err := s.consumeChallenge(ctx, claim)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("challenge expired, login again")
+	}
+
+	signedAccessToken, signedRefreshToken, err := s.issueLoginTokens(ctx, account, &input.SessionClient)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.VerifySecondFactorOutput{
+		Message:      "Logged in successfully",
+		Success:      true,
+		AccessToken:  signedAccessToken,
+		RefreshToken: signedRefreshToken,
+	}, nil
+}
+
+// DisableTOTP remove the second factor of an account after checking a current code, roles that require it can not
+func (s *AuthService) DisableTOTP(ctx context.Context, input *dto.DisableTOTPInput) (*dto.DisableTOTPOutput, error) {
+	account, _, err := s.twoFactorAccount(ctx, input.UserID, "")
+	if err != nil {
+		return nil, err
+	}
+	required, err := s.requiresSecondFactor(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	if required {
+		return nil, fmt.Errorf("second factor is required for role %s", account.Role)
+	}
+	twoFactor, err := s.AccountRepo.GetTwoFactor(ctx, account.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && twoFactor.ConfirmedAt == nil) {
+		return nil, errors.New("second factor is not enabled")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.verifySecondFactor(ctx, twoFactor, input.Code); err != nil {
+		if errors.Is(err, ErrSecondFactorInvalid) {
+			s.failSecondFactor(ctx, account, nil)
+		}
+		return nil, err
+	}
+	s.resetSecondFactorFailures(ctx, account.ID)
+	if err := s.AccountRepo.DeleteTwoFactor(ctx, account.ID); err != nil {
+		s.ZapLogger.Warn("AuthService: disable TOTP failure", zap.Error(err))
+		return nil, err
+	}
+	return &dto.DisableTOTPOutput{
+		Message: "Second factor disabled",
+		Success: true,
+	}, nil
+}
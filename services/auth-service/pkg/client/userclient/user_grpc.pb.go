@@ -27,6 +27,12 @@ const (
 	UserService_UpdateSellerByID_FullMethodName    = "/user_service.pkg.pb.UserService/UpdateSellerByID"
 	UserService_GetSellerByID_FullMethodName       = "/user_service.pkg.pb.UserService/GetSellerByID"
 	UserService_DelSellerByID_FullMethodName       = "/user_service.pkg.pb.UserService/DelSellerByID"
+	UserService_AddWishlistItem_FullMethodName     = "/user_service.pkg.pb.UserService/AddWishlistItem"
+	UserService_RemoveWishlistItem_FullMethodName  = "/user_service.pkg.pb.UserService/RemoveWishlistItem"
+	UserService_GetWishlist_FullMethodName         = "/user_service.pkg.pb.UserService/GetWishlist"
+	UserService_ShareWishlist_FullMethodName       = "/user_service.pkg.pb.UserService/ShareWishlist"
+	UserService_UnshareWishlist_FullMethodName     = "/user_service.pkg.pb.UserService/UnshareWishlist"
+	UserService_GetSharedWishlist_FullMethodName   = "/user_service.pkg.pb.UserService/GetSharedWishlist"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -41,6 +47,12 @@ type UserServiceClient interface {
 	UpdateSellerByID(ctx context.Context, in *UpdateSellerByIDRequest, opts ...grpc.CallOption) (*UpdateSellerByIDResponse, error)
 	GetSellerByID(ctx context.Context, in *GetSellerByIDRequest, opts ...grpc.CallOption) (*GetSellerByIDResponse, error)
 	DelSellerByID(ctx context.Context, in *DelSellerByIDRequest, opts ...grpc.CallOption) (*DelSellerByIDResponse, error)
+	AddWishlistItem(ctx context.Context, in *AddWishlistItemRequest, opts ...grpc.CallOption) (*AddWishlistItemResponse, error)
+	RemoveWishlistItem(ctx context.Context, in *RemoveWishlistItemRequest, opts ...grpc.CallOption) (*RemoveWishlistItemResponse, error)
+	GetWishlist(ctx context.Context, in *GetWishlistRequest, opts ...grpc.CallOption) (*GetWishlistResponse, error)
+	ShareWishlist(ctx context.Context, in *ShareWishlistRequest, opts ...grpc.CallOption) (*ShareWishlistResponse, error)
+	UnshareWishlist(ctx context.Context, in *UnshareWishlistRequest, opts ...grpc.CallOption) (*UnshareWishlistResponse, error)
+	GetSharedWishlist(ctx context.Context, in *GetSharedWishlistRequest, opts ...grpc.CallOption) (*GetSharedWishlistResponse, error)
 }
 
 type userServiceClient struct {
@@ -131,6 +143,66 @@ func (c *userServiceClient) DelSellerByID(ctx context.Context, in *DelSellerByID
 	return out, nil
 }
 
+func (c *userServiceClient) AddWishlistItem(ctx context.Context, in *AddWishlistItemRequest, opts ...grpc.CallOption) (*AddWishlistItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddWishlistItemResponse)
+	err := c.cc.Invoke(ctx, UserService_AddWishlistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemoveWishlistItem(ctx context.Context, in *RemoveWishlistItemRequest, opts ...grpc.CallOption) (*RemoveWishlistItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveWishlistItemResponse)
+	err := c.cc.Invoke(ctx, UserService_RemoveWishlistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetWishlist(ctx context.Context, in *GetWishlistRequest, opts ...grpc.CallOption) (*GetWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWishlistResponse)
+	err := c.cc.Invoke(ctx, UserService_GetWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ShareWishlist(ctx context.Context, in *ShareWishlistRequest, opts ...grpc.CallOption) (*ShareWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareWishlistResponse)
+	err := c.cc.Invoke(ctx, UserService_ShareWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnshareWishlist(ctx context.Context, in *UnshareWishlistRequest, opts ...grpc.CallOption) (*UnshareWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnshareWishlistResponse)
+	err := c.cc.Invoke(ctx, UserService_UnshareWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetSharedWishlist(ctx context.Context, in *GetSharedWishlistRequest, opts ...grpc.CallOption) (*GetSharedWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSharedWishlistResponse)
+	err := c.cc.Invoke(ctx, UserService_GetSharedWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -143,6 +215,12 @@ type UserServiceServer interface {
 	UpdateSellerByID(context.Context, *UpdateSellerByIDRequest) (*UpdateSellerByIDResponse, error)
 	GetSellerByID(context.Context, *GetSellerByIDRequest) (*GetSellerByIDResponse, error)
 	DelSellerByID(context.Context, *DelSellerByIDRequest) (*DelSellerByIDResponse, error)
+	AddWishlistItem(context.Context, *AddWishlistItemRequest) (*AddWishlistItemResponse, error)
+	RemoveWishlistItem(context.Context, *RemoveWishlistItemRequest) (*RemoveWishlistItemResponse, error)
+	GetWishlist(context.Context, *GetWishlistRequest) (*GetWishlistResponse, error)
+	ShareWishlist(context.Context, *ShareWishlistRequest) (*ShareWishlistResponse, error)
+	UnshareWishlist(context.Context, *UnshareWishlistRequest) (*UnshareWishlistResponse, error)
+	GetSharedWishlist(context.Context, *GetSharedWishlistRequest) (*GetSharedWishlistResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -177,6 +255,24 @@ func (UnimplementedUserServiceServer) GetSellerByID(context.Context, *GetSellerB
 func (UnimplementedUserServiceServer) DelSellerByID(context.Context, *DelSellerByIDRequest) (*DelSellerByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelSellerByID not implemented")
 }
+func (UnimplementedUserServiceServer) AddWishlistItem(context.Context, *AddWishlistItemRequest) (*AddWishlistItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWishlistItem not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveWishlistItem(context.Context, *RemoveWishlistItemRequest) (*RemoveWishlistItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWishlistItem not implemented")
+}
+func (UnimplementedUserServiceServer) GetWishlist(context.Context, *GetWishlistRequest) (*GetWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWishlist not implemented")
+}
+func (UnimplementedUserServiceServer) ShareWishlist(context.Context, *ShareWishlistRequest) (*ShareWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareWishlist not implemented")
+}
+func (UnimplementedUserServiceServer) UnshareWishlist(context.Context, *UnshareWishlistRequest) (*UnshareWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareWishlist not implemented")
+}
+func (UnimplementedUserServiceServer) GetSharedWishlist(context.Context, *GetSharedWishlistRequest) (*GetSharedWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedWishlist not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -342,6 +438,114 @@ func _UserService_DelSellerByID_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_AddWishlistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWishlistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AddWishlistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AddWishlistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AddWishlistItem(ctx, req.(*AddWishlistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveWishlistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWishlistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveWishlistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RemoveWishlistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveWishlistItem(ctx, req.(*RemoveWishlistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetWishlist(ctx, req.(*GetWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ShareWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ShareWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ShareWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ShareWishlist(ctx, req.(*ShareWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnshareWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnshareWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnshareWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnshareWishlist(ctx, req.(*UnshareWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetSharedWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetSharedWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetSharedWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetSharedWishlist(ctx, req.(*GetSharedWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -381,6 +585,30 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelSellerByID",
 			Handler:    _UserService_DelSellerByID_Handler,
 		},
+		{
+			MethodName: "AddWishlistItem",
+			Handler:    _UserService_AddWishlistItem_Handler,
+		},
+		{
+			MethodName: "RemoveWishlistItem",
+			Handler:    _UserService_RemoveWishlistItem_Handler,
+		},
+		{
+			MethodName: "GetWishlist",
+			Handler:    _UserService_GetWishlist_Handler,
+		},
+		{
+			MethodName: "ShareWishlist",
+			Handler:    _UserService_ShareWishlist_Handler,
+		},
+		{
+			MethodName: "UnshareWishlist",
+			Handler:    _UserService_UnshareWishlist_Handler,
+		},
+		{
+			MethodName: "GetSharedWishlist",
+			Handler:    _UserService_GetSharedWishlist_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
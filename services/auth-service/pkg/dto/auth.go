@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 type RegisterInput struct {
 	Username        string `json:"username" binding:"required"`
 	Password        string `json:"password" binding:"required"`
@@ -12,16 +14,27 @@ type RegisterOutput struct {
 	Success bool   `json:"success"`
 }
 
+// SessionClient is the client a login is made from, it is recorded in the session of the login
+type SessionClient struct {
+	DeviceLabel string // name given by the user, derived from UserAgent when empty
+	UserAgent   string
+	ClientIP    string
+}
+
 type LoginInput struct {
 	Username string `json:"username" binding:"required"`
 	Password string `json:"password" binding:"required"`
 	Role     string `json:"role" binding:"required"`
+	SessionClient
 }
 type LoginOutput struct {
-	Message      string `json:"message"`
-	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
-	Success      bool   `json:"success"`
+	Message              string `json:"message"`
+	AccessToken          string `json:"access_token"`
+	RefreshToken         string `json:"refresh_token"`
+	Success              bool   `json:"success"`
+	SecondFactorRequired bool   `json:"second_factor_required"`
+	EnrollmentRequired   bool   `json:"enrollment_required"` // the role requires a second factor that is not enrolled yet
+	ChallengeToken       string `json:"challenge_token"`
 }
 
 type ChangePasswordInput struct {
@@ -49,6 +62,7 @@ type TokenRequest struct {
 	UserID     uint64
 	Username   string
 	Role       string
+	StoreID    uint64 // store of custom roles
 	PwdVersion int64
 }
 
@@ -67,8 +81,217 @@ type GetStoreIDRoleByIdInput struct {
 	ID uint64
 }
 type GetStoreIDRoleByIdOutput struct {
+	Message     string   `json:"message"`
+	Success     bool     `json:"success"`
+	StoreID     uint64   `json:"store_id"`
+	Role        string   `json:"role"`
+	Permissions []string `json:"permissions"`
+}
+
+type GetStoreAccountIDsInput struct {
+	StoreID uint64
+}
+type GetStoreAccountIDsOutput struct {
+	Message string   `json:"message"`
+	Success bool     `json:"success"`
+	UserIDs []uint64 `json:"user_ids"`
+}
+
+type LogoutInput struct {
+	AccessToken string
+}
+type LogoutOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type LogoutAllInput struct {
+	AccessToken string
+}
+type LogoutAllOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type UnlockAccountInput struct {
+	Username string
+	Role     string
+	ClientIP string
+}
+type UnlockAccountOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type RequestPasswordResetInput struct {
+	Username string
+	Role     string
+}
+type RequestPasswordResetOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type ResetPasswordInput struct {
+	Token       string
+	NewPassword string
+}
+type ResetPasswordOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type BeginTOTPEnrollmentInput struct {
+	UserID         uint64
+	ChallengeToken string
+}
+type BeginTOTPEnrollmentOutput struct {
+	Message         string `json:"message"`
+	Success         bool   `json:"success"`
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+type ConfirmTOTPEnrollmentInput struct {
+	UserID         uint64
+	ChallengeToken string
+	Code           string
+	SessionClient  // client of the login completed with ChallengeToken
+}
+type ConfirmTOTPEnrollmentOutput struct {
+	Message       string   `json:"message"`
+	Success       bool     `json:"success"`
+	RecoveryCodes []string `json:"recovery_codes"`
+	AccessToken   string   `json:"access_token"`
+	RefreshToken  string   `json:"refresh_token"`
+}
+
+type VerifySecondFactorInput struct {
+	ChallengeToken string
+	Code           string
+	SessionClient
+}
+type VerifySecondFactorOutput struct {
+	Message      string `json:"message"`
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	Success      bool   `json:"success"`
+}
+
+type DisableTOTPInput struct {
+	UserID uint64
+	Code   string
+}
+type DisableTOTPOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+type BeginOIDCLoginInput struct {
+	Provider string
+	UserID   uint64 // set to link the identity to this account instead of logging in
+}
+type BeginOIDCLoginOutput struct {
+	Message          string `json:"message"`
+	Success          bool   `json:"success"`
+	AuthorizationURL string `json:"authorization_url"`
+	State            string `json:"state"`
+}
+
+type CompleteOIDCLoginInput struct {
+	Provider string
+	State    string
+	Code     string
+	UserID   uint64 // authenticated caller, 0 for anonymous
+	SessionClient
+}
+type CompleteOIDCLoginOutput struct {
+	Message              string `json:"message"`
+	Success              bool   `json:"success"`
+	AccessToken          string `json:"access_token"`
+	RefreshToken         string `json:"refresh_token"`
+	SecondFactorRequired bool   `json:"second_factor_required"`
+	EnrollmentRequired   bool   `json:"enrollment_required"`
+	ChallengeToken       string `json:"challenge_token"`
+	AccountCreated       bool   `json:"account_created"` // the first login of the identity created a buyer account
+	Linked               bool   `json:"linked"`          // the identity was linked to the account that began the flow
+}
+
+// StoreRole is a custom role of a store
+type StoreRole struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+type ListStoreRolesInput struct {
+	ActorID uint64
+	StoreID uint64 // required for accounts without store, such as admins
+}
+type ListStoreRolesOutput struct {
+	Message               string       `json:"message"`
+	Success               bool         `json:"success"`
+	Roles                 []*StoreRole `json:"roles"`
+	AssignablePermissions []string     `json:"assignable_permissions"`
+}
+
+type CreateStoreRoleInput struct {
+	ActorID uint64
+	StoreID uint64
+	Role    *StoreRole
+}
+type CreateStoreRoleOutput struct {
+	Message string     `json:"message"`
+	Success bool       `json:"success"`
+	Role    *StoreRole `json:"role"`
+}
+
+type UpdateStoreRoleInput struct {
+	ActorID uint64
+	StoreID uint64
+	Role    *StoreRole
+}
+type UpdateStoreRoleOutput struct {
+	Message string     `json:"message"`
+	Success bool       `json:"success"`
+	Role    *StoreRole `json:"role"`
+}
+
+type DeleteStoreRoleInput struct {
+	ActorID uint64
+	StoreID uint64
+	Name    string
+}
+type DeleteStoreRoleOutput struct {
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+// Session is a login of the user, Current is the session of the request
+type Session struct {
+	ID          string    `json:"id"`
+	DeviceLabel string    `json:"device_label"`
+	UserAgent   string    `json:"user_agent"`
+	ClientIP    string    `json:"client_ip"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastUsedAt  time.Time `json:"last_used_at"`
+	Current     bool      `json:"current"`
+}
+
+type ListSessionsInput struct {
+	AccessToken string
+}
+type ListSessionsOutput struct {
+	Message  string     `json:"message"`
+	Success  bool       `json:"success"`
+	Sessions []*Session `json:"sessions"`
+}
+
+type RevokeSessionInput struct {
+	AccessToken string
+	SessionID   string
+}
+type RevokeSessionOutput struct {
 	Message string `json:"message"`
 	Success bool   `json:"success"`
-	StoreID uint64 `json:"store_id"`
-	Role    string `json:"role"`
 }
@@ -0,0 +1,16 @@
+package dto
+
+// JWK is a public signing key in JSON Web Key format, Ed25519 keys are OKP keys (RFC 8037)
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	Kid string `json:"kid"`
+	X   string `json:"x"` // base64url public key
+}
+
+type GetJWKSInput struct{}
+type GetJWKSOutput struct {
+	Keys []*JWK `json:"keys"`
+}
@@ -1,6 +1,19 @@
 package dto
 
+import "time"
+
 type CreateSellerKafkaEvent struct {
 	SellerID uint64
 	UserID   uint64
 }
+
+// LoginLockedKafkaEvent is published when failed logins lock an account or an IP, or wrong codes lock a second factor
+type LoginLockedKafkaEvent struct {
+	Scope       string    `json:"scope"` // account, ip or second_factor
+	UserID      uint64    `json:"user_id,omitempty"`
+	Username    string    `json:"username,omitempty"`
+	Role        string    `json:"role,omitempty"`
+	ClientIP    string    `json:"client_ip,omitempty"`
+	Failures    int64     `json:"failures"`
+	LockedUntil time.Time `json:"locked_until"`
+}
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// Audit events
+const (
+	AuditRefreshTokenReuse = "REFRESH_TOKEN_REUSE" // a revoked refresh token was presented, its family was revoked
+	AuditLoginLocked       = "LOGIN_LOCKED"        // failed logins locked the account
+	AuditLoginUnlocked     = "LOGIN_UNLOCKED"      // an admin unlocked the account
+	AuditTwoFactorEnabled  = "TWO_FACTOR_ENABLED"  // TOTP enrolment was confirmed
+	AuditTwoFactorDisabled = "TWO_FACTOR_DISABLED" // TOTP was removed
+	AuditRecoveryCodeUsed  = "RECOVERY_CODE_USED"  // a recovery code replaced a TOTP code
+	AuditIdentityLinked    = "IDENTITY_LINKED"     // an external identity was linked to the account
+)
+
+// AuditLog record a security relevant event of an account
+type AuditLog struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement"`
+	UserID    uint64    `gorm:"not null;index"`
+	Event     string    `gorm:"not null;index"`
+	Detail    string    `gorm:"not null;default:''"`
+	CreatedAt time.Time `gorm:"not null"`
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// ExternalIdentity link the subject of an OpenID Connect provider to a local account.
+// An issuer and subject pair identify one user of one provider for good, email and username may change.
+type ExternalIdentity struct {
+	ID          uint64    `gorm:"primaryKey;autoIncrement"`
+	UserID      uint64    `gorm:"not null;index"`
+	Provider    string    `gorm:"not null"` // configured name of the provider
+	Issuer      string    `gorm:"not null;uniqueIndex:idx_external_identity_subject"`
+	Subject     string    `gorm:"not null;uniqueIndex:idx_external_identity_subject"`
+	Email       string    `gorm:"not null;default:''"`
+	CreatedAt   time.Time `gorm:"not null"`
+	LastLoginAt time.Time `gorm:"not null"`
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// PasswordResetToken is a single use token that sets a new password without the old one.
+// Only the SHA-256 of the token is stored, the token itself is sent to the account owner.
+type PasswordResetToken struct {
+	ID        uint64     `gorm:"primaryKey;autoIncrement"`
+	UserID    uint64     `gorm:"not null;index"`
+	TokenHash string     `gorm:"type:varchar(64);not null;uniqueIndex"`
+	ExpiresAt time.Time  `gorm:"not null"`
+	UsedAt    *time.Time // set when redeemed, or when a newer token is requested
+	CreatedAt time.Time  `gorm:"not null"`
+}
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// RefreshToken is an issued refresh token, identified by the jti claim.
+// Every login starts a family, every refresh revokes the presented token and issues the next one of its family.
+type RefreshToken struct {
+	ID         string     `gorm:"primaryKey;type:varchar(64)"`
+	FamilyID   string     `gorm:"type:varchar(64);not null;index"`
+	UserID     uint64     `gorm:"not null;index"`
+	ExpiresAt  time.Time  `gorm:"not null"`
+	RevokedAt  *time.Time // set when rotated or when its family is revoked
+	ReplacedBy string     `gorm:"type:varchar(64);not null;default:''"` // ID of the token issued in exchange, empty when not rotated
+	CreatedAt  time.Time  `gorm:"not null"`
+}
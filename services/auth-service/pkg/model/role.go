@@ -0,0 +1,70 @@
+package model
+
+import "time"
+
+// Permissions, checked by the gateway from access tokens and by services from GetStoreIDRoleById
+const (
+	PermissionBuyerProfile    = "buyer:profile"    // buyer profile and wishlist
+	PermissionQuestionAsk     = "question:ask"     // ask questions about products
+	PermissionSellerWrite     = "seller:write"     // create, update and delete the seller profile of the store
+	PermissionStaffWrite      = "staff:write"      // register accounts of store staff
+	PermissionStoreRoleWrite  = "store_role:write" // manage the custom roles of a store
+	PermissionStoreRoleAny    = "store_role:any"   // manage the custom roles of any store, named by the request
+	PermissionProductWrite    = "product:write"    // create, update, import and export products
+	PermissionProductDelete   = "product:delete"   // delete, archive and restore products
+	PermissionInventoryRead   = "inventory:read"   // read inventory movements
+	PermissionInventoryWrite  = "inventory:write"  // manual inventory adjustment
+	PermissionQuestionAnswer  = "question:answer"  // answer questions of buyers
+	PermissionCategoryWrite   = "category:write"   // manage categories
+	PermissionContentModerate = "content:moderate" // moderate questions and answers
+	PermissionAccountUnlock   = "account:unlock"   // clear login lockouts
+)
+
+// System roles, every account that is not store staff with a custom role has one of them
+const (
+	RoleBuyer          = "buyer"
+	RoleSellerAdmin    = "seller_admin"
+	RoleSellerEmployee = "seller_employee"
+	RoleAdmin          = "admin"
+)
+
+// SystemRolePermissions is the permissions of the system roles, written to DB at start by SyncSystemRoles
+var SystemRolePermissions = map[string][]string{
+	RoleBuyer: {PermissionBuyerProfile, PermissionQuestionAsk},
+	RoleSellerAdmin: {PermissionSellerWrite, PermissionStaffWrite, PermissionStoreRoleWrite, PermissionProductWrite,
+		PermissionProductDelete, PermissionInventoryRead, PermissionInventoryWrite, PermissionQuestionAnswer},
+	RoleSellerEmployee: {PermissionProductWrite, PermissionInventoryRead, PermissionInventoryWrite, PermissionQuestionAnswer},
+	RoleAdmin: {PermissionCategoryWrite, PermissionContentModerate, PermissionAccountUnlock, PermissionStoreRoleWrite,
+		PermissionStoreRoleAny},
+}
+
+// StoreStaffRoles are the system roles that staff of a store can be registered with, besides its custom roles
+var StoreStaffRoles = []string{RoleSellerAdmin, RoleSellerEmployee}
+
+// StorePermissions are the permissions that custom store roles can grant. Staff and role management stay with
+// the system roles, so a custom role can not grant more than its creator has.
+var StorePermissions = []string{PermissionProductWrite, PermissionProductDelete, PermissionInventoryRead,
+	PermissionInventoryWrite, PermissionQuestionAnswer}
+
+// Permission is a right checked by the gateway and the services
+type Permission struct {
+	Name string `gorm:"primaryKey;type:varchar(64)"`
+}
+
+// Role is a named set of permissions. System roles have StoreID 0, custom roles belong to a store and are
+// named uniquely within it. Accounts refer to their role by name.
+type Role struct {
+	ID          uint64           `gorm:"primaryKey;autoIncrement"`
+	Name        string           `gorm:"not null;uniqueIndex:idx_role_store_name"`
+	StoreID     uint64           `gorm:"not null;default:0;uniqueIndex:idx_role_store_name"`
+	Description string           `gorm:"not null;default:''"`
+	Permissions []RolePermission `gorm:"foreignKey:RoleID;constraint:OnDelete:CASCADE"`
+	CreatedAt   time.Time        `gorm:"not null"`
+	UpdatedAt   time.Time        `gorm:"not null"`
+}
+
+// RolePermission grant a permission to a role
+type RolePermission struct {
+	RoleID         uint64 `gorm:"primaryKey"`
+	PermissionName string `gorm:"primaryKey;type:varchar(64)"`
+}
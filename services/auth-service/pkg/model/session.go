@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// Session is a login as its owner sees it, one per refresh token family. Its ID is the FamilyID of the family,
+// it is revoked with the family and expires with the last refresh token issued in it.
+type Session struct {
+	ID          string     `gorm:"primaryKey;type:varchar(64)"`
+	UserID      uint64     `gorm:"not null;index"`
+	DeviceLabel string     `gorm:"type:varchar(64);not null;default:''"`
+	UserAgent   string     `gorm:"type:varchar(512);not null;default:''"`
+	ClientIP    string     `gorm:"type:varchar(45);not null;default:''"`
+	CreatedAt   time.Time  `gorm:"not null"`
+	LastUsedAt  time.Time  `gorm:"not null"` // login or latest refresh
+	ExpiresAt   time.Time  `gorm:"not null"`
+	RevokedAt   *time.Time // set on logout, revocation and refresh token reuse
+}
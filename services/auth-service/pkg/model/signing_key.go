@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// SigningKeyAlgEdDSA is the JWT alg of Ed25519 signing keys
+const SigningKeyAlgEdDSA = "EdDSA"
+
+// SigningKey is an Ed25519 key pair that signs tokens, its kid is set in the header of the tokens it signs.
+// The newest key that is not retired signs new tokens, retired keys stay published to verify the tokens
+// they signed until those expire.
+type SigningKey struct {
+	KID        string     `gorm:"primaryKey;type:varchar(32)"`
+	Algorithm  string     `gorm:"type:varchar(16);not null"`
+	PrivateKey []byte     `gorm:"not null"` // Ed25519 seed
+	PublicKey  []byte     `gorm:"not null"`
+	CreatedAt  time.Time  `gorm:"not null;index"`
+	RetiredAt  *time.Time `gorm:"index"` // set when a newer key is rotated in
+}
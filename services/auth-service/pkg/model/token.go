@@ -5,10 +5,12 @@ import (
 )
 
 type AuthClaim struct {
-	UserID     uint64
-	Username   string
-	Role       string
-	PwdVersion int64
-	Type       string
+	UserID      uint64
+	Username    string
+	Role        string
+	PwdVersion  int64
+	Type        string
+	FamilyID    string   // refresh token family of the login, revoked together on logout
+	Permissions []string `json:",omitempty"` // permissions of Role, in access tokens only
 	jwt.RegisteredClaims
 }
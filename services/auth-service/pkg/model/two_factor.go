@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// SecondFactorRequiredPermissions are the permissions whose holders can not log in without a second factor
+var SecondFactorRequiredPermissions = []string{PermissionStaffWrite}
+
+// TwoFactor is the TOTP secret of an account. It is used at login once ConfirmedAt is set.
+type TwoFactor struct {
+	UserID       uint64     `gorm:"primaryKey"`
+	Secret       []byte     `gorm:"not null"`
+	ConfirmedAt  *time.Time // set when a first code is verified
+	LastUsedStep int64      `gorm:"not null;default:0"` // TOTP step of the last accepted code, codes of earlier steps are refused
+	CreatedAt    time.Time  `gorm:"not null"`
+}
+
+// RecoveryCode is a single use code that replaces a TOTP code when the authenticator is lost.
+// Only the SHA-256 of the code is stored.
+type RecoveryCode struct {
+	ID       uint64 `gorm:"primaryKey;autoIncrement"`
+	UserID   uint64 `gorm:"not null;index"`
+	CodeHash string `gorm:"type:varchar(64);not null"`
+	UsedAt   *time.Time
+}
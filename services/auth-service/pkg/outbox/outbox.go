@@ -13,3 +13,24 @@ type PwdVersionKafkaEvent struct {
 	UserID     uint64 `json:"user_id"`
 	PwdVersion int64  `json:"pwd_version"`
 }
+
+// TokenRevocationEvent is saved with a logout, the gateway denies the revoked access tokens until ExpiresAt
+type TokenRevocationEvent struct {
+	ID           uint64    `gorm:"primaryKey;autoIncrement"`
+	UserID       uint64    `gorm:"notnull"`
+	JTI          string    `gorm:"type:varchar(64)"` // revoked access token, may be empty when FamilyID is set
+	FamilyID     string    `gorm:"type:varchar(64)"` // revoked session, its access tokens are denied whatever JTI
+	IssuedBefore time.Time `gorm:"notnull"`          // time of the revocation
+	ExpiresAt    time.Time `gorm:"notnull"`
+	Status       string    `gorm:"notnull;default:'PENDING';index:idx_token_revocation_status_created_at,priority:1"` // PENDING, FAILED, SUCCESS
+	CreatedAt    time.Time `gorm:"notnull;index:idx_token_revocation_status_created_at,priority:2"`
+}
+
+type TokenRevocationKafkaEvent struct {
+	ID           uint64    `json:"event_id"`
+	UserID       uint64    `json:"user_id"`
+	JTI          string    `json:"jti,omitempty"`
+	FamilyID     string    `json:"family_id,omitempty"`
+	IssuedBefore time.Time `json:"issued_before"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
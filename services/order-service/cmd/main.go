@@ -69,6 +69,13 @@ func main() {
 	defer conn.Close()
 	ctx1 := context.Context(context.Background())
 	orderService.ProducerCreOrdKafkaEventWorker(ctx1, 3*time.Second, 100, topic1)
+	topic2 := "order.cancel_order"
+	conn2, err := kafka.DialLeader(context.Background(), "tcp", "broker1:9092", topic2, 0)
+	if err != nil {
+		panic(err)
+	}
+	defer conn2.Close()
+	orderService.ProducerCanOrdKafkaEventWorker(ctx1, 3*time.Second, 100, topic2)
 
 	lis, err := net.Listen("tcp", ":50052")
 	if err != nil {
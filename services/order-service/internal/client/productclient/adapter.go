@@ -1,6 +1,9 @@
 package productclient
 
-import productpb "order-service/pkg/client/productclient"
+import (
+	productpb "order-service/pkg/client/productclient"
+	"product-service/pkg/money"
+)
 
 func ProductsProtoToDTO(products []*productpb.Product) []*ProductDTOClient {
 	var dtoProducts []*ProductDTOClient
@@ -18,8 +21,17 @@ func ProductProtoToDTO(product *productpb.Product) *ProductDTOClient {
 	return &ProductDTOClient{
 		ID:        product.GetId(),
 		Name:      product.GetName(),
-		Price:     product.GetPrice(),
+		Price:     money.New(product.GetPrice().GetAmount(), product.GetPrice().GetCurrency()),
 		SellerID:  product.GetSellerId(),
 		Inventory: product.GetInventory(),
+		Status:    product.GetStatus(),
 	}
 }
+
+func ExchangeRatesProtoToMap(rates []*productpb.ExchangeRate) map[string]float64 {
+	ratesMap := make(map[string]float64, len(rates))
+	for _, rate := range rates {
+		ratesMap[rate.GetCurrency()] = rate.GetRate()
+	}
+	return ratesMap
+}
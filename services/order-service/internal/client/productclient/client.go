@@ -48,3 +48,29 @@ func (p *ProductClient) GetProductsByID(ctx context.Context, input *GetProductsB
 		Products: output,
 	}, nil
 }
+
+func (p *ProductClient) GetExchangeRates(ctx context.Context, input *GetExchangeRatesInput) (*GetExchangeRatesOutput, error) {
+	if p.Client == nil {
+		productClient, err := p.ClientManager.GetOrCreateProductClient()
+		if err != nil {
+			p.ZapLogger.Error("ProductClient: ProductClient is nil and create failed", zap.Error(err))
+			return nil, err
+		}
+		p.ZapLogger.Info("ProductClient: ProductClient is nil and create success")
+		p.Client = productClient
+	}
+
+	res, err := p.Client.GetExchangeRates(ctx, &productpb.GetExchangeRatesRequest{})
+	if err != nil {
+		p.ZapLogger.Error("ProductClient: GetExchangeRates error", zap.Error(err))
+		return nil, err
+	}
+
+	return &GetExchangeRatesOutput{
+		Base:      res.GetBase(),
+		Rates:     ExchangeRatesProtoToMap(res.GetRates()),
+		UpdatedAt: res.GetUpdatedAt().AsTime(),
+		Message:   res.GetMessage(),
+		Success:   res.GetSuccess(),
+	}, nil
+}
@@ -1,11 +1,17 @@
 package productclient
 
+import (
+	"product-service/pkg/money"
+	"time"
+)
+
 type ProductDTOClient struct {
 	ID        uint64
 	Name      string
-	Price     float64
+	Price     money.Money
 	SellerID  uint64
 	Inventory int64
+	Status    string
 }
 
 type GetProductsByIDInput struct {
@@ -17,3 +23,14 @@ type GetProductsByIDOutput struct {
 	Message  string
 	Success  bool
 }
+
+type GetExchangeRatesInput struct {
+}
+
+type GetExchangeRatesOutput struct {
+	Base      string
+	Rates     map[string]float64 // units of currency for one unit of Base
+	UpdatedAt time.Time
+	Message   string
+	Success   bool
+}
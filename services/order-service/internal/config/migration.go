@@ -0,0 +1,40 @@
+package config
+
+import (
+	"order-service/pkg/model"
+	"product-service/pkg/money"
+
+	"gorm.io/gorm"
+)
+
+// minorUnitsSQL is the factor from major to minor units of the currency column
+const minorUnitsSQL = `CASE WHEN currency IN @zero THEN 1 WHEN currency IN @three THEN 1000 ELSE 100 END`
+
+// migrateMoneyColumns move amounts of the old float total_price and price columns to minor units
+// in total_amount and price_amount and drop them. It runs after AutoMigrate added the new columns
+// and does nothing once the old columns are gone.
+func migrateMoneyColumns(db *gorm.DB) error {
+	currencies := map[string]interface{}{
+		"zero":  money.CurrenciesWithExponent(0),
+		"three": money.CurrenciesWithExponent(3),
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		if tx.Migrator().HasColumn(&model.Order{}, "total_price") {
+			if err := tx.Exec(`UPDATE orders SET total_amount = ROUND(total_price * `+minorUnitsSQL+`)`, currencies).Error; err != nil {
+				return err
+			}
+			if err := tx.Migrator().DropColumn(&model.Order{}, "total_price"); err != nil {
+				return err
+			}
+		}
+		if tx.Migrator().HasColumn(&model.OrderItem{}, "price") {
+			if err := tx.Exec(`UPDATE order_items SET price_amount = ROUND(price * `+minorUnitsSQL+`)`, currencies).Error; err != nil {
+				return err
+			}
+			if err := tx.Migrator().DropColumn(&model.OrderItem{}, "price"); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -89,7 +89,10 @@ func initPostgresDB() (*gorm.DB, error) {
 		return nil, err
 	}
 
-	db.AutoMigrate(&model.Order{}, &model.OrderItem{}, &outbox.CreateOrderEvent{})
+	db.AutoMigrate(&model.Order{}, &model.OrderItem{}, &outbox.CreateOrderEvent{}, &outbox.CancelOrderEvent{})
+	if err := migrateMoneyColumns(db); err != nil {
+		return nil, err
+	}
 
 	return db, nil
 }
@@ -139,6 +139,11 @@ func (r *OrderRepository) CancelOrderByID(ctx context.Context, id uint64) error
 			return err
 		}
 
+		// Create cancel order in outbox, so that reserved inventory is given back
+		if err := r.CreateCancelOrderOutbox(tx, &outbox.CancelOrderEvent{OrderID: id, Status: "PENDING"}); err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
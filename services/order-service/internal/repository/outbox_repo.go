@@ -5,6 +5,7 @@ import (
 	"order-service/pkg/outbox"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 func (r *OrderRepository) CreateOrderOutbox(tx *gorm.DB, createOrderOutbox *outbox.CreateOrderEvent) error {
@@ -30,3 +31,24 @@ func (r *OrderRepository) UpdateCreateOrderEventStatus(ctx context.Context, orde
 	return r.DB.WithContext(ctx).Model(&outbox.CreateOrderEvent{}).Where("order_id = ?", orderID).
 		Updates(map[string]interface{}{"status": status}).Error
 }
+
+func (r *OrderRepository) CreateCancelOrderOutbox(tx *gorm.DB, cancelOrderOutbox *outbox.CancelOrderEvent) error {
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(cancelOrderOutbox).Error
+}
+
+func (r *OrderRepository) GetCancelOrderEventNotPublish(limit int) ([]*outbox.CancelOrderEvent, error) {
+	var canOrdEvents []*outbox.CancelOrderEvent
+	result := r.DB.Model(&outbox.CancelOrderEvent{}).Where("status IN ?", []string{"PENDING", "FAILED"}).Limit(limit).Find(&canOrdEvents)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return canOrdEvents, nil
+}
+
+func (r *OrderRepository) UpdateCancelOrderEventStatus(ctx context.Context, orderID uint64, status string) error {
+	return r.DB.WithContext(ctx).Model(&outbox.CancelOrderEvent{}).Where("order_id = ?", orderID).
+		Updates(map[string]interface{}{"status": status}).Error
+}
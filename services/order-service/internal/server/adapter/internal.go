@@ -3,10 +3,21 @@ package adapter
 import (
 	"order-service/pkg/dto"
 	orderpb "order-service/pkg/pb"
+	"product-service/pkg/money"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+func MoneyProtoToDTO(m *orderpb.Money) money.Money {
+	return money.New(m.GetAmount(), m.GetCurrency())
+}
+func MoneyDTOToProto(m money.Money) *orderpb.Money {
+	return &orderpb.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
 func OrderProtoToDTO(order *orderpb.Order) (*dto.Order, error) {
 	orderItemsDTO, err := OrderItemsProtoToDTO(order.GetOrderItem())
 	if err != nil {
@@ -16,10 +27,14 @@ func OrderProtoToDTO(order *orderpb.Order) (*dto.Order, error) {
 		ID:         order.GetId(),
 		BuyerID:    order.GetBuyerId(),
 		Status:     order.GetStatus(),
-		TotalPrice: order.GetTotalPrice(),
+		TotalPrice: MoneyProtoToDTO(order.GetTotalPrice()),
+		Currency:   order.GetCurrency(),
 		OrderItems: orderItemsDTO,
 		CreatedAt:  order.GetCreatedAt().AsTime(),
 		UpdatedAt:  order.GetUpdatedAt().AsTime(),
+
+		RateBase:       order.GetRateBase(),
+		RatesUpdatedAt: order.GetRatesUpdatedAt().AsTime(),
 	}, nil
 }
 func OrderDTOToProto(order *dto.Order) (*orderpb.Order, error) {
@@ -31,10 +46,14 @@ func OrderDTOToProto(order *dto.Order) (*orderpb.Order, error) {
 		Id:         order.ID,
 		BuyerId:    order.BuyerID,
 		Status:     order.Status,
-		TotalPrice: order.TotalPrice,
+		TotalPrice: MoneyDTOToProto(order.TotalPrice),
+		Currency:   order.Currency,
 		OrderItem:  orderItems,
 		CreatedAt:  timestamppb.New(order.CreatedAt),
 		UpdatedAt:  timestamppb.New(order.UpdatedAt),
+
+		RateBase:       order.RateBase,
+		RatesUpdatedAt: timestamppb.New(order.RatesUpdatedAt),
 	}, nil
 }
 
@@ -63,28 +82,30 @@ func OrdersDTOToProto(orders []*dto.Order) ([]*orderpb.Order, error) {
 
 func OrderItemProtoToDTO(orderItem *orderpb.OrderItem) (*dto.OrderItem, error) {
 	return &dto.OrderItem{
-		ID:        orderItem.GetID(),
-		Name:      orderItem.GetName(),
-		OrderID:   orderItem.GetOrderId(),
-		ProductID: orderItem.GetProductId(),
-		Quantity:  orderItem.GetQuantity(),
-		Price:     orderItem.GetPrice(),
-		Status:    orderItem.GetStatus(),
-		CreatedAt: orderItem.GetCreatedAt().AsTime(),
-		UpdatedAt: orderItem.GetUpdatedAt().AsTime(),
+		ID:           orderItem.GetID(),
+		Name:         orderItem.GetName(),
+		OrderID:      orderItem.GetOrderId(),
+		ProductID:    orderItem.GetProductId(),
+		Quantity:     orderItem.GetQuantity(),
+		Price:        MoneyProtoToDTO(orderItem.GetPrice()),
+		ExchangeRate: orderItem.GetExchangeRate(),
+		Status:       orderItem.GetStatus(),
+		CreatedAt:    orderItem.GetCreatedAt().AsTime(),
+		UpdatedAt:    orderItem.GetUpdatedAt().AsTime(),
 	}, nil
 }
 func OrderItemDTOToProto(orderItem *dto.OrderItem) (*orderpb.OrderItem, error) {
 	return &orderpb.OrderItem{
-		ID:        orderItem.ID,
-		Name:      orderItem.Name,
-		OrderId:   orderItem.OrderID,
-		ProductId: orderItem.ProductID,
-		Quantity:  orderItem.Quantity,
-		Price:     orderItem.Price,
-		Status:    orderItem.Status,
-		CreatedAt: timestamppb.New(orderItem.CreatedAt),
-		UpdatedAt: timestamppb.New(orderItem.UpdatedAt),
+		ID:           orderItem.ID,
+		Name:         orderItem.Name,
+		OrderId:      orderItem.OrderID,
+		ProductId:    orderItem.ProductID,
+		Quantity:     orderItem.Quantity,
+		Price:        MoneyDTOToProto(orderItem.Price),
+		ExchangeRate: orderItem.ExchangeRate,
+		Status:       orderItem.Status,
+		CreatedAt:    timestamppb.New(orderItem.CreatedAt),
+		UpdatedAt:    timestamppb.New(orderItem.UpdatedAt),
 	}, nil
 }
 
@@ -5,6 +5,7 @@ import (
 	"order-service/internal/client/productclient"
 	"order-service/pkg/dto"
 	"order-service/pkg/model"
+	"product-service/pkg/money"
 	"slices"
 )
 
@@ -13,13 +14,17 @@ import (
 func OrderDTOToModel(order *dto.Order) *model.Order {
 	orderItems := OrderItemsDTOToModel(order.OrderItems)
 	return &model.Order{
-		ID:         order.ID,
-		BuyerID:    order.BuyerID,
-		Status:     order.Status,
-		TotalPrice: order.TotalPrice,
-		OrderItems: orderItems,
-		CreatedAt:  order.CreatedAt,
-		UpdatedAt:  order.UpdatedAt,
+		ID:          order.ID,
+		BuyerID:     order.BuyerID,
+		Status:      order.Status,
+		TotalAmount: order.TotalPrice.Amount,
+		Currency:    order.Currency,
+		OrderItems:  orderItems,
+		CreatedAt:   order.CreatedAt,
+		UpdatedAt:   order.UpdatedAt,
+
+		RateBase:       order.RateBase,
+		RatesUpdatedAt: order.RatesUpdatedAt,
 	}
 }
 func OrderModelToDTO(order *model.Order, products []*productclient.ProductDTOClient) *dto.Order {
@@ -29,10 +34,14 @@ func OrderModelToDTO(order *model.Order, products []*productclient.ProductDTOCli
 		ID:         order.ID,
 		BuyerID:    order.BuyerID,
 		Status:     order.Status,
-		TotalPrice: order.TotalPrice,
+		TotalPrice: money.New(order.TotalAmount, order.Currency),
+		Currency:   order.Currency,
 		OrderItems: orderItems,
 		CreatedAt:  order.CreatedAt,
 		UpdatedAt:  order.UpdatedAt,
+
+		RateBase:       order.RateBase,
+		RatesUpdatedAt: order.RatesUpdatedAt,
 	}
 }
 
@@ -55,27 +64,30 @@ func OrdersModelToDTO(orders []*model.Order, products []*productclient.ProductDT
 
 func OrderItemDTOToModel(orderItem *dto.OrderItem) *model.OrderItem {
 	return &model.OrderItem{
-		ID:        orderItem.ID,
-		OrderID:   orderItem.OrderID,
-		ProductID: orderItem.ProductID,
-		Quantity:  orderItem.Quantity,
-		Price:     orderItem.Price,
-		Status:    orderItem.Status,
-		CreatedAt: orderItem.CreatedAt,
-		UpdatedAt: orderItem.UpdatedAt,
+		ID:           orderItem.ID,
+		OrderID:      orderItem.OrderID,
+		ProductID:    orderItem.ProductID,
+		Quantity:     orderItem.Quantity,
+		PriceAmount:  orderItem.Price.Amount,
+		Currency:     orderItem.Price.Currency,
+		ExchangeRate: orderItem.ExchangeRate,
+		Status:       orderItem.Status,
+		CreatedAt:    orderItem.CreatedAt,
+		UpdatedAt:    orderItem.UpdatedAt,
 	}
 }
 func OrderItemModelToDTO(orderItem *model.OrderItem, name string) *dto.OrderItem {
 	return &dto.OrderItem{
-		ID:        orderItem.ID,
-		Name:      name,
-		OrderID:   orderItem.OrderID,
-		ProductID: orderItem.ProductID,
-		Quantity:  orderItem.Quantity,
-		Price:     orderItem.Price,
-		Status:    orderItem.Status,
-		CreatedAt: orderItem.CreatedAt,
-		UpdatedAt: orderItem.UpdatedAt,
+		ID:           orderItem.ID,
+		Name:         name,
+		OrderID:      orderItem.OrderID,
+		ProductID:    orderItem.ProductID,
+		Quantity:     orderItem.Quantity,
+		Price:        money.New(orderItem.PriceAmount, orderItem.Currency),
+		ExchangeRate: orderItem.ExchangeRate,
+		Status:       orderItem.Status,
+		CreatedAt:    orderItem.CreatedAt,
+		UpdatedAt:    orderItem.UpdatedAt,
 	}
 }
 
@@ -125,3 +137,13 @@ func FilterItemIDsByItems(orderItems []*model.OrderItem) []uint64 {
 	slices.Sort(ids)
 	return ids
 }
+
+func FilterProductIDsByItems(orderItems []*model.OrderItem) []uint64 {
+	idsMap := map[uint64]struct{}{}
+	for _, orderItem := range orderItems {
+		idsMap[orderItem.ProductID] = struct{}{}
+	}
+	ids := slices.Collect(maps.Keys(idsMap))
+	slices.Sort(ids)
+	return ids
+}
@@ -113,6 +113,75 @@ func (s *OrderService) producerPwdVersionKafkaEvent(ctx context.Context, eventMo
 	return nil
 }
 
+func (s *OrderService) ProducerCanOrdKafkaEventWorker(ctx context.Context, interval time.Duration, limit int, topic string) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			// Cancel by context
+			case <-ctx.Done():
+				s.ZapLogger.Info("OrderService: Worker send CancelOrder Kafka event stop by context")
+				return
+			// Interval time
+			case <-ticker.C:
+				if err := s.producerCanOrdKafkaEventBatch(ctx, limit, topic); err != nil {
+					s.ZapLogger.Warn("OrderService: error in procedure CanOrdKafkaEvent batch", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+func (s *OrderService) producerCanOrdKafkaEventBatch(ctx context.Context, limit int, topic string) error {
+	// Create context for function
+	ctxEachEvent, cancel := context.WithTimeout(ctx, 9*time.Second)
+	defer cancel()
+
+	// Get models from DB
+	eventsModel, err := s.OrderRepo.GetCancelOrderEventNotPublish(limit)
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, eventModel := range eventsModel {
+		eventKafka := &outbox.CancelOrderKafkaEvent{OrderID: eventModel.OrderID}
+		if err := s.producerCanOrdKafkaEvent(ctxEachEvent, eventKafka, topic); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (s *OrderService) producerCanOrdKafkaEvent(ctx context.Context, eventModel *outbox.CancelOrderKafkaEvent, topic string) error {
+
+	// Parse event model to json
+	eventJson, err := json.Marshal(eventModel)
+	if err != nil {
+		return err
+	}
+
+	// Publish event
+	if err := s.MQProducer.Publish(ctx, &kafka.LeastBytes{}, topic, []byte("key"), eventJson); err != nil {
+		s.ZapLogger.Warn("OrderService: publish to Kafka failure", zap.Error(err))
+		if err2 := s.OrderRepo.UpdateCancelOrderEventStatus(ctx, eventModel.OrderID, "FAILED"); err2 != nil {
+			s.ZapLogger.Warn("OrderService: publish to Kafka failure and can not update OutboxDB")
+			return err2
+		}
+		return err
+	}
+	// Update OutboxDB if procedure successfully
+	if err := s.OrderRepo.UpdateCancelOrderEventStatus(ctx, eventModel.OrderID, "SUCCESS"); err != nil {
+		s.ZapLogger.Warn("OrderService: publish to Kafka success but update to OutboxDB failed")
+		return err
+	}
+
+	s.ZapLogger.Info("OrderService: publish CancelOrder to Kafka success")
+	return nil
+}
+
 //func (s *OrderService) UpdateStoreIDFromKafka(ctx context.Context, msg *kafka.Message) error {
 //
 //	fmt.Println("UpdateStoreIDFromKafka")
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"order-service/internal/client/productclient"
 	"order-service/internal/client/serviceclientmanager"
@@ -13,6 +14,7 @@ import (
 	"order-service/pkg/dto"
 	"order-service/pkg/model"
 	"order-service/pkg/outbox"
+	"product-service/pkg/money"
 
 	"go.uber.org/zap"
 )
@@ -41,6 +43,50 @@ func NewOrderService(repo *repository.OrderRepository, logger *zap.Logger, scm *
 func (s *OrderService) CreateOrder(ctx context.Context, input *dto.CreateOrderInput) (*dto.CreateOrderOutput, error) {
 	orderModel := adapter.OrderDTOToModel(input.Order)
 
+	// Reject archived, deleted or unknown products
+	productIDs := adapter.FilterProductIDsByItems(orderModel.OrderItems)
+	clientProductOutput, err := s.SCM.ProductServiceClient.GetProductsByID(ctx, &productclient.GetProductsByIDInput{
+		IDs: productIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	activeProducts := map[uint64]*productclient.ProductDTOClient{}
+	for _, product := range clientProductOutput.Products {
+		if product.Status == "ACTIVE" {
+			activeProducts[product.ID] = product
+		}
+	}
+	for _, productID := range productIDs {
+		if activeProducts[productID] == nil {
+			s.ZapLogger.Warn("OrderService: product is not available", zap.Uint64("productID", productID))
+			return &dto.CreateOrderOutput{
+				Message: fmt.Sprintf("Product %d is not available", productID),
+				Success: false,
+			}, nil
+		}
+	}
+
+	// Price items from products and snapshot the exchange rates into the order
+	clientRatesOutput, err := s.SCM.ProductServiceClient.GetExchangeRates(ctx, &productclient.GetExchangeRatesInput{})
+	if err != nil {
+		return nil, err
+	}
+	if !clientRatesOutput.Success {
+		s.ZapLogger.Warn("OrderService: exchange rates are not available", zap.String("message", clientRatesOutput.Message))
+		return &dto.CreateOrderOutput{
+			Message: "Exchange rates are not available",
+			Success: false,
+		}, nil
+	}
+	if err := priceOrder(orderModel, activeProducts, clientRatesOutput); err != nil {
+		s.ZapLogger.Warn("OrderService: can not price order", zap.Error(err))
+		return &dto.CreateOrderOutput{
+			Message: err.Error(),
+			Success: false,
+		}, nil
+	}
+
 	// Create OutboxModel
 	items := orderModel.OrderItems
 	var outboxItems []*outbox.ItemEvent
@@ -177,3 +223,45 @@ func (s *OrderService) CancelOrderByID(ctx context.Context, input *dto.CancelOrd
 		Success: true,
 	}, nil
 }
+
+// priceOrder set the price and exchange rate of each item from its product and the total in the order currency.
+// Each line is converted and rounded to the minor unit of the order currency before it is added to the total.
+// An order without currency is placed in the currency of its first item.
+func priceOrder(order *model.Order, products map[uint64]*productclient.ProductDTOClient, rates *productclient.GetExchangeRatesOutput) error {
+	if order.Currency == "" && len(order.OrderItems) > 0 {
+		order.Currency = products[order.OrderItems[0].ProductID].Price.Currency
+	}
+	orderRate, ok := rates.Rates[order.Currency]
+	if !ok {
+		return fmt.Errorf("currency %s is not supported", order.Currency)
+	}
+
+	lines := make([]money.Money, 0, len(order.OrderItems))
+	for _, item := range order.OrderItems {
+		product := products[item.ProductID]
+		productRate, ok := rates.Rates[product.Price.Currency]
+		if !ok {
+			return fmt.Errorf("currency %s of product %d is not supported", product.Price.Currency, product.ID)
+		}
+		item.PriceAmount = product.Price.Amount
+		item.Currency = product.Price.Currency
+		item.ExchangeRate = orderRate / productRate
+
+		line, err := product.Price.Mul(item.Quantity)
+		if err != nil {
+			return fmt.Errorf("price of product %d is too large", product.ID)
+		}
+		if line, err = line.Convert(order.Currency, item.ExchangeRate); err != nil {
+			return fmt.Errorf("price of product %d can not be converted to %s", product.ID, order.Currency)
+		}
+		lines = append(lines, line)
+	}
+	total, err := money.Sum(order.Currency, lines...)
+	if err != nil {
+		return errors.New("total price of order is too large")
+	}
+	order.TotalAmount = total.Amount
+	order.RateBase = rates.Base
+	order.RatesUpdatedAt = rates.UpdatedAt
+	return nil
+}
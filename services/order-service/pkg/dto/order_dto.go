@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"product-service/pkg/money"
 	"time"
 )
 
@@ -8,22 +9,27 @@ type Order struct {
 	ID         uint64
 	BuyerID    uint64
 	Status     string
-	TotalPrice float64
+	TotalPrice money.Money
+	Currency   string // requested currency of the order, empty is the currency of the first item
 	OrderItems []*OrderItem
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
+
+	RateBase       string
+	RatesUpdatedAt time.Time
 }
 
 type OrderItem struct {
-	ID        uint64
-	Name      string
-	OrderID   uint64
-	ProductID uint64
-	Quantity  int64
-	Price     float64
-	Status    string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID           uint64
+	Name         string
+	OrderID      uint64
+	ProductID    uint64
+	Quantity     int64
+	Price        money.Money
+	ExchangeRate float64 // units of order currency for one unit of Price.Currency
+	Status       string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
 }
 
 type CreateOrderInput struct {
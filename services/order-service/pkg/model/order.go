@@ -5,22 +5,29 @@ import (
 )
 
 type Order struct {
-	ID         uint64       `gorm:"primaryKey;AutoIncrement"`
-	BuyerID    uint64       `gorm:"not null;index:order_index"`
-	Status     string       `gorm:"not null;default:'PENDING';index:order_index"` // PENDING, ACTIVE (valid inventory), CANCELED, COMPLETED
-	TotalPrice float64      `gorm:"not null;default:0"`
-	OrderItems []*OrderItem `gorm:"foreignKey:OrderID"` // 1 to many (in SQL, references often in child table)
-	CreatedAt  time.Time    `gorm:"autoCreateTime"`
-	UpdatedAt  time.Time    `gorm:"autoUpdateTime"`
+	ID          uint64       `gorm:"primaryKey;AutoIncrement"`
+	BuyerID     uint64       `gorm:"not null;index:order_index"`
+	Status      string       `gorm:"not null;default:'PENDING';index:order_index"` // PENDING, ACTIVE (valid inventory), CANCELED, COMPLETED
+	TotalAmount int64        `gorm:"not null;default:0"`                           // in minor units of Currency
+	Currency    string       `gorm:"type:char(3);not null;default:'VND'"`          // ISO 4217 code of the total
+	OrderItems  []*OrderItem `gorm:"foreignKey:OrderID"`                           // 1 to many (in SQL, references often in child table)
+	CreatedAt   time.Time    `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time    `gorm:"autoUpdateTime"`
+
+	// Snapshot of the exchange rates the order was placed at
+	RateBase       string    `gorm:"type:char(3);not null;default:''"`
+	RatesUpdatedAt time.Time `gorm:""`
 }
 
 type OrderItem struct {
-	ID        uint64    `gorm:"primaryKey;AutoIncrement"`
-	OrderID   uint64    `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;;index:order_item_index"`
-	ProductID uint64    `gorm:"not null"`
-	Quantity  int64     `gorm:"not null"`
-	Price     float64   `gorm:"not null"`
-	Status    string    `gorm:"not null;default:'ACTIVE';index:order_item_index"` // ACTIVE, CANCELED
-	CreatedAt time.Time `gorm:"autoCreateTime"`
-	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+	ID           uint64    `gorm:"primaryKey;AutoIncrement"`
+	OrderID      uint64    `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;;index:order_item_index"`
+	ProductID    uint64    `gorm:"not null"`
+	Quantity     int64     `gorm:"not null"`
+	PriceAmount  int64     `gorm:"not null;default:0"`                               // unit price in minor units of Currency
+	Currency     string    `gorm:"type:char(3);not null;default:'VND'"`              // ISO 4217 code of the price, the product currency
+	ExchangeRate float64   `gorm:"not null;default:1"`                               // units of order currency for one unit of Currency
+	Status       string    `gorm:"not null;default:'ACTIVE';index:order_item_index"` // ACTIVE, CANCELED
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
 }
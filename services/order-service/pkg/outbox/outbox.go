@@ -7,6 +7,17 @@ type CreateOrderEvent struct {
 	Items   datatypes.JSON
 	Status  string `gorm:"index:idx_co_kafka"`
 }
+
+// CancelOrderEvent asks product-service to give back the inventory reserved by a canceled order
+type CancelOrderEvent struct {
+	OrderID uint64 `gorm:"primary_key"`
+	Status  string `gorm:"index:idx_cancel_order_kafka"`
+}
+
+type CancelOrderKafkaEvent struct {
+	OrderID uint64 `json:"order_id"`
+}
+
 type ItemEvent struct {
 	ProductID uint64 `json:"product_id"`
 	Quantity  int64  `json:"quantity"`
@@ -23,22 +23,78 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type Order struct {
+// Money is an exact amount in minor units of an ISO 4217 currency, e.g. amount 1999 and currency USD is 19.99 USD
+type Money struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	BuyerId       uint64                 `protobuf:"varint,2,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	TotalPrice    float64                `protobuf:"fixed64,4,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
-	OrderItem     []*OrderItem           `protobuf:"bytes,5,rep,name=order_item,json=orderItem,proto3" json:"order_item,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_order_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type Order struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BuyerId        uint64                 `protobuf:"varint,2,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	OrderItem      []*OrderItem           `protobuf:"bytes,5,rep,name=order_item,json=orderItem,proto3" json:"order_item,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Currency       string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"` // ISO 4217, empty is the currency of the first item
+	RateBase       string                 `protobuf:"bytes,9,opt,name=rate_base,json=rateBase,proto3" json:"rate_base,omitempty"`
+	RatesUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=rates_updated_at,json=ratesUpdatedAt,proto3" json:"rates_updated_at,omitempty"`
+	TotalPrice     *Money                 `protobuf:"bytes,11,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *Order) Reset() {
 	*x = Order{}
-	mi := &file_order_proto_msgTypes[0]
+	mi := &file_order_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50,7 +106,7 @@ func (x *Order) String() string {
 func (*Order) ProtoMessage() {}
 
 func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[0]
+	mi := &file_order_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -63,7 +119,7 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Order.ProtoReflect.Descriptor instead.
 func (*Order) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{0}
+	return file_order_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Order) GetId() uint64 {
@@ -87,13 +143,6 @@ func (x *Order) GetStatus() string {
 	return ""
 }
 
-func (x *Order) GetTotalPrice() float64 {
-	if x != nil {
-		return x.TotalPrice
-	}
-	return 0
-}
-
 func (x *Order) GetOrderItem() []*OrderItem {
 	if x != nil {
 		return x.OrderItem
@@ -115,6 +164,34 @@ func (x *Order) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Order) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Order) GetRateBase() string {
+	if x != nil {
+		return x.RateBase
+	}
+	return ""
+}
+
+func (x *Order) GetRatesUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RatesUpdatedAt
+	}
+	return nil
+}
+
+func (x *Order) GetTotalPrice() *Money {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return nil
+}
+
 type OrderItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ID            uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
@@ -122,17 +199,18 @@ type OrderItem struct {
 	OrderId       uint64                 `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	ProductId     uint64                 `protobuf:"varint,4,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Quantity      int64                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
 	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ExchangeRate  float64                `protobuf:"fixed64,11,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"` // units of order currency for one unit of price currency
+	Price         *Money                 `protobuf:"bytes,12,opt,name=price,proto3" json:"price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OrderItem) Reset() {
 	*x = OrderItem{}
-	mi := &file_order_proto_msgTypes[1]
+	mi := &file_order_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -144,7 +222,7 @@ func (x *OrderItem) String() string {
 func (*OrderItem) ProtoMessage() {}
 
 func (x *OrderItem) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[1]
+	mi := &file_order_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -157,7 +235,7 @@ func (x *OrderItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
 func (*OrderItem) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{1}
+	return file_order_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *OrderItem) GetID() uint64 {
@@ -195,13 +273,6 @@ func (x *OrderItem) GetQuantity() int64 {
 	return 0
 }
 
-func (x *OrderItem) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
 func (x *OrderItem) GetStatus() string {
 	if x != nil {
 		return x.Status
@@ -223,6 +294,20 @@ func (x *OrderItem) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *OrderItem) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
+func (x *OrderItem) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
 type CreateOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
@@ -232,7 +317,7 @@ type CreateOrderRequest struct {
 
 func (x *CreateOrderRequest) Reset() {
 	*x = CreateOrderRequest{}
-	mi := &file_order_proto_msgTypes[2]
+	mi := &file_order_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -244,7 +329,7 @@ func (x *CreateOrderRequest) String() string {
 func (*CreateOrderRequest) ProtoMessage() {}
 
 func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[2]
+	mi := &file_order_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -257,7 +342,7 @@ func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
 func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{2}
+	return file_order_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateOrderRequest) GetOrder() *Order {
@@ -277,7 +362,7 @@ type CreateOrderResponse struct {
 
 func (x *CreateOrderResponse) Reset() {
 	*x = CreateOrderResponse{}
-	mi := &file_order_proto_msgTypes[3]
+	mi := &file_order_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -289,7 +374,7 @@ func (x *CreateOrderResponse) String() string {
 func (*CreateOrderResponse) ProtoMessage() {}
 
 func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[3]
+	mi := &file_order_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -302,7 +387,7 @@ func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
 func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{3}
+	return file_order_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateOrderResponse) GetMessage() string {
@@ -328,7 +413,7 @@ type GetOrderByIDRequest struct {
 
 func (x *GetOrderByIDRequest) Reset() {
 	*x = GetOrderByIDRequest{}
-	mi := &file_order_proto_msgTypes[4]
+	mi := &file_order_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -340,7 +425,7 @@ func (x *GetOrderByIDRequest) String() string {
 func (*GetOrderByIDRequest) ProtoMessage() {}
 
 func (x *GetOrderByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[4]
+	mi := &file_order_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -353,7 +438,7 @@ func (x *GetOrderByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderByIDRequest) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{4}
+	return file_order_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetOrderByIDRequest) GetId() uint64 {
@@ -374,7 +459,7 @@ type GetOrderByIDResponse struct {
 
 func (x *GetOrderByIDResponse) Reset() {
 	*x = GetOrderByIDResponse{}
-	mi := &file_order_proto_msgTypes[5]
+	mi := &file_order_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -386,7 +471,7 @@ func (x *GetOrderByIDResponse) String() string {
 func (*GetOrderByIDResponse) ProtoMessage() {}
 
 func (x *GetOrderByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[5]
+	mi := &file_order_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -399,7 +484,7 @@ func (x *GetOrderByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetOrderByIDResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{5}
+	return file_order_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetOrderByIDResponse) GetMessage() string {
@@ -433,7 +518,7 @@ type GetOrdersByBuyerIDStatusRequest struct {
 
 func (x *GetOrdersByBuyerIDStatusRequest) Reset() {
 	*x = GetOrdersByBuyerIDStatusRequest{}
-	mi := &file_order_proto_msgTypes[6]
+	mi := &file_order_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -445,7 +530,7 @@ func (x *GetOrdersByBuyerIDStatusRequest) String() string {
 func (*GetOrdersByBuyerIDStatusRequest) ProtoMessage() {}
 
 func (x *GetOrdersByBuyerIDStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[6]
+	mi := &file_order_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -458,7 +543,7 @@ func (x *GetOrdersByBuyerIDStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrdersByBuyerIDStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetOrdersByBuyerIDStatusRequest) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{6}
+	return file_order_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetOrdersByBuyerIDStatusRequest) GetBuyerId() uint64 {
@@ -486,7 +571,7 @@ type GetOrdersByBuyerIDStatusResponse struct {
 
 func (x *GetOrdersByBuyerIDStatusResponse) Reset() {
 	*x = GetOrdersByBuyerIDStatusResponse{}
-	mi := &file_order_proto_msgTypes[7]
+	mi := &file_order_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -498,7 +583,7 @@ func (x *GetOrdersByBuyerIDStatusResponse) String() string {
 func (*GetOrdersByBuyerIDStatusResponse) ProtoMessage() {}
 
 func (x *GetOrdersByBuyerIDStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[7]
+	mi := &file_order_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -511,7 +596,7 @@ func (x *GetOrdersByBuyerIDStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrdersByBuyerIDStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetOrdersByBuyerIDStatusResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{7}
+	return file_order_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetOrdersByBuyerIDStatusResponse) GetMessage() string {
@@ -544,7 +629,7 @@ type GetOrderItemsByOrderIDRequest struct {
 
 func (x *GetOrderItemsByOrderIDRequest) Reset() {
 	*x = GetOrderItemsByOrderIDRequest{}
-	mi := &file_order_proto_msgTypes[8]
+	mi := &file_order_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -556,7 +641,7 @@ func (x *GetOrderItemsByOrderIDRequest) String() string {
 func (*GetOrderItemsByOrderIDRequest) ProtoMessage() {}
 
 func (x *GetOrderItemsByOrderIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[8]
+	mi := &file_order_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -569,7 +654,7 @@ func (x *GetOrderItemsByOrderIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderItemsByOrderIDRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderItemsByOrderIDRequest) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{8}
+	return file_order_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetOrderItemsByOrderIDRequest) GetOrderId() uint64 {
@@ -590,7 +675,7 @@ type GetOrderItemsByOrderIDResponse struct {
 
 func (x *GetOrderItemsByOrderIDResponse) Reset() {
 	*x = GetOrderItemsByOrderIDResponse{}
-	mi := &file_order_proto_msgTypes[9]
+	mi := &file_order_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -602,7 +687,7 @@ func (x *GetOrderItemsByOrderIDResponse) String() string {
 func (*GetOrderItemsByOrderIDResponse) ProtoMessage() {}
 
 func (x *GetOrderItemsByOrderIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[9]
+	mi := &file_order_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -615,7 +700,7 @@ func (x *GetOrderItemsByOrderIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderItemsByOrderIDResponse.ProtoReflect.Descriptor instead.
 func (*GetOrderItemsByOrderIDResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{9}
+	return file_order_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetOrderItemsByOrderIDResponse) GetMessage() string {
@@ -648,7 +733,7 @@ type UpdateOrderByIDRequest struct {
 
 func (x *UpdateOrderByIDRequest) Reset() {
 	*x = UpdateOrderByIDRequest{}
-	mi := &file_order_proto_msgTypes[10]
+	mi := &file_order_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -660,7 +745,7 @@ func (x *UpdateOrderByIDRequest) String() string {
 func (*UpdateOrderByIDRequest) ProtoMessage() {}
 
 func (x *UpdateOrderByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[10]
+	mi := &file_order_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,7 +758,7 @@ func (x *UpdateOrderByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOrderByIDRequest.ProtoReflect.Descriptor instead.
 func (*UpdateOrderByIDRequest) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{10}
+	return file_order_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateOrderByIDRequest) GetOrder() *Order {
@@ -693,7 +778,7 @@ type UpdateOrderByIDResponse struct {
 
 func (x *UpdateOrderByIDResponse) Reset() {
 	*x = UpdateOrderByIDResponse{}
-	mi := &file_order_proto_msgTypes[11]
+	mi := &file_order_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -705,7 +790,7 @@ func (x *UpdateOrderByIDResponse) String() string {
 func (*UpdateOrderByIDResponse) ProtoMessage() {}
 
 func (x *UpdateOrderByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[11]
+	mi := &file_order_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,7 +803,7 @@ func (x *UpdateOrderByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOrderByIDResponse.ProtoReflect.Descriptor instead.
 func (*UpdateOrderByIDResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{11}
+	return file_order_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateOrderByIDResponse) GetMassage() string {
@@ -744,7 +829,7 @@ type CancelOrderByIDRequest struct {
 
 func (x *CancelOrderByIDRequest) Reset() {
 	*x = CancelOrderByIDRequest{}
-	mi := &file_order_proto_msgTypes[12]
+	mi := &file_order_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -756,7 +841,7 @@ func (x *CancelOrderByIDRequest) String() string {
 func (*CancelOrderByIDRequest) ProtoMessage() {}
 
 func (x *CancelOrderByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[12]
+	mi := &file_order_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -769,7 +854,7 @@ func (x *CancelOrderByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelOrderByIDRequest.ProtoReflect.Descriptor instead.
 func (*CancelOrderByIDRequest) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{12}
+	return file_order_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CancelOrderByIDRequest) GetId() uint64 {
@@ -789,7 +874,7 @@ type CancelOrderByIDResponse struct {
 
 func (x *CancelOrderByIDResponse) Reset() {
 	*x = CancelOrderByIDResponse{}
-	mi := &file_order_proto_msgTypes[13]
+	mi := &file_order_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -801,7 +886,7 @@ func (x *CancelOrderByIDResponse) String() string {
 func (*CancelOrderByIDResponse) ProtoMessage() {}
 
 func (x *CancelOrderByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[13]
+	mi := &file_order_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,7 +899,7 @@ func (x *CancelOrderByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelOrderByIDResponse.ProtoReflect.Descriptor instead.
 func (*CancelOrderByIDResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{13}
+	return file_order_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CancelOrderByIDResponse) GetMessage() string {
@@ -835,32 +920,41 @@ var File_order_proto protoreflect.FileDescriptor
 
 const file_order_proto_rawDesc = "" +
 	"\n" +
-	"\vorder.proto\x12\x14order_service.pkg.pb\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd3\x02\n" +
+	"\vorder.proto\x12\x14order_service.pkg.pb\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"Z\n" +
+	"\x05Money\x12\x1f\n" +
+	"\x06amount\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x06amount\x120\n" +
+	"\bcurrency\x18\x02 \x01(\tB\x14\xbaH\x11r\x0f2\r^([A-Z]{3})?$R\bcurrency\"\x8b\x04\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
 	"\bbuyer_id\x18\x02 \x01(\x04R\abuyerId\x12H\n" +
-	"\x06status\x18\x03 \x01(\tB0\xbaH-r+R\aPENDINGR\x06FAILEDR\aSUCCESSR\x05VALIDR\bCANCELEDR\x06status\x12\x1f\n" +
-	"\vtotal_price\x18\x04 \x01(\x01R\n" +
-	"totalPrice\x12>\n" +
+	"\x06status\x18\x03 \x01(\tB0\xbaH-r+R\aPENDINGR\x06FAILEDR\aSUCCESSR\x05VALIDR\bCANCELEDR\x06status\x12>\n" +
 	"\n" +
 	"order_item\x18\x05 \x03(\v2\x1f.order_service.pkg.pb.OrderItemR\torderItem\x129\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa9\x02\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x120\n" +
+	"\bcurrency\x18\b \x01(\tB\x14\xbaH\x11r\x0f2\r^([A-Z]{3})?$R\bcurrency\x12\x1b\n" +
+	"\trate_base\x18\t \x01(\tR\brateBase\x12D\n" +
+	"\x10rates_updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0eratesUpdatedAt\x12<\n" +
+	"\vtotal_price\x18\v \x01(\v2\x1b.order_service.pkg.pb.MoneyR\n" +
+	"totalPriceJ\x04\b\x04\x10\x05\"\xf7\x02\n" +
 	"\tOrderItem\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
 	"\border_id\x18\x03 \x01(\x04R\aorderId\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x04 \x01(\x04R\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x05 \x01(\x03R\bquantity\x12\x14\n" +
-	"\x05price\x18\x06 \x01(\x01R\x05price\x12\x16\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\x12\x16\n" +
 	"\x06status\x18\a \x01(\tR\x06status\x129\n" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"G\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12#\n" +
+	"\rexchange_rate\x18\v \x01(\x01R\fexchangeRate\x121\n" +
+	"\x05price\x18\f \x01(\v2\x1b.order_service.pkg.pb.MoneyR\x05priceJ\x04\b\x06\x10\aJ\x04\b\n" +
+	"\x10\v\"G\n" +
 	"\x12CreateOrderRequest\x121\n" +
 	"\x05order\x18\x01 \x01(\v2\x1b.order_service.pkg.pb.OrderR\x05order\"I\n" +
 	"\x13CreateOrderResponse\x12\x18\n" +
@@ -916,52 +1010,56 @@ func file_order_proto_rawDescGZIP() []byte {
 	return file_order_proto_rawDescData
 }
 
-var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_order_proto_goTypes = []any{
-	(*Order)(nil),                            // 0: order_service.pkg.pb.Order
-	(*OrderItem)(nil),                        // 1: order_service.pkg.pb.OrderItem
-	(*CreateOrderRequest)(nil),               // 2: order_service.pkg.pb.CreateOrderRequest
-	(*CreateOrderResponse)(nil),              // 3: order_service.pkg.pb.CreateOrderResponse
-	(*GetOrderByIDRequest)(nil),              // 4: order_service.pkg.pb.GetOrderByIDRequest
-	(*GetOrderByIDResponse)(nil),             // 5: order_service.pkg.pb.GetOrderByIDResponse
-	(*GetOrdersByBuyerIDStatusRequest)(nil),  // 6: order_service.pkg.pb.GetOrdersByBuyerIDStatusRequest
-	(*GetOrdersByBuyerIDStatusResponse)(nil), // 7: order_service.pkg.pb.GetOrdersByBuyerIDStatusResponse
-	(*GetOrderItemsByOrderIDRequest)(nil),    // 8: order_service.pkg.pb.GetOrderItemsByOrderIDRequest
-	(*GetOrderItemsByOrderIDResponse)(nil),   // 9: order_service.pkg.pb.GetOrderItemsByOrderIDResponse
-	(*UpdateOrderByIDRequest)(nil),           // 10: order_service.pkg.pb.UpdateOrderByIDRequest
-	(*UpdateOrderByIDResponse)(nil),          // 11: order_service.pkg.pb.UpdateOrderByIDResponse
-	(*CancelOrderByIDRequest)(nil),           // 12: order_service.pkg.pb.CancelOrderByIDRequest
-	(*CancelOrderByIDResponse)(nil),          // 13: order_service.pkg.pb.CancelOrderByIDResponse
-	(*timestamppb.Timestamp)(nil),            // 14: google.protobuf.Timestamp
+	(*Money)(nil),                            // 0: order_service.pkg.pb.Money
+	(*Order)(nil),                            // 1: order_service.pkg.pb.Order
+	(*OrderItem)(nil),                        // 2: order_service.pkg.pb.OrderItem
+	(*CreateOrderRequest)(nil),               // 3: order_service.pkg.pb.CreateOrderRequest
+	(*CreateOrderResponse)(nil),              // 4: order_service.pkg.pb.CreateOrderResponse
+	(*GetOrderByIDRequest)(nil),              // 5: order_service.pkg.pb.GetOrderByIDRequest
+	(*GetOrderByIDResponse)(nil),             // 6: order_service.pkg.pb.GetOrderByIDResponse
+	(*GetOrdersByBuyerIDStatusRequest)(nil),  // 7: order_service.pkg.pb.GetOrdersByBuyerIDStatusRequest
+	(*GetOrdersByBuyerIDStatusResponse)(nil), // 8: order_service.pkg.pb.GetOrdersByBuyerIDStatusResponse
+	(*GetOrderItemsByOrderIDRequest)(nil),    // 9: order_service.pkg.pb.GetOrderItemsByOrderIDRequest
+	(*GetOrderItemsByOrderIDResponse)(nil),   // 10: order_service.pkg.pb.GetOrderItemsByOrderIDResponse
+	(*UpdateOrderByIDRequest)(nil),           // 11: order_service.pkg.pb.UpdateOrderByIDRequest
+	(*UpdateOrderByIDResponse)(nil),          // 12: order_service.pkg.pb.UpdateOrderByIDResponse
+	(*CancelOrderByIDRequest)(nil),           // 13: order_service.pkg.pb.CancelOrderByIDRequest
+	(*CancelOrderByIDResponse)(nil),          // 14: order_service.pkg.pb.CancelOrderByIDResponse
+	(*timestamppb.Timestamp)(nil),            // 15: google.protobuf.Timestamp
 }
 var file_order_proto_depIdxs = []int32{
-	1,  // 0: order_service.pkg.pb.Order.order_item:type_name -> order_service.pkg.pb.OrderItem
-	14, // 1: order_service.pkg.pb.Order.created_at:type_name -> google.protobuf.Timestamp
-	14, // 2: order_service.pkg.pb.Order.updated_at:type_name -> google.protobuf.Timestamp
-	14, // 3: order_service.pkg.pb.OrderItem.created_at:type_name -> google.protobuf.Timestamp
-	14, // 4: order_service.pkg.pb.OrderItem.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 5: order_service.pkg.pb.CreateOrderRequest.order:type_name -> order_service.pkg.pb.Order
-	0,  // 6: order_service.pkg.pb.GetOrderByIDResponse.order:type_name -> order_service.pkg.pb.Order
-	0,  // 7: order_service.pkg.pb.GetOrdersByBuyerIDStatusResponse.order:type_name -> order_service.pkg.pb.Order
-	1,  // 8: order_service.pkg.pb.GetOrderItemsByOrderIDResponse.order_item:type_name -> order_service.pkg.pb.OrderItem
-	0,  // 9: order_service.pkg.pb.UpdateOrderByIDRequest.order:type_name -> order_service.pkg.pb.Order
-	2,  // 10: order_service.pkg.pb.OrderService.CreateOrder:input_type -> order_service.pkg.pb.CreateOrderRequest
-	4,  // 11: order_service.pkg.pb.OrderService.GetOrderByID:input_type -> order_service.pkg.pb.GetOrderByIDRequest
-	6,  // 12: order_service.pkg.pb.OrderService.GetOrdersByBuyerIDStatus:input_type -> order_service.pkg.pb.GetOrdersByBuyerIDStatusRequest
-	8,  // 13: order_service.pkg.pb.OrderService.GetOrderItemsByOrderID:input_type -> order_service.pkg.pb.GetOrderItemsByOrderIDRequest
-	10, // 14: order_service.pkg.pb.OrderService.UpdateOrderByID:input_type -> order_service.pkg.pb.UpdateOrderByIDRequest
-	12, // 15: order_service.pkg.pb.OrderService.CancelOrderByID:input_type -> order_service.pkg.pb.CancelOrderByIDRequest
-	3,  // 16: order_service.pkg.pb.OrderService.CreateOrder:output_type -> order_service.pkg.pb.CreateOrderResponse
-	5,  // 17: order_service.pkg.pb.OrderService.GetOrderByID:output_type -> order_service.pkg.pb.GetOrderByIDResponse
-	7,  // 18: order_service.pkg.pb.OrderService.GetOrdersByBuyerIDStatus:output_type -> order_service.pkg.pb.GetOrdersByBuyerIDStatusResponse
-	9,  // 19: order_service.pkg.pb.OrderService.GetOrderItemsByOrderID:output_type -> order_service.pkg.pb.GetOrderItemsByOrderIDResponse
-	11, // 20: order_service.pkg.pb.OrderService.UpdateOrderByID:output_type -> order_service.pkg.pb.UpdateOrderByIDResponse
-	13, // 21: order_service.pkg.pb.OrderService.CancelOrderByID:output_type -> order_service.pkg.pb.CancelOrderByIDResponse
-	16, // [16:22] is the sub-list for method output_type
-	10, // [10:16] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	2,  // 0: order_service.pkg.pb.Order.order_item:type_name -> order_service.pkg.pb.OrderItem
+	15, // 1: order_service.pkg.pb.Order.created_at:type_name -> google.protobuf.Timestamp
+	15, // 2: order_service.pkg.pb.Order.updated_at:type_name -> google.protobuf.Timestamp
+	15, // 3: order_service.pkg.pb.Order.rates_updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: order_service.pkg.pb.Order.total_price:type_name -> order_service.pkg.pb.Money
+	15, // 5: order_service.pkg.pb.OrderItem.created_at:type_name -> google.protobuf.Timestamp
+	15, // 6: order_service.pkg.pb.OrderItem.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: order_service.pkg.pb.OrderItem.price:type_name -> order_service.pkg.pb.Money
+	1,  // 8: order_service.pkg.pb.CreateOrderRequest.order:type_name -> order_service.pkg.pb.Order
+	1,  // 9: order_service.pkg.pb.GetOrderByIDResponse.order:type_name -> order_service.pkg.pb.Order
+	1,  // 10: order_service.pkg.pb.GetOrdersByBuyerIDStatusResponse.order:type_name -> order_service.pkg.pb.Order
+	2,  // 11: order_service.pkg.pb.GetOrderItemsByOrderIDResponse.order_item:type_name -> order_service.pkg.pb.OrderItem
+	1,  // 12: order_service.pkg.pb.UpdateOrderByIDRequest.order:type_name -> order_service.pkg.pb.Order
+	3,  // 13: order_service.pkg.pb.OrderService.CreateOrder:input_type -> order_service.pkg.pb.CreateOrderRequest
+	5,  // 14: order_service.pkg.pb.OrderService.GetOrderByID:input_type -> order_service.pkg.pb.GetOrderByIDRequest
+	7,  // 15: order_service.pkg.pb.OrderService.GetOrdersByBuyerIDStatus:input_type -> order_service.pkg.pb.GetOrdersByBuyerIDStatusRequest
+	9,  // 16: order_service.pkg.pb.OrderService.GetOrderItemsByOrderID:input_type -> order_service.pkg.pb.GetOrderItemsByOrderIDRequest
+	11, // 17: order_service.pkg.pb.OrderService.UpdateOrderByID:input_type -> order_service.pkg.pb.UpdateOrderByIDRequest
+	13, // 18: order_service.pkg.pb.OrderService.CancelOrderByID:input_type -> order_service.pkg.pb.CancelOrderByIDRequest
+	4,  // 19: order_service.pkg.pb.OrderService.CreateOrder:output_type -> order_service.pkg.pb.CreateOrderResponse
+	6,  // 20: order_service.pkg.pb.OrderService.GetOrderByID:output_type -> order_service.pkg.pb.GetOrderByIDResponse
+	8,  // 21: order_service.pkg.pb.OrderService.GetOrdersByBuyerIDStatus:output_type -> order_service.pkg.pb.GetOrdersByBuyerIDStatusResponse
+	10, // 22: order_service.pkg.pb.OrderService.GetOrderItemsByOrderID:output_type -> order_service.pkg.pb.GetOrderItemsByOrderIDResponse
+	12, // 23: order_service.pkg.pb.OrderService.UpdateOrderByID:output_type -> order_service.pkg.pb.UpdateOrderByIDResponse
+	14, // 24: order_service.pkg.pb.OrderService.CancelOrderByID:output_type -> order_service.pkg.pb.CancelOrderByIDResponse
+	19, // [19:25] is the sub-list for method output_type
+	13, // [13:19] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_order_proto_init() }
@@ -975,7 +1073,7 @@ func file_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_proto_rawDesc), len(file_order_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
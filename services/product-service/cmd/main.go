@@ -5,10 +5,16 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"net/http"
+	"os"
+	"product-service/internal/client"
+	"product-service/internal/client/authclient"
 	"product-service/internal/config"
 	"product-service/internal/repository"
 	"product-service/internal/server"
 	"product-service/internal/service"
+	"product-service/internal/service/exchangerate"
+	"product-service/internal/service/search"
 	productpb "product-service/pkg/pb"
 	"time"
 
@@ -26,7 +32,7 @@ func main() {
 		log.Fatal("Error NewServiceConfig", err.Error())
 	}
 
-	_, err = config.NewEnvConfig()
+	envConfig, err := config.NewEnvConfig()
 	if err != nil {
 		log.Fatal("Error NewEnvConfig", err.Error())
 	}
@@ -42,7 +48,40 @@ func main() {
 	defer serviceConfig.KafkaInstance.KafkaManager.CloseReaderAll()
 
 	productRepo := repository.NewProductRepository(serviceConfig.PostgresDB)
-	productService := service.NewProductService(productRepo, serviceConfig.ZapLogger, serviceConfig.KafkaInstance.KafkaProducer, serviceConfig.KafkaInstance.KafkaConsumer, serviceConfig.KafkaInstance.KafkaClient)
+	productCache := repository.NewProductCache(serviceConfig.RedisClient, envConfig.ProductCacheTTL, envConfig.ProductCacheEnabled)
+	// Create gRPC clients, connected lazily
+	grpcClientManager := client.NewClientManager()
+	defer grpcClientManager.CloseAll()
+	authClient := authclient.NewAuthClient(nil, grpcClientManager, serviceConfig.ZapLogger)
+
+	relatedCache := repository.NewRelatedProductCache(serviceConfig.RedisClient, 3*time.Hour)
+	rateSource := exchangerate.NewStaticFileSource(envConfig.ExchangeRatesFile)
+	searchIndex, err := search.New(&search.Config{
+		Backend:          envConfig.SearchBackend,
+		ElasticsearchURL: envConfig.ElasticsearchURL,
+		IndexName:        envConfig.SearchIndexName,
+	})
+	if err != nil {
+		log.Fatalf("Can not create search index: %v", err)
+	}
+	productService := service.NewProductService(productRepo, productCache, relatedCache, authClient, rateSource, searchIndex, serviceConfig.ZapLogger, serviceConfig.KafkaInstance.KafkaProducer, serviceConfig.KafkaInstance.KafkaConsumer, serviceConfig.KafkaInstance.KafkaClient)
+
+	// "product-service reindex" rebuild the search index from DB and exit
+	if len(os.Args) > 1 && os.Args[1] == "reindex" {
+		if err := productService.ReindexProducts(context.Background()); err != nil {
+			log.Fatalf("Can not reindex products: %v", err)
+		}
+		return
+	}
+
+	// Expose expvar metrics (product cache hits and misses) at /debug/vars
+	if metricsAddr := os.Getenv("METRICS_ADDR"); metricsAddr != "" {
+		go func() {
+			if err := http.ListenAndServe(metricsAddr, nil); err != nil {
+				log.Printf("Metrics server stopped with error: %v", err)
+			}
+		}()
+	}
 
 	// Run consumer in goroutine
 	ctx := context.Context(context.Background())
@@ -52,6 +91,33 @@ func main() {
 			log.Printf("Consumer stopped with error: %v", err)
 		}
 	}()
+	topicCancel := "order.cancel_order"
+	go func() {
+		if err := serviceConfig.KafkaInstance.KafkaConsumer.Consume(ctx, topicCancel, "product-service-group", productService.CancelOrderInventory); err != nil {
+			log.Printf("Consumer stopped with error: %v", err)
+		}
+	}()
+	topicReturn := "order.return_order"
+	go func() {
+		if err := serviceConfig.KafkaInstance.KafkaConsumer.Consume(ctx, topicReturn, "product-service-group", productService.ReturnOrderInventory); err != nil {
+			log.Printf("Consumer stopped with error: %v", err)
+		}
+	}()
+
+	// Keep search index in sync from product events, the in-memory index starts empty so it is filled from DB first
+	if searchIndex.Name() == search.BackendMemory {
+		if err := productService.ReindexProducts(ctx); err != nil {
+			log.Printf("Can not fill search index: %v", err)
+		}
+	}
+	indexerGroupID := search.IndexerGroupID(searchIndex)
+	for _, topicProductEvent := range []string{"product.created", "product.updated", "product.deleted"} {
+		go func() {
+			if err := serviceConfig.KafkaInstance.KafkaConsumer.Consume(ctx, topicProductEvent, indexerGroupID, productService.IndexProductEvent); err != nil {
+				log.Printf("Consumer stopped with error: %v", err)
+			}
+		}()
+	}
 
 	// Run producer in goroutine
 	topic1 := "product.validate_order"
@@ -63,6 +129,44 @@ func main() {
 	ctx1 := context.Context(context.Background())
 	productService.ProducerValOrdKafkaEventWorker(ctx1, 10*time.Second, 100, topic1)
 
+	// Run stock level producer in goroutine
+	for _, topicStockLevel := range []string{"product.low_stock", "product.out_of_stock"} {
+		connStockLevel, err := kafka.DialLeader(context.Background(), "tcp", "broker1:9092", topicStockLevel, 0)
+		if err != nil {
+			panic(err)
+		}
+		defer connStockLevel.Close()
+	}
+	productService.ProducerStoLevKafkaEventWorker(ctx1, 5*time.Second, 100)
+
+	// Run product event producer in goroutine
+	for _, topicProductEvent := range []string{"product.created", "product.updated", "product.deleted"} {
+		connProductEvent, err := kafka.DialLeader(context.Background(), "tcp", "broker1:9092", topicProductEvent, 0)
+		if err != nil {
+			panic(err)
+		}
+		defer connProductEvent.Close()
+	}
+	productService.ProducerProEveKafkaEventWorker(ctx1, 5*time.Second, 100)
+
+	// Run question answered producer in goroutine
+	topicQuestionAnswered := "product.question_answered"
+	connQuestionAnswered, err := kafka.DialLeader(context.Background(), "tcp", "broker1:9092", topicQuestionAnswered, 0)
+	if err != nil {
+		panic(err)
+	}
+	defer connQuestionAnswered.Close()
+	productService.ProducerQueAnsKafkaEventWorker(ctx1, 5*time.Second, 100)
+
+	// Run import worker in goroutine
+	productService.ImportJobWorker(ctx1, 5*time.Second, 10)
+
+	// Load exchange rates and refresh them in goroutine
+	productService.ExchangeRateWorker(ctx1, envConfig.ExchangeRatesInterval)
+
+	// Run related products batch in goroutine, from orders of the last 90 days
+	productService.RelatedProductsWorker(ctx1, time.Hour, 90*24*time.Hour)
+
 	lis, err := net.Listen("tcp", ":50053")
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
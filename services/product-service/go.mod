@@ -14,6 +14,7 @@ require (
 	gorm.io/datatypes v1.2.6
 	gorm.io/driver/postgres v1.5.0
 	gorm.io/gorm v1.30.0
+	product-service/pkg/money v0.0.0
 )
 
 require (
@@ -45,3 +46,5 @@ require (
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250707201910-8d1bb00bc6a7 // indirect
 	gorm.io/driver/mysql v1.5.6 // indirect
 )
+
+replace product-service/pkg/money => ./pkg/money
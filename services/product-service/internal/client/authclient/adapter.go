@@ -1 +1,31 @@
 package authclient
+
+import authpb "product-service/pkg/client/authclient"
+
+func GetStoreIDRoleByIDInputToRequest(input *GetStoreIDRoleByIDInput) *authpb.GetStoreIDRoleByIDRequest {
+	return &authpb.GetStoreIDRoleByIDRequest{
+		ID: input.ID,
+	}
+}
+func GetStoreIDRoleByIDResponseToOutput(res *authpb.GetStoreIDRoleByIDResponse) *GetStoreIDRoleByIDOutput {
+	return &GetStoreIDRoleByIDOutput{
+		Message:     "Get StoreID, Role successfully",
+		Success:     true,
+		Role:        res.GetRole(),
+		StoreID:     res.GetStoreId(),
+		Permissions: res.GetPermissions(),
+	}
+}
+
+func GetStoreAccountIDsInputToRequest(input *GetStoreAccountIDsInput) *authpb.GetStoreAccountIDsRequest {
+	return &authpb.GetStoreAccountIDsRequest{
+		StoreId: input.StoreID,
+	}
+}
+func GetStoreAccountIDsResponseToOutput(res *authpb.GetStoreAccountIDsResponse) *GetStoreAccountIDsOutput {
+	return &GetStoreAccountIDsOutput{
+		Message: "Get account IDs of store successfully",
+		Success: true,
+		UserIDs: res.GetUserIds(),
+	}
+}
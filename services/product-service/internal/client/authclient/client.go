@@ -1 +1,61 @@
 package authclient
+
+import (
+	"context"
+	"product-service/internal/client"
+	authpb "product-service/pkg/client/authclient"
+
+	"go.uber.org/zap"
+)
+
+type AuthClient struct {
+	Client        authpb.AuthServiceClient
+	ClientManager *client.ClientManager
+	ZapLogger     *zap.Logger
+}
+
+func NewAuthClient(client authpb.AuthServiceClient, cm *client.ClientManager, logger *zap.Logger) *AuthClient {
+	return &AuthClient{
+		Client:        client,
+		ClientManager: cm,
+		ZapLogger:     logger,
+	}
+}
+
+func (a *AuthClient) GetStoreIDRoleByID(ctx context.Context, input *GetStoreIDRoleByIDInput) (*GetStoreIDRoleByIDOutput, error) {
+	if a.Client == nil {
+		authClient, err := a.ClientManager.GetOrCreateAuthClient()
+		if err != nil {
+			a.ZapLogger.Error("AuthClient: AuthClient is nil and create failed", zap.Error(err))
+			return nil, err
+		}
+		a.ZapLogger.Info("AuthClient: AuthClient is nil and create success")
+		a.Client = authClient
+	}
+
+	res, err := a.Client.GetStoreIDRoleById(ctx, GetStoreIDRoleByIDInputToRequest(input))
+	if err != nil {
+		a.ZapLogger.Error("AuthClient: GetStoreIDRoleByID error", zap.Error(err))
+		return nil, err
+	}
+	return GetStoreIDRoleByIDResponseToOutput(res), nil
+}
+
+func (a *AuthClient) GetStoreAccountIDs(ctx context.Context, input *GetStoreAccountIDsInput) (*GetStoreAccountIDsOutput, error) {
+	if a.Client == nil {
+		authClient, err := a.ClientManager.GetOrCreateAuthClient()
+		if err != nil {
+			a.ZapLogger.Error("AuthClient: AuthClient is nil and create failed", zap.Error(err))
+			return nil, err
+		}
+		a.ZapLogger.Info("AuthClient: AuthClient is nil and create success")
+		a.Client = authClient
+	}
+
+	res, err := a.Client.GetStoreAccountIDs(ctx, GetStoreAccountIDsInputToRequest(input))
+	if err != nil {
+		a.ZapLogger.Error("AuthClient: GetStoreAccountIDs error", zap.Error(err))
+		return nil, err
+	}
+	return GetStoreAccountIDsResponseToOutput(res), nil
+}
@@ -1 +1,21 @@
 package authclient
+
+type GetStoreIDRoleByIDInput struct {
+	ID uint64
+}
+type GetStoreIDRoleByIDOutput struct {
+	Message     string
+	StoreID     uint64
+	Role        string
+	Permissions []string // permissions of Role in the store
+	Success     bool
+}
+
+type GetStoreAccountIDsInput struct {
+	StoreID uint64
+}
+type GetStoreAccountIDsOutput struct {
+	Message string
+	UserIDs []uint64 // accounts of the store, staff and admins
+	Success bool
+}
@@ -11,6 +11,16 @@ import (
 type EnvConfig struct {
 	JWTSecret     string
 	JWTExpireTime time.Duration
+
+	ProductCacheEnabled bool
+	ProductCacheTTL     time.Duration
+
+	ExchangeRatesFile     string
+	ExchangeRatesInterval time.Duration
+
+	SearchBackend    string
+	ElasticsearchURL string
+	SearchIndexName  string
 }
 
 // InitJWTSecret load env about jwt
@@ -36,6 +46,60 @@ func InitJWTExpireTime() (time.Duration, error) {
 	return jwtExpireTime, nil
 }
 
+// InitProductCache load env about product cache, the cache is enabled by default
+func InitProductCache() (bool, time.Duration) {
+	enabled := true
+	if enabledStr := os.Getenv("PRODUCT_CACHE_ENABLED"); enabledStr != "" {
+		parsed, err := strconv.ParseBool(enabledStr)
+		if err != nil {
+			fmt.Println("PRODUCT_CACHE_ENABLED env variable not valid, using default true")
+		} else {
+			enabled = parsed
+		}
+	}
+
+	ttlSecond := GetEnvIntWithDefault("PRODUCT_CACHE_TTL_SECONDS", 300)
+	if ttlSecond <= 0 {
+		fmt.Println("PRODUCT_CACHE_TTL_SECONDS env variable not valid, using default 300 seconds")
+		ttlSecond = 300
+	}
+
+	return enabled, time.Duration(ttlSecond) * time.Second
+}
+
+// InitExchangeRates load env about the static exchange rates file and how often it is read
+func InitExchangeRates() (string, time.Duration) {
+	path := os.Getenv("EXCHANGE_RATES_FILE")
+	if path == "" {
+		path = "exchange_rates.json"
+	}
+
+	intervalMinute := GetEnvIntWithDefault("EXCHANGE_RATES_REFRESH_MINUTES", 60)
+	if intervalMinute <= 0 {
+		fmt.Println("EXCHANGE_RATES_REFRESH_MINUTES env variable not valid, using default 60 minutes")
+		intervalMinute = 60
+	}
+
+	return path, time.Duration(intervalMinute) * time.Minute
+}
+
+// InitSearch load env about the search backend, products are searched in memory by default
+func InitSearch() (string, string, string) {
+	backend := os.Getenv("SEARCH_BACKEND")
+	if backend == "" {
+		backend = "memory"
+	}
+	url := os.Getenv("ELASTICSEARCH_URL")
+	if url == "" {
+		url = "http://elasticsearch:9200"
+	}
+	indexName := os.Getenv("SEARCH_INDEX_NAME")
+	if indexName == "" {
+		indexName = "products"
+	}
+	return backend, url, indexName
+}
+
 // NewEnvConfig load env config
 func NewEnvConfig() (*EnvConfig, error) {
 	jwtSecret, err := InitJWTSecret()
@@ -48,8 +112,22 @@ func NewEnvConfig() (*EnvConfig, error) {
 		return nil, err
 	}
 
+	productCacheEnabled, productCacheTTL := InitProductCache()
+	exchangeRatesFile, exchangeRatesInterval := InitExchangeRates()
+	searchBackend, elasticsearchURL, searchIndexName := InitSearch()
+
 	return &EnvConfig{
 		JWTSecret:     jwtSecret,
 		JWTExpireTime: jwtExpireTime,
+
+		ProductCacheEnabled: productCacheEnabled,
+		ProductCacheTTL:     productCacheTTL,
+
+		ExchangeRatesFile:     exchangeRatesFile,
+		ExchangeRatesInterval: exchangeRatesInterval,
+
+		SearchBackend:    searchBackend,
+		ElasticsearchURL: elasticsearchURL,
+		SearchIndexName:  searchIndexName,
 	}, nil
 }
@@ -0,0 +1,37 @@
+package config
+
+import (
+	"product-service/pkg/model"
+	"product-service/pkg/money"
+
+	"gorm.io/gorm"
+)
+
+// minorUnitsSQL is the factor from major to minor units of the currency column
+const minorUnitsSQL = `CASE WHEN currency IN @zero THEN 1 WHEN currency IN @three THEN 1000 ELSE 100 END`
+
+// migratePriceColumn move prices of the old float price column to minor units in price_amount and drop it.
+// It runs after AutoMigrate added price_amount and does nothing once the old column is gone.
+func migratePriceColumn(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&model.Product{}, "price") {
+		return nil
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`UPDATE products SET price_amount = ROUND(price * `+minorUnitsSQL+`)`, map[string]interface{}{
+			"zero":  money.CurrenciesWithExponent(0),
+			"three": money.CurrenciesWithExponent(3),
+		}).Error; err != nil {
+			return err
+		}
+		return tx.Migrator().DropColumn(&model.Product{}, "price")
+	})
+}
+
+// dropPriceIndex drop the listing index on price_amount alone, replaced by idx_products_status_currency_price
+// since price sorts order by currency first
+func dropPriceIndex(db *gorm.DB) error {
+	if !db.Migrator().HasIndex(&model.Product{}, "idx_products_status_price") {
+		return nil
+	}
+	return db.Migrator().DropIndex(&model.Product{}, "idx_products_status_price")
+}
@@ -92,7 +92,14 @@ func initPostgresDB() (*gorm.DB, error) {
 		return nil, err
 	}
 
-	db.AutoMigrate(&model.Product{}, &outbox.ValidateOrderEvent{})
+	db.AutoMigrate(&model.Product{}, &model.Category{}, &model.AttributeDefinition{}, &model.ImportJob{}, &model.InventoryMovement{},
+		&model.ProductQuestion{}, &model.ProductAnswer{}, &model.QAFlag{}, &model.ExchangeRate{}, &outbox.ValidateOrderEvent{}, &outbox.StockLevelEvent{}, &outbox.ProductEvent{}, &outbox.QuestionAnsweredEvent{})
+	if err := migratePriceColumn(db); err != nil {
+		return nil, err
+	}
+	if err := dropPriceIndex(db); err != nil {
+		return nil, err
+	}
 
 	return db, nil
 }
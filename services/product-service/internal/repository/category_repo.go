@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"product-service/pkg/model"
+
+	"gorm.io/gorm"
+)
+
+// ErrCategoryInUse is returned when a category that still has products is deleted
+var ErrCategoryInUse = errors.New("category has products")
+
+// ErrCategoryNameExists is returned when another category already has the name
+var ErrCategoryNameExists = errors.New("category name already exists")
+
+// checkCategoryName return ErrCategoryNameExists when a category other than categoryID has the name, in tx
+func checkCategoryName(tx *gorm.DB, categoryID uint64, name string) error {
+	var count int64
+	if err := tx.Model(&model.Category{}).Where("name = ? AND id <> ?", name, categoryID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrCategoryNameExists
+	}
+	return nil
+}
+
+// CreateCategory create category with its attribute definitions
+func (r *ProductRepository) CreateCategory(ctx context.Context, category *model.Category) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkCategoryName(tx, 0, category.Name); err != nil {
+			return err
+		}
+		return tx.Create(category).Error
+	})
+}
+
+// UpdateCategory rename category and replace its attribute definitions.
+// Existing products are not revalidated, the new schema applies to their next change.
+func (r *ProductRepository) UpdateCategory(ctx context.Context, category *model.Category) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkCategoryName(tx, category.ID, category.Name); err != nil {
+			return err
+		}
+		result := tx.Model(&model.Category{}).Where("id = ?", category.ID).Update("name", category.Name)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if err := tx.Where("category_id = ?", category.ID).Delete(&model.AttributeDefinition{}).Error; err != nil {
+			return err
+		}
+		if len(category.Attributes) == 0 {
+			return nil
+		}
+		for _, attribute := range category.Attributes {
+			attribute.CategoryID = category.ID
+		}
+		return tx.Create(category.Attributes).Error
+	})
+}
+
+// DeleteCategory delete a category that has no products, deleted products included
+func (r *ProductRepository) DeleteCategory(ctx context.Context, categoryID uint64) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Unscoped().Model(&model.Product{}).Where("category_id = ?", categoryID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrCategoryInUse
+		}
+		result := tx.Where("id = ?", categoryID).Delete(&model.Category{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// GetCategoryByID get category with its attribute definitions
+func (r *ProductRepository) GetCategoryByID(ctx context.Context, categoryID uint64) (*model.Category, error) {
+	var category model.Category
+	if err := r.DB.WithContext(ctx).Preload("Attributes", func(db *gorm.DB) *gorm.DB {
+		return db.Order("name")
+	}).Where("id = ?", categoryID).First(&category).Error; err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+// GetCategories get all categories with their attribute definitions, ordered by name
+func (r *ProductRepository) GetCategories(ctx context.Context) ([]*model.Category, error) {
+	var categories []*model.Category
+	if err := r.DB.WithContext(ctx).Preload("Attributes", func(db *gorm.DB) *gorm.DB {
+		return db.Order("name")
+	}).Order("name").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+// GetCategoryIDBySKU get CategoryID of the product of sellers with sku, 0 when there is no such product
+func (r *ProductRepository) GetCategoryIDBySKU(ctx context.Context, sellerIDs []uint64, sku string) (uint64, error) {
+	var categoryIDs []uint64
+	if err := r.DB.WithContext(ctx).Unscoped().Model(&model.Product{}).
+		Where("seller_id IN ? AND sku = ?", sellerIDs, sku).Order("id").Limit(1).Pluck("category_id", &categoryIDs).Error; err != nil {
+		return 0, err
+	}
+	if len(categoryIDs) == 0 {
+		return 0, nil
+	}
+	return categoryIDs[0], nil
+}
+
+// GetCurrencyBySKU get Currency of the product of sellers with sku, empty when there is no such product
+func (r *ProductRepository) GetCurrencyBySKU(ctx context.Context, sellerIDs []uint64, sku string) (string, error) {
+	var currencies []string
+	if err := r.DB.WithContext(ctx).Unscoped().Model(&model.Product{}).
+		Where("seller_id IN ? AND sku = ?", sellerIDs, sku).Order("id").Limit(1).Pluck("currency", &currencies).Error; err != nil {
+		return "", err
+	}
+	if len(currencies) == 0 {
+		return "", nil
+	}
+	return currencies[0], nil
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"product-service/pkg/model"
+
+	"gorm.io/gorm"
+)
+
+// ReplaceExchangeRates replace all exchange rates by rates, so that currencies dropped by the source are removed
+func (r *ProductRepository) ReplaceExchangeRates(ctx context.Context, rates []*model.ExchangeRate) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("1 = 1").Delete(&model.ExchangeRate{}).Error; err != nil {
+			return err
+		}
+		if len(rates) == 0 {
+			return nil
+		}
+		return tx.Create(rates).Error
+	})
+}
+
+// GetExchangeRates get all exchange rates ordered by currency
+func (r *ProductRepository) GetExchangeRates(ctx context.Context) ([]*model.ExchangeRate, error) {
+	var rates []*model.ExchangeRate
+	if err := r.DB.WithContext(ctx).Order("currency").Find(&rates).Error; err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+// ExistsExchangeRate return true if there is a rate for currency
+func (r *ProductRepository) ExistsExchangeRate(ctx context.Context, currency string) (bool, error) {
+	var rate model.ExchangeRate
+	err := r.DB.WithContext(ctx).Select("currency").Where("currency = ?", currency).First(&rate).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
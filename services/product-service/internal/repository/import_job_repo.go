@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"product-service/pkg/model"
+	"product-service/pkg/outbox"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateImportJob create new import job
+func (r *ProductRepository) CreateImportJob(ctx context.Context, job *model.ImportJob) error {
+	return r.DB.WithContext(ctx).Create(job).Error
+}
+
+// GetImportJobByID get import job by ID, without its payload
+func (r *ProductRepository) GetImportJobByID(ctx context.Context, jobID uint64) (*model.ImportJob, error) {
+	var job model.ImportJob
+	if err := r.DB.WithContext(ctx).Omit("payload").Where("id = ?", jobID).First(&job).Error; err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// GetPendingImportJobs get the oldest import jobs waiting for the worker
+func (r *ProductRepository) GetPendingImportJobs(ctx context.Context, limit int) ([]*model.ImportJob, error) {
+	var jobs []*model.ImportJob
+	result := r.DB.WithContext(ctx).Where("status = ?", "PENDING").Order("created_at").Limit(limit).Find(&jobs)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return jobs, nil
+}
+
+// ClaimImportJob move a PENDING job to RUNNING, return false if another worker already took it
+func (r *ProductRepository) ClaimImportJob(ctx context.Context, jobID uint64) (bool, error) {
+	result := r.DB.WithContext(ctx).Model(&model.ImportJob{}).Where("id = ? AND status = ?", jobID, "PENDING").
+		Updates(map[string]interface{}{"status": "RUNNING"})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// FinishImportJob save the result of an import job and drop its payload
+func (r *ProductRepository) FinishImportJob(ctx context.Context, job *model.ImportJob) error {
+	return r.DB.WithContext(ctx).Model(&model.ImportJob{}).Where("id = ?", job.ID).Updates(map[string]interface{}{
+		"status":       job.Status,
+		"payload":      []byte{},
+		"total_rows":   job.TotalRows,
+		"success_rows": job.SuccessRows,
+		"failed_rows":  job.FailedRows,
+		"errors":       datatypes.JSON(job.Errors),
+	}).Error
+}
+
+// UpsertProductBySKU create product, or update the product of sellerIDs, the sellers of a store, having the same SKU.
+// An updated product keeps its SellerID, a soft-deleted one is restored. The inventory change is recorded as an
+// IMPORT movement of jobID by the importer, the SellerID of product, and may add a stock level event.
+func (r *ProductRepository) UpsertProductBySKU(ctx context.Context, product *model.Product, sellerIDs []uint64, jobID uint64) error {
+	actorID, inventory := product.SellerID, product.Inventory
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existed model.Product
+		err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("seller_id IN ? AND sku = ?", sellerIDs, product.SKU).Order("id").First(&existed).Error
+		eventType := outbox.ProductUpdated
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// A new product starts empty, its stock is added by the movement below
+			eventType = outbox.ProductCreated
+			product.Inventory = 0
+			err = tx.Create(product).Error
+			product.Inventory = inventory
+			if err != nil {
+				return err
+			}
+		} else if err != nil {
+			return err
+		} else {
+			product.ID, product.SellerID = existed.ID, existed.SellerID
+			if err := tx.Unscoped().Model(&model.Product{}).Where("id = ?", existed.ID).
+				Select("name", "price_amount", "currency", "attributes", "deleted_at").Updates(product).Error; err != nil {
+				return err
+			}
+		}
+
+		// Record inventory change, the product is restored by now
+		if err := r.setInventory(tx, &model.InventoryMovement{
+			ProductID:   product.ID,
+			Type:        model.MovementImport,
+			ReferenceID: jobID,
+			ActorID:     actorID,
+		}, existed.Inventory, inventory); err != nil {
+			return err
+		}
+		return r.CreateProductEvent(tx, product.ID, eventType)
+	})
+}
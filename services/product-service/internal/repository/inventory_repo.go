@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"product-service/pkg/dto"
+	"product-service/pkg/model"
+	"product-service/pkg/outbox"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrInsufficientInventory is returned when a change would make inventory negative
+var ErrInsufficientInventory = errors.New("insufficient inventory")
+
+// ErrProductNotActive is returned when an archived or deleted product is ordered
+var ErrProductNotActive = errors.New("product is not active")
+
+// orderMovementTypes are the movement types whose ReferenceID is an OrderID
+var orderMovementTypes = []string{model.MovementOrderReservation, model.MovementOrderCancellation, model.MovementOrderReturn}
+
+// changeInventory add movement.Quantity to inventory of the product and append the movement to the ledger, in tx.
+// SellerID and InventoryAfter of the movement are filled from the updated product.
+// A stock level event is added to the outbox when the change crosses the low-stock threshold or reaches zero.
+func (r *ProductRepository) changeInventory(tx *gorm.DB, movement *model.InventoryMovement) error {
+	// Only active products can be ordered, stock of old orders is given back even to deleted products
+	query := tx
+	switch movement.Type {
+	case model.MovementOrderReservation:
+		query = query.Where("status = ?", model.ProductStatusActive)
+	case model.MovementOrderCancellation, model.MovementOrderReturn:
+		query = query.Unscoped()
+	}
+
+	var product model.Product
+	result := query.Model(&product).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}, {Name: "seller_id"}, {Name: "inventory"}, {Name: "low_stock_threshold"}}}).
+		Where("id = ? AND inventory + ? >= 0", movement.ProductID, movement.Quantity).
+		UpdateColumn("inventory", gorm.Expr("inventory + ?", movement.Quantity))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		var existed model.Product
+		if err := tx.Select("id", "status").Where("id = ?", movement.ProductID).First(&existed).Error; err != nil {
+			return err
+		}
+		if movement.Type == model.MovementOrderReservation && existed.Status != model.ProductStatusActive {
+			return ErrProductNotActive
+		}
+		return ErrInsufficientInventory
+	}
+
+	movement.SellerID = product.SellerID
+	movement.InventoryAfter = product.Inventory
+	if err := tx.Create(movement).Error; err != nil {
+		return err
+	}
+	return r.createStockLevelEvent(tx, &product, product.Inventory-movement.Quantity)
+}
+
+// setInventory set inventory of the product, locked at before in tx, through changeInventory so the stock level
+// event is added too. The difference is recorded as movement, nothing is recorded when inventory is unchanged.
+func (r *ProductRepository) setInventory(tx *gorm.DB, movement *model.InventoryMovement, before, inventory int64) error {
+	if inventory == before {
+		return nil
+	}
+	movement.Quantity = inventory - before
+	return r.changeInventory(tx, movement)
+}
+
+// stockLevelEventType return the stock level event of inventory of product going from before to product.Inventory,
+// across zero or across its low-stock threshold, or empty for none. Out of stock takes precedence over low stock.
+func stockLevelEventType(product *model.Product, before int64) string {
+	switch {
+	case product.Inventory == 0 && before > 0:
+		return outbox.StockLevelOutOfStock
+	case product.Inventory < product.LowStockThreshold && before >= product.LowStockThreshold:
+		return outbox.StockLevelLow
+	default:
+		return ""
+	}
+}
+
+// createStockLevelEvent add the outbox event of stockLevelEventType when there is one
+func (r *ProductRepository) createStockLevelEvent(tx *gorm.DB, product *model.Product, before int64) error {
+	eventType := stockLevelEventType(product, before)
+	if eventType == "" {
+		return nil
+	}
+	return r.CreateStockLevelEvent(tx, &outbox.StockLevelEvent{
+		Type:      eventType,
+		ProductID: product.ID,
+		SellerID:  product.SellerID,
+		Inventory: product.Inventory,
+		Threshold: product.LowStockThreshold,
+		Status:    "PENDING",
+	})
+}
+
+// AdjustInventory change inventory of a product and record the movement
+func (r *ProductRepository) AdjustInventory(ctx context.Context, movement *model.InventoryMovement) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return r.changeInventory(tx, movement)
+	})
+}
+
+// RestockOrderInventory give back inventory reserved by an order, all remaining items when items is empty.
+// Each product is restocked at most by the quantity reserved for the order minus what was already given back,
+// so a redelivered event does not restock twice. The restocked ProductIDs are returned.
+func (r *ProductRepository) RestockOrderInventory(ctx context.Context, orderID uint64, items []*dto.ItemEvent, movementType string) ([]uint64, error) {
+	var productIDs []uint64
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+
+		// Get quantity still reserved for each product of the order
+		var reserved []struct {
+			ProductID uint64
+			Remaining int64
+		}
+		if err := tx.Model(&model.InventoryMovement{}).Select("product_id, -SUM(quantity) AS remaining").
+			Where("reference_id = ? AND type IN ?", orderID, orderMovementTypes).
+			Group("product_id").Order("product_id").Scan(&reserved).Error; err != nil {
+			return err
+		}
+
+		// Get requested quantity for each product
+		requested := make(map[uint64]int64, len(items))
+		for _, item := range items {
+			requested[item.ProductID] += item.Quantity
+		}
+
+		for _, product := range reserved {
+			quantity := product.Remaining
+			if len(items) > 0 {
+				quantity = min(quantity, requested[product.ProductID])
+			}
+			if quantity <= 0 {
+				continue
+			}
+			if err := r.changeInventory(tx, &model.InventoryMovement{
+				ProductID:   product.ProductID,
+				Type:        movementType,
+				ReferenceID: orderID,
+				Quantity:    quantity,
+			}); err != nil {
+				return err
+			}
+			productIDs = append(productIDs, product.ProductID)
+		}
+		return nil
+	})
+	return productIDs, err
+}
+
+// GetInventoryMovements get ledger rows of the products of sellerIDs, newest first. productID and movementType are optional filters
+func (r *ProductRepository) GetInventoryMovements(ctx context.Context, sellerIDs []uint64, productID uint64, movementType string,
+	page, pageSize uint64) ([]*model.InventoryMovement, error) {
+	var movements []*model.InventoryMovement
+	query := r.DB.WithContext(ctx).Where("seller_id IN ?", sellerIDs)
+	if productID != 0 {
+		query = query.Where("product_id = ?", productID)
+	}
+	if movementType != "" {
+		query = query.Where("type = ?", movementType)
+	}
+	offset := int((page - 1) * pageSize)
+	if err := query.Order("created_at DESC, id DESC").Limit(int(pageSize)).Offset(offset).Find(&movements).Error; err != nil {
+		return nil, err
+	}
+	return movements, nil
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"product-service/pkg/model"
+	"product-service/pkg/outbox"
+	"testing"
+)
+
+func TestStockLevelEventType(t *testing.T) {
+	tests := []struct {
+		name      string
+		before    int64
+		inventory int64
+		threshold int64
+		want      string
+	}{
+		{"reservation takes the last unit", 1, 0, 5, outbox.StockLevelOutOfStock},
+		{"reservation drops below threshold", 5, 4, 5, outbox.StockLevelLow},
+		{"reservation already below threshold", 4, 3, 5, ""},
+		{"product update lowers stock below threshold", 20, 2, 5, outbox.StockLevelLow},
+		{"product update lowers stock above threshold", 20, 10, 5, ""},
+		{"product update without threshold", 20, 2, 0, ""},
+		{"import sets stock to zero", 20, 0, 0, outbox.StockLevelOutOfStock},
+		{"import sets stock to zero below threshold", 3, 0, 5, outbox.StockLevelOutOfStock},
+		{"import of a new product below threshold", 0, 2, 5, ""},
+		{"import of an empty product", 0, 0, 5, ""},
+		{"import restocks", 0, 50, 5, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product := &model.Product{ID: 1, Inventory: tt.inventory, LowStockThreshold: tt.threshold}
+			if got := stockLevelEventType(product, tt.before); got != tt.want {
+				t.Errorf("stockLevelEventType() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
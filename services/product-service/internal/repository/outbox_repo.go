@@ -2,10 +2,14 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"product-service/pkg/model"
+	"product-service/pkg/money"
 	"product-service/pkg/outbox"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 func (r *ProductRepository) CreateOrUpdateValOrdEvent(tx *gorm.DB, orderID uint64, success bool, processed bool) error {
@@ -58,3 +62,109 @@ func (r *ProductRepository) UpdateValOrdEventStatus(ctx context.Context, userID
 	return r.DB.WithContext(ctx).Model(&outbox.ValidateOrderEvent{}).Where("order_id = ?", userID).
 		Updates(map[string]interface{}{"status": status}).Error
 }
+
+func (r *ProductRepository) CreateStockLevelEvent(tx *gorm.DB, stockLevelEvent *outbox.StockLevelEvent) error {
+	return tx.Create(stockLevelEvent).Error
+}
+
+func (r *ProductRepository) GetStockLevelEventNotPublish(limit int) ([]*outbox.StockLevelEvent, error) {
+	var stockLevelEvents []*outbox.StockLevelEvent
+	result := r.DB.Model(&outbox.StockLevelEvent{}).Where("status IN ?", []string{"PENDING", "FAILED"}).
+		Order("created_at").Limit(limit).Find(&stockLevelEvents)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return stockLevelEvents, nil
+}
+
+func (r *ProductRepository) UpdateStockLevelEventStatus(ctx context.Context, eventID uint64, status string) error {
+	return r.DB.WithContext(ctx).Model(&outbox.StockLevelEvent{}).Where("id = ?", eventID).
+		Updates(map[string]interface{}{"status": status}).Error
+}
+
+// CreateProductEvent add a product event with a snapshot of the product to the outbox, in tx.
+// The product version is increased, except for CREATED events which carry the first version.
+func (r *ProductRepository) CreateProductEvent(tx *gorm.DB, productID uint64, eventType string) error {
+	var product model.Product
+	query := tx.Unscoped().Model(&product).Where("id = ?", productID)
+	if eventType == outbox.ProductCreated {
+		if err := query.First(&product).Error; err != nil {
+			return err
+		}
+	} else {
+		result := query.Clauses(clause.Returning{}).UpdateColumn("version", gorm.Expr("version + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+	}
+
+	status := product.Status
+	if product.DeletedAt.Valid {
+		status = model.ProductStatusDeleted
+	}
+	snapshot, err := json.Marshal(&outbox.ProductSnapshot{
+		ID:                product.ID,
+		Name:              product.Name,
+		Price:             money.New(product.PriceAmount, product.Currency),
+		SellerID:          product.SellerID,
+		Inventory:         product.Inventory,
+		Attributes:        product.Attributes,
+		SKU:               product.SKU,
+		LowStockThreshold: product.LowStockThreshold,
+		CategoryID:        product.CategoryID,
+		Status:            status,
+		Version:           product.Version,
+	})
+	if err != nil {
+		return err
+	}
+	return tx.Create(&outbox.ProductEvent{
+		Type:      eventType,
+		ProductID: product.ID,
+		Version:   product.Version,
+		Snapshot:  snapshot,
+		Status:    "PENDING",
+	}).Error
+}
+
+func (r *ProductRepository) GetProductEventNotPublish(limit int) ([]*outbox.ProductEvent, error) {
+	var productEvents []*outbox.ProductEvent
+	result := r.DB.Model(&outbox.ProductEvent{}).Where("status IN ?", []string{"PENDING", "FAILED"}).
+		Order("id").Limit(limit).Find(&productEvents)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return productEvents, nil
+}
+
+func (r *ProductRepository) UpdateProductEventStatus(ctx context.Context, eventID uint64, status string) error {
+	return r.DB.WithContext(ctx).Model(&outbox.ProductEvent{}).Where("id = ?", eventID).
+		Updates(map[string]interface{}{"status": status}).Error
+}
+
+func (r *ProductRepository) GetQuestionAnsweredEventNotPublish(limit int) ([]*outbox.QuestionAnsweredEvent, error) {
+	var questionAnsweredEvents []*outbox.QuestionAnsweredEvent
+	result := r.DB.Model(&outbox.QuestionAnsweredEvent{}).Where("status IN ?", []string{"PENDING", "FAILED"}).
+		Order("id").Limit(limit).Find(&questionAnsweredEvents)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return questionAnsweredEvents, nil
+}
+
+func (r *ProductRepository) UpdateQuestionAnsweredEventStatus(ctx context.Context, eventID uint64, status string) error {
+	return r.DB.WithContext(ctx).Model(&outbox.QuestionAnsweredEvent{}).Where("id = ?", eventID).
+		Updates(map[string]interface{}{"status": status}).Error
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"product-service/pkg/model"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// productCacheMetrics count product cache hits, misses and Redis errors, exposed by expvar at /debug/vars
+var productCacheMetrics = expvar.NewMap("product_cache")
+
+// ProductCache is a Redis read-through cache of products by ProductID.
+// When the cache is disabled every lookup is a miss and nothing is written.
+type ProductCache struct {
+	Client  *redis.Client
+	TTL     time.Duration
+	Enabled bool
+}
+
+// NewProductCache create new ProductCache, mainly used for ProductService
+func NewProductCache(client *redis.Client, ttl time.Duration, enabled bool) *ProductCache {
+	return &ProductCache{
+		Client:  client,
+		TTL:     ttl,
+		Enabled: enabled && client != nil,
+	}
+}
+
+func productCacheKey(productID uint64) string {
+	return "product:" + strconv.FormatUint(productID, 10)
+}
+
+// GetProducts get cached products by ProductID and the IDs that are not in cache.
+// On Redis error every ID is returned as missing together with the error.
+func (c *ProductCache) GetProducts(ctx context.Context, productIDs []uint64) (map[uint64]*model.Product, []uint64, error) {
+	products := make(map[uint64]*model.Product, len(productIDs))
+	if !c.Enabled || len(productIDs) == 0 {
+		return products, productIDs, nil
+	}
+
+	keys := make([]string, len(productIDs))
+	for i, productID := range productIDs {
+		keys[i] = productCacheKey(productID)
+	}
+	values, err := c.Client.MGet(ctx, keys...).Result()
+	if err != nil {
+		productCacheMetrics.Add("errors", 1)
+		productCacheMetrics.Add("misses", int64(len(productIDs)))
+		return products, productIDs, err
+	}
+
+	var missing []uint64
+	for i, value := range values {
+		str, ok := value.(string)
+		if !ok {
+			missing = append(missing, productIDs[i])
+			continue
+		}
+		var product model.Product
+		if err := json.Unmarshal([]byte(str), &product); err != nil {
+			missing = append(missing, productIDs[i])
+			continue
+		}
+		products[productIDs[i]] = &product
+	}
+	productCacheMetrics.Add("hits", int64(len(products)))
+	productCacheMetrics.Add("misses", int64(len(missing)))
+	return products, missing, nil
+}
+
+// SetProducts cache products with the cache TTL
+func (c *ProductCache) SetProducts(ctx context.Context, products []*model.Product) error {
+	if !c.Enabled || len(products) == 0 {
+		return nil
+	}
+
+	pipe := c.Client.Pipeline()
+	for _, product := range products {
+		value, err := json.Marshal(product)
+		if err != nil {
+			return err
+		}
+		pipe.Set(ctx, productCacheKey(product.ID), value, c.TTL)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		productCacheMetrics.Add("errors", 1)
+		return err
+	}
+	return nil
+}
+
+// Invalidate remove products from cache, it is called after every change of a product
+func (c *ProductCache) Invalidate(ctx context.Context, productIDs ...uint64) error {
+	if !c.Enabled || len(productIDs) == 0 {
+		return nil
+	}
+
+	keys := make([]string, len(productIDs))
+	for i, productID := range productIDs {
+		keys[i] = productCacheKey(productID)
+	}
+	if err := c.Client.Del(ctx, keys...).Err(); err != nil && !errors.Is(err, redis.Nil) {
+		productCacheMetrics.Add("errors", 1)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"product-service/pkg/dto"
+	"product-service/pkg/model"
+	"strings"
+)
+
+// ErrInvalidPageToken is returned for a page token that is malformed or was returned with another sort
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// exactCountLimit is the planner row estimate above which listings return the estimate instead of counting
+const exactCountLimit = 10000
+
+// pageToken is the keyset of the last product of a page, it is sent to clients as base64 JSON
+type pageToken struct {
+	Sort     string `json:"s"`
+	ID       uint64 `json:"i"`
+	Currency string `json:"c,omitempty"`
+	Price    int64  `json:"p,omitempty"`
+	Name     string `json:"n,omitempty"`
+}
+
+// productSort is the keyset of a listing sort, products are ordered by columns then ID in the same direction
+type productSort struct {
+	columns []string // empty to order by ID only
+	desc    bool
+	key     func(token *pageToken) []any // values of columns
+	set     func(token *pageToken, product *model.Product)
+}
+
+// priceSort order by currency before amount, amounts in minor units of different currencies do not compare,
+// so products are grouped by currency code and ordered by price within their currency
+func priceSort(desc bool) productSort {
+	return productSort{
+		columns: []string{"currency", "price_amount"},
+		desc:    desc,
+		key:     func(token *pageToken) []any { return []any{token.Currency, token.Price} },
+		set: func(token *pageToken, product *model.Product) {
+			token.Currency, token.Price = product.Currency, product.PriceAmount
+		},
+	}
+}
+
+var productSorts = map[string]productSort{
+	dto.ProductSortNewest:    {desc: true},
+	dto.ProductSortOldest:    {},
+	dto.ProductSortPriceAsc:  priceSort(false),
+	dto.ProductSortPriceDesc: priceSort(true),
+	dto.ProductSortNameAsc: {
+		columns: []string{"name"},
+		key:     func(token *pageToken) []any { return []any{token.Name} },
+		set:     func(token *pageToken, product *model.Product) { token.Name = product.Name },
+	},
+}
+
+// after return the condition and its args of the products after token in the order of sort
+func (sort productSort) after(token *pageToken) (string, []any) {
+	op := " > "
+	if sort.desc {
+		op = " < "
+	}
+	if len(sort.columns) == 0 {
+		return "id" + op + "?", []any{token.ID}
+	}
+	args := append(sort.key(token), token.ID)
+	return "(" + strings.Join(sort.columns, ", ") + ", id)" + op + "(?" + strings.Repeat(", ?", len(args)-1) + ")", args
+}
+
+// order return the ORDER BY clause of sort
+func (sort productSort) order() string {
+	direction := " ASC"
+	if sort.desc {
+		direction = " DESC"
+	}
+	var order []string
+	for _, column := range sort.columns {
+		order = append(order, column+direction)
+	}
+	return strings.Join(append(order, "id"+direction), ", ")
+}
+
+func encodePageToken(token *pageToken) (string, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodePageToken parse a token returned by encodePageToken for a listing sorted by sortName. Tokens that were not
+// issued like that, edited ones included, are rejected rather than read as another position.
+func decodePageToken(s string, sortName string) (*pageToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	var token pageToken
+	if err := decoder.Decode(&token); err != nil || decoder.More() {
+		return nil, ErrInvalidPageToken
+	}
+	if token.Sort != sortName || token.ID == 0 {
+		return nil, ErrInvalidPageToken
+	}
+	return &token, nil
+}
+
+// GetProducts get a page of active products
+func (r *ProductRepository) GetProducts(ctx context.Context, page dto.PageQuery) ([]*model.Product, *dto.PageInfo, error) {
+	return r.listProducts(ctx, "status = ?", []any{model.ProductStatusActive}, page)
+}
+
+// GetProductsPageBySellerID get a page of products of a seller, archived products included
+func (r *ProductRepository) GetProductsPageBySellerID(ctx context.Context, sellerID uint64, page dto.PageQuery) ([]*model.Product, *dto.PageInfo, error) {
+	return r.listProducts(ctx, "seller_id = ?", []any{sellerID}, page)
+}
+
+// listProducts get the page of products matching where after page.PageToken, with the total count of matching products
+func (r *ProductRepository) listProducts(ctx context.Context, where string, args []any, page dto.PageQuery) ([]*model.Product, *dto.PageInfo, error) {
+	sortName := page.Sort
+	if sortName == "" {
+		sortName = dto.ProductSortNewest
+	}
+	sort, ok := productSorts[sortName]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown product sort %q", sortName)
+	}
+
+	query := r.DB.WithContext(ctx).Where(where, args...)
+	if page.PageToken != "" {
+		token, err := decodePageToken(page.PageToken, sortName)
+		if err != nil {
+			return nil, nil, err
+		}
+		condition, conditionArgs := sort.after(token)
+		query = query.Where(condition, conditionArgs...)
+	}
+
+	// Read one more product to know if there is a next page
+	var products []*model.Product
+	if err := query.Order(sort.order()).Limit(int(page.PageSize) + 1).Find(&products).Error; err != nil {
+		return nil, nil, err
+	}
+	info := &dto.PageInfo{}
+	if uint64(len(products)) > page.PageSize {
+		products = products[:page.PageSize]
+		last := products[len(products)-1]
+		token := &pageToken{Sort: sortName, ID: last.ID}
+		if sort.set != nil {
+			sort.set(token, last)
+		}
+		nextPageToken, err := encodePageToken(token)
+		if err != nil {
+			return nil, nil, err
+		}
+		info.NextPageToken = nextPageToken
+	}
+
+	total, estimated, err := r.countProducts(ctx, where, args)
+	if err != nil {
+		return nil, nil, err
+	}
+	info.TotalCount = total
+	info.TotalIsEstimate = estimated
+	return products, info, nil
+}
+
+// countProducts count products matching where.
+// The planner estimate is returned instead when it is above exactCountLimit, since counting reads every matching row.
+func (r *ProductRepository) countProducts(ctx context.Context, where string, args []any) (uint64, bool, error) {
+	var plan string
+	if err := r.DB.WithContext(ctx).Raw("EXPLAIN (FORMAT JSON) SELECT 1 FROM products WHERE deleted_at IS NULL AND "+where, args...).
+		Row().Scan(&plan); err != nil {
+		return 0, false, err
+	}
+	var explain []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(plan), &explain); err != nil {
+		return 0, false, err
+	}
+	if len(explain) > 0 && explain[0].Plan.Rows > exactCountLimit {
+		return uint64(explain[0].Plan.Rows), true, nil
+	}
+
+	var count int64
+	if err := r.DB.WithContext(ctx).Model(&model.Product{}).Where(where, args...).Count(&count).Error; err != nil {
+		return 0, false, err
+	}
+	return uint64(count), false, nil
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"encoding/base64"
+	"errors"
+	"product-service/pkg/dto"
+	"product-service/pkg/model"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	product := &model.Product{ID: 42, Name: "Blue shirt, size \"M\"", PriceAmount: 1999, Currency: "USD"}
+	for sortName, sort := range productSorts {
+		t.Run(sortName, func(t *testing.T) {
+			token := &pageToken{Sort: sortName, ID: product.ID}
+			if sort.set != nil {
+				sort.set(token, product)
+			}
+			encoded, err := encodePageToken(token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.ContainsAny(encoded, "+/=") {
+				t.Errorf("token %q is not URL safe", encoded)
+			}
+			decoded, err := decodePageToken(encoded, sortName)
+			if err != nil {
+				t.Fatalf("decodePageToken() error = %v", err)
+			}
+			if *decoded != *token {
+				t.Errorf("decodePageToken() = %+v, want %+v", decoded, token)
+			}
+			if sort.key != nil && !slices.Equal(sort.key(decoded), sort.key(token)) {
+				t.Errorf("keyset = %v, want %v", sort.key(decoded), sort.key(token))
+			}
+		})
+	}
+}
+
+func TestPageTokenTampering(t *testing.T) {
+	encode := func(s string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(s))
+	}
+	valid, err := encodePageToken(&pageToken{Sort: dto.ProductSortPriceAsc, ID: 42, Currency: "USD", Price: 1999})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		sort  string
+	}{
+		{"other sort", valid, dto.ProductSortPriceDesc},
+		{"unknown sort", valid, "popular"},
+		{"not base64", "%%%", dto.ProductSortPriceAsc},
+		{"padded base64", base64.URLEncoding.EncodeToString([]byte(`{"s":"PRICE_ASC","i":7}`)), dto.ProductSortPriceAsc},
+		{"truncated", valid[:len(valid)-4], dto.ProductSortPriceAsc},
+		{"not json", encode("PRICE_ASC:42"), dto.ProductSortPriceAsc},
+		{"json array", encode(`["PRICE_ASC",42]`), dto.ProductSortPriceAsc},
+		{"unknown field", encode(`{"s":"PRICE_ASC","i":42,"seller":7}`), dto.ProductSortPriceAsc},
+		{"wrong type", encode(`{"s":"PRICE_ASC","i":"42"}`), dto.ProductSortPriceAsc},
+		{"negative id", encode(`{"s":"PRICE_ASC","i":-1}`), dto.ProductSortPriceAsc},
+		{"zero id", encode(`{"s":"PRICE_ASC","i":0}`), dto.ProductSortPriceAsc},
+		{"missing id", encode(`{"s":"PRICE_ASC","p":1999}`), dto.ProductSortPriceAsc},
+		{"trailing value", encode(`{"s":"PRICE_ASC","i":42}{"s":"PRICE_ASC","i":1}`), dto.ProductSortPriceAsc},
+		{"empty", "", dto.ProductSortPriceAsc},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodePageToken(tt.token, tt.sort); !errors.Is(err, ErrInvalidPageToken) {
+				t.Errorf("decodePageToken() error = %v, want %v", err, ErrInvalidPageToken)
+			}
+		})
+	}
+}
+
+func TestProductSortKeyset(t *testing.T) {
+	token := &pageToken{ID: 42, Currency: "JPY", Price: 1000, Name: "Tea"}
+	tests := []struct {
+		sort      string
+		wantAfter string
+		wantArgs  []any
+		wantOrder string
+	}{
+		{dto.ProductSortNewest, "id < ?", []any{uint64(42)}, "id DESC"},
+		{dto.ProductSortOldest, "id > ?", []any{uint64(42)}, "id ASC"},
+		{dto.ProductSortPriceAsc, "(currency, price_amount, id) > (?, ?, ?)", []any{"JPY", int64(1000), uint64(42)},
+			"currency ASC, price_amount ASC, id ASC"},
+		{dto.ProductSortPriceDesc, "(currency, price_amount, id) < (?, ?, ?)", []any{"JPY", int64(1000), uint64(42)},
+			"currency DESC, price_amount DESC, id DESC"},
+		{dto.ProductSortNameAsc, "(name, id) > (?, ?)", []any{"Tea", uint64(42)}, "name ASC, id ASC"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sort, func(t *testing.T) {
+			sort := productSorts[tt.sort]
+			after, args := sort.after(token)
+			if after != tt.wantAfter || !slices.Equal(args, tt.wantArgs) {
+				t.Errorf("after() = %q %v, want %q %v", after, args, tt.wantAfter, tt.wantArgs)
+			}
+			if got := sort.order(); got != tt.wantOrder {
+				t.Errorf("order() = %q, want %q", got, tt.wantOrder)
+			}
+		})
+	}
+}
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"product-service/pkg/dto"
 	"product-service/pkg/model"
+	"product-service/pkg/outbox"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ProductRepository struct {
@@ -21,21 +23,63 @@ func NewProductRepository(db *gorm.DB) *ProductRepository {
 	}
 }
 
-// CreateProduct create new product
+// CreateProduct create new product and record its initial stock
 func (r *ProductRepository) CreateProduct(ctx context.Context, product *model.Product) error {
-	return r.DB.WithContext(ctx).Create(product).Error
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(product).Error; err != nil {
+			return err
+		}
+		if err := r.CreateProductEvent(tx, product.ID, outbox.ProductCreated); err != nil {
+			return err
+		}
+		if product.Inventory == 0 {
+			return nil
+		}
+		return tx.Create(&model.InventoryMovement{
+			ProductID:      product.ID,
+			SellerID:       product.SellerID,
+			Type:           model.MovementInitialStock,
+			Quantity:       product.Inventory,
+			InventoryAfter: product.Inventory,
+			ActorID:        product.SellerID,
+		}).Error
+	})
 }
 
-// UpdateProduct update product
-func (r *ProductRepository) UpdateProduct(ctx context.Context, product *model.Product) error {
-	result := r.DB.WithContext(ctx).Model(&model.Product{}).Where("id = ?", product.ID).Updates(product)
-	if result.Error != nil {
-		return result.Error
-	}
-	if result.RowsAffected == 0 {
-		return gorm.ErrRecordNotFound
-	}
-	return nil
+// UpdateProduct update product, a changed inventory is recorded as a PRODUCT_UPDATE movement made by actorID
+// and may add a stock level event
+func (r *ProductRepository) UpdateProduct(ctx context.Context, product *model.Product, actorID uint64) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+
+		// Lock old inventory, zero inventory is not updated by Updates
+		var oldProduct model.Product
+		if product.Inventory != 0 {
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "seller_id", "inventory").
+				Where("id = ?", product.ID).First(&oldProduct).Error; err != nil {
+				return err
+			}
+		}
+
+		result := tx.Model(&model.Product{}).Where("id = ?", product.ID).Omit("inventory").Updates(product)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		// Record inventory change, after the other fields so a new low-stock threshold applies to it
+		if product.Inventory != 0 {
+			if err := r.setInventory(tx, &model.InventoryMovement{
+				ProductID: product.ID,
+				Type:      model.MovementProductUpdate,
+				ActorID:   actorID,
+			}, oldProduct.Inventory, product.Inventory); err != nil {
+				return err
+			}
+		}
+		return r.CreateProductEvent(tx, product.ID, outbox.ProductUpdated)
+	})
 }
 
 // GetProductByID get product by ProductID
@@ -47,13 +91,22 @@ func (r *ProductRepository) GetProductByID(ctx context.Context, productID uint64
 	return &product, nil
 }
 
-// GetProductsByID get product by ProductID
+// GetProductByIDUnscoped get product by ProductID, including a deleted product
+func (r *ProductRepository) GetProductByIDUnscoped(ctx context.Context, productID uint64) (*model.Product, error) {
+	var product model.Product
+	if err := r.DB.WithContext(ctx).Unscoped().Model(&model.Product{}).Where("id = ?", productID).First(&product).Error; err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+// GetProductsByID get product by ProductID, archived and deleted products are included for old orders
 func (r *ProductRepository) GetProductsByID(ctx context.Context, productIDs []uint64) ([]*model.Product, error) {
 	var products []*model.Product
 	if len(productIDs) == 0 {
 		return []*model.Product{}, nil
 	}
-	if err := r.DB.WithContext(ctx).Model(&model.Product{}).Where("id IN ?", productIDs).Find(&products).Error; err != nil {
+	if err := r.DB.WithContext(ctx).Unscoped().Model(&model.Product{}).Where("id IN ?", productIDs).Find(&products).Error; err != nil {
 		return nil, err
 	}
 	return products, nil
@@ -77,33 +130,38 @@ func (r *ProductRepository) GetSellerIDByID(ctx context.Context, productID uint6
 	return product.SellerID, nil
 }
 
-// GetAndDecreaseInventoryByID get and decrease inventory by ProductID (atomic)
-func (r *ProductRepository) GetAndDecreaseInventoryByID(ctx context.Context, id uint64, quantity int64) error {
-	// Use dto.Product to use atomic transaction: get and delete inventory
-	result := r.DB.WithContext(ctx).Model(&model.Product{}).Where("id = ? AND inventory >= ?", id, quantity).UpdateColumn("inventory", gorm.Expr("inventory - ?", quantity))
-	if result.Error != nil {
-		return result.Error
-	}
-	if result.RowsAffected == 0 {
-		return errors.New("no rows affected")
-	}
-	return nil
+// GetAndDecreaseInventoryByID get and decrease inventory by ProductID (atomic), recorded as a reservation made by userID
+func (r *ProductRepository) GetAndDecreaseInventoryByID(ctx context.Context, id uint64, quantity int64, userID uint64) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := r.changeInventory(tx, &model.InventoryMovement{
+			ProductID: id,
+			Type:      model.MovementOrderReservation,
+			Quantity:  -quantity,
+			ActorID:   userID,
+		})
+		if errors.Is(err, ErrInsufficientInventory) || errors.Is(err, ErrProductNotActive) || errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("no rows affected")
+		}
+		return err
+	})
 }
 
 func (r *ProductRepository) GetAndDecreaseInventoryByIDBatch(ctx context.Context, kafkaEvent *dto.CreateOrderKafkaEvent) error {
 	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		itemEvents := kafkaEvent.Items
 		for _, item := range itemEvents {
-			result := tx.Model(&model.Product{}).
-				Where("id = ? AND inventory >= ?", item.ProductID, item.Quantity).
-				UpdateColumn("inventory", gorm.Expr("inventory - ?", item.Quantity))
-
-			if result.Error != nil {
-				return fmt.Errorf("errorDB %v in ID: %d", result.Error.Error(), item.ProductID)
-			}
-			if result.RowsAffected == 0 {
+			err := r.changeInventory(tx, &model.InventoryMovement{
+				ProductID:   item.ProductID,
+				Type:        model.MovementOrderReservation,
+				ReferenceID: kafkaEvent.OrderID,
+				Quantity:    -item.Quantity,
+			})
+			if errors.Is(err, ErrInsufficientInventory) || errors.Is(err, ErrProductNotActive) || errors.Is(err, gorm.ErrRecordNotFound) {
 				return fmt.Errorf("no product for product_id = %d", item.ProductID)
 			}
+			if err != nil {
+				return fmt.Errorf("errorDB %v in ID: %d", err.Error(), item.ProductID)
+			}
 		}
 
 		// Update in OutboxDB
@@ -114,20 +172,64 @@ func (r *ProductRepository) GetAndDecreaseInventoryByIDBatch(ctx context.Context
 	})
 }
 
-// GetProductsBySellerID get product array by SellerID
-func (r *ProductRepository) GetProductsBySellerID(ctx context.Context, sellerID uint64) ([]*model.Product, error) {
+// DeleteProduct soft delete product
+func (r *ProductRepository) DeleteProduct(ctx context.Context, productID uint64) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ?", productID).Delete(&model.Product{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return r.CreateProductEvent(tx, productID, outbox.ProductDeleted)
+	})
+}
+
+// ArchiveProduct hide product from listings without deleting it
+func (r *ProductRepository) ArchiveProduct(ctx context.Context, productID uint64) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Product{}).Where("id = ?", productID).
+			Updates(map[string]interface{}{"status": model.ProductStatusArchived})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return r.CreateProductEvent(tx, productID, outbox.ProductUpdated)
+	})
+}
+
+// RestoreProduct make an archived or deleted product active again
+func (r *ProductRepository) RestoreProduct(ctx context.Context, productID uint64) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Model(&model.Product{}).Where("id = ?", productID).
+			Updates(map[string]interface{}{"status": model.ProductStatusActive, "deleted_at": nil})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return r.CreateProductEvent(tx, productID, outbox.ProductUpdated)
+	})
+}
+
+// GetActiveProductsAfterID get up to limit active products with ID greater than afterID, by ID
+func (r *ProductRepository) GetActiveProductsAfterID(ctx context.Context, afterID uint64, limit int) ([]*model.Product, error) {
 	var products []*model.Product
-	if err := r.DB.WithContext(ctx).Where("seller_id = ?", sellerID).Find(&products).Error; err != nil {
+	if err := r.DB.WithContext(ctx).Where("status = ? AND id > ?", model.ProductStatusActive, afterID).
+		Order("id").Limit(limit).Find(&products).Error; err != nil {
 		return nil, err
 	}
 	return products, nil
 }
 
-func (r *ProductRepository) GetProducts(ctx context.Context, page, pageSize uint64) ([]*model.Product, error) {
+// GetProductsBySellerIDs get all products of sellers, listings use GetProductsPageBySellerID
+func (r *ProductRepository) GetProductsBySellerIDs(ctx context.Context, sellerIDs []uint64) ([]*model.Product, error) {
 	var products []*model.Product
-	pageSizeInt := int(pageSize)
-	offset := int((page - 1) * pageSize)
-	if err := r.DB.WithContext(ctx).Limit(pageSizeInt).Offset(offset).Find(&products).Error; err != nil {
+	if err := r.DB.WithContext(ctx).Where("seller_id IN ?", sellerIDs).Order("id").Find(&products).Error; err != nil {
 		return nil, err
 	}
 	return products, nil
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"product-service/pkg/model"
+	"product-service/pkg/outbox"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrQANotVisible is returned for an answer to, or a flag on, a question or an answer that is flagged or hidden
+var ErrQANotVisible = errors.New("question or answer is flagged or hidden")
+
+// checkQAVisible return ErrQANotVisible unless status is visible. Flagged and hidden questions and answers wait
+// for, or got, a moderator's decision, so they take neither answers nor more flags.
+func checkQAVisible(status string) error {
+	if status != model.QAStatusVisible {
+		return ErrQANotVisible
+	}
+	return nil
+}
+
+// CreateQuestion create question of a buyer about a product
+func (r *ProductRepository) CreateQuestion(ctx context.Context, question *model.ProductQuestion) error {
+	return r.DB.WithContext(ctx).Create(question).Error
+}
+
+// GetQuestionByID get question by QuestionID, hidden questions included
+func (r *ProductRepository) GetQuestionByID(ctx context.Context, questionID uint64) (*model.ProductQuestion, error) {
+	var question model.ProductQuestion
+	if err := r.DB.WithContext(ctx).Where("id = ?", questionID).First(&question).Error; err != nil {
+		return nil, err
+	}
+	return &question, nil
+}
+
+// CreateAnswer create answer to question and add a question answered event to the outbox, in one transaction.
+// ErrQANotVisible is returned when the question is not visible.
+func (r *ProductRepository) CreateAnswer(ctx context.Context, question *model.ProductQuestion, answer *model.ProductAnswer) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var statuses []string
+		if err := tx.Model(&model.ProductQuestion{}).Clauses(clause.Locking{Strength: "SHARE"}).
+			Where("id = ?", question.ID).Pluck("status", &statuses).Error; err != nil {
+			return err
+		}
+		if len(statuses) == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if err := checkQAVisible(statuses[0]); err != nil {
+			return err
+		}
+		answer.QuestionID = question.ID
+		if err := tx.Create(answer).Error; err != nil {
+			return err
+		}
+		return tx.Create(&outbox.QuestionAnsweredEvent{
+			QuestionID:  question.ID,
+			AnswerID:    answer.ID,
+			ProductID:   question.ProductID,
+			BuyerID:     question.BuyerID,
+			ResponderID: answer.ResponderID,
+			Status:      "PENDING",
+		}).Error
+	})
+}
+
+// GetQuestionsByProductID get a page of listed questions of a product with their listed answers, newest question first,
+// and the number of listed questions
+func (r *ProductRepository) GetQuestionsByProductID(ctx context.Context, productID, page, pageSize uint64) ([]*model.ProductQuestion, int64, error) {
+	var total int64
+	query := r.DB.WithContext(ctx).Model(&model.ProductQuestion{}).Where("product_id = ? AND status IN ?", productID, model.QAListedStatuses)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var questions []*model.ProductQuestion
+	offset := int((page - 1) * pageSize)
+	if err := query.Preload("Answers", func(db *gorm.DB) *gorm.DB {
+		return db.Where("status IN ?", model.QAListedStatuses).Order("created_at, id")
+	}).Order("created_at DESC, id DESC").Limit(int(pageSize)).Offset(offset).Find(&questions).Error; err != nil {
+		return nil, 0, err
+	}
+	return questions, total, nil
+}
+
+// qaTargetModel return the model flagged by a flag target type
+func qaTargetModel(targetType string) interface{} {
+	if targetType == model.FlagTargetAnswer {
+		return &model.ProductAnswer{}
+	}
+	return &model.ProductQuestion{}
+}
+
+// FlagQA record flag of a user on a question or an answer and put a visible target in the moderation queue when it
+// reaches model.QAFlagLimit flags. It returns false when the user already flagged the target, gorm.ErrRecordNotFound
+// for an unknown target and ErrQANotVisible for a target that is not visible.
+func (r *ProductRepository) FlagQA(ctx context.Context, flag *model.QAFlag) (bool, error) {
+	flagged := false
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var statuses []string
+		if err := tx.Model(qaTargetModel(flag.TargetType)).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", flag.TargetID).Pluck("status", &statuses).Error; err != nil {
+			return err
+		}
+		if len(statuses) == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if err := checkQAVisible(statuses[0]); err != nil {
+			return err
+		}
+
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(flag)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		flagged = true
+		return tx.Model(qaTargetModel(flag.TargetType)).Where("id = ?", flag.TargetID).UpdateColumns(map[string]interface{}{
+			"flag_count": gorm.Expr("flag_count + 1"),
+			"status": gorm.Expr("CASE WHEN status = ? AND flag_count + 1 >= ? THEN ? ELSE status END",
+				model.QAStatusVisible, model.QAFlagLimit, model.QAStatusFlagged),
+		}).Error
+	})
+	return flagged, err
+}
+
+// UpdateQAStatus set moderation status of a question or an answer. The decision settles its flags, so they are deleted
+// with flag_count reset and the target leaves the moderation queue.
+func (r *ProductRepository) UpdateQAStatus(ctx context.Context, targetType string, targetID uint64, status string) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(qaTargetModel(targetType)).Where("id = ?", targetID).
+			UpdateColumns(map[string]interface{}{"status": status, "flag_count": 0})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Where("target_type = ? AND target_id = ?", targetType, targetID).Delete(&model.QAFlag{}).Error
+	})
+}
+
+// GetFlaggedQuestions get a page of the questions of the moderation queue, most flagged first, and their number
+func (r *ProductRepository) GetFlaggedQuestions(ctx context.Context, page, pageSize uint64) ([]*model.ProductQuestion, int64, error) {
+	var total int64
+	query := r.DB.WithContext(ctx).Model(&model.ProductQuestion{}).Where("status = ?", model.QAStatusFlagged)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var questions []*model.ProductQuestion
+	offset := int((page - 1) * pageSize)
+	if err := query.Order("flag_count DESC, id").Limit(int(pageSize)).Offset(offset).Find(&questions).Error; err != nil {
+		return nil, 0, err
+	}
+	return questions, total, nil
+}
+
+// GetFlaggedAnswers get a page of the answers of the moderation queue, most flagged first, and their number
+func (r *ProductRepository) GetFlaggedAnswers(ctx context.Context, page, pageSize uint64) ([]*model.ProductAnswer, int64, error) {
+	var total int64
+	query := r.DB.WithContext(ctx).Model(&model.ProductAnswer{}).Where("status = ?", model.QAStatusFlagged)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var answers []*model.ProductAnswer
+	offset := int((page - 1) * pageSize)
+	if err := query.Order("flag_count DESC, id").Limit(int(pageSize)).Offset(offset).Find(&answers).Error; err != nil {
+		return nil, 0, err
+	}
+	return answers, total, nil
+}
+
+// GetQuestionsByIDs get questions by QuestionIDs, hidden questions included
+func (r *ProductRepository) GetQuestionsByIDs(ctx context.Context, questionIDs []uint64) ([]*model.ProductQuestion, error) {
+	var questions []*model.ProductQuestion
+	if len(questionIDs) == 0 {
+		return questions, nil
+	}
+	if err := r.DB.WithContext(ctx).Where("id IN ?", questionIDs).Find(&questions).Error; err != nil {
+		return nil, err
+	}
+	return questions, nil
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"errors"
+	"product-service/pkg/model"
+	"testing"
+)
+
+func TestCheckQAVisible(t *testing.T) {
+	tests := []struct {
+		status  string
+		wantErr error
+	}{
+		{model.QAStatusVisible, nil},
+		{model.QAStatusFlagged, ErrQANotVisible}, // waiting in the moderation queue
+		{model.QAStatusHidden, ErrQANotVisible},  // hidden by a moderator
+		{"", ErrQANotVisible},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			if err := checkQAVisible(tt.status); !errors.Is(err, tt.wantErr) {
+				t.Errorf("checkQAVisible() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"product-service/pkg/model"
+	"time"
+)
+
+// completedReservations selects ORDER_RESERVATION movements of orders that were not canceled or returned.
+// order-service does not complete orders nor publish an order completed event, its only events are
+// order.create_order, order.cancel_order and order.return_order which product-service already records as
+// ORDER_RESERVATION, ORDER_CANCELLATION and ORDER_RETURN movements, so a reservation that was neither
+// canceled nor returned is the closest to a completed order this service can see.
+// Switch to order completion once order-service publishes it.
+const completedReservations = `
+	SELECT m.reference_id, m.product_id, -m.quantity AS quantity
+	FROM inventory_movements m
+	WHERE m.type = @reservation AND m.created_at >= @since
+	AND NOT EXISTS (
+		SELECT 1 FROM inventory_movements c
+		WHERE c.reference_id = m.reference_id AND c.type IN @cancellations
+	)`
+
+// GetCoPurchasedProducts count for each product the orders placed since that also contained another product,
+// and return for each product at most limit other ProductIDs, most bought together first
+func (r *ProductRepository) GetCoPurchasedProducts(ctx context.Context, since time.Time, limit int) (map[uint64][]uint64, error) {
+	var rows []struct {
+		ProductID uint64
+		RelatedID uint64
+	}
+	err := r.DB.WithContext(ctx).Raw(`
+		WITH reservations AS (`+completedReservations+`),
+		pairs AS (
+			SELECT a.product_id, b.product_id AS related_id, COUNT(DISTINCT a.reference_id) AS orders
+			FROM reservations a JOIN reservations b ON a.reference_id = b.reference_id AND a.product_id <> b.product_id
+			GROUP BY a.product_id, b.product_id
+		),
+		ranked AS (
+			SELECT product_id, related_id,
+				ROW_NUMBER() OVER (PARTITION BY product_id ORDER BY orders DESC, related_id) AS row_rank
+			FROM pairs
+		)
+		SELECT product_id, related_id FROM ranked WHERE row_rank <= @limit ORDER BY product_id, row_rank`,
+		map[string]interface{}{
+			"reservation":   model.MovementOrderReservation,
+			"cancellations": []string{model.MovementOrderCancellation, model.MovementOrderReturn},
+			"since":         since,
+			"limit":         limit,
+		}).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	related := make(map[uint64][]uint64)
+	for _, row := range rows {
+		related[row.ProductID] = append(related[row.ProductID], row.RelatedID)
+	}
+	return related, nil
+}
+
+// GetPopularProductsByCategory get for each category at most limit active ProductIDs, most units ordered since first
+func (r *ProductRepository) GetPopularProductsByCategory(ctx context.Context, since time.Time, limit int) (map[uint64][]uint64, error) {
+	var rows []struct {
+		CategoryID uint64
+		ProductID  uint64
+	}
+	err := r.DB.WithContext(ctx).Raw(`
+		WITH reservations AS (`+completedReservations+`),
+		ranked AS (
+			SELECT p.category_id, p.id AS product_id,
+				ROW_NUMBER() OVER (PARTITION BY p.category_id ORDER BY SUM(s.quantity) DESC, p.id) AS row_rank
+			FROM reservations s JOIN products p ON p.id = s.product_id
+			WHERE p.status = @active AND p.deleted_at IS NULL
+			GROUP BY p.category_id, p.id
+		)
+		SELECT category_id, product_id FROM ranked WHERE row_rank <= @limit ORDER BY category_id, row_rank`,
+		map[string]interface{}{
+			"reservation":   model.MovementOrderReservation,
+			"cancellations": []string{model.MovementOrderCancellation, model.MovementOrderReturn},
+			"active":        model.ProductStatusActive,
+			"since":         since,
+			"limit":         limit,
+		}).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	popular := make(map[uint64][]uint64)
+	for _, row := range rows {
+		popular[row.CategoryID] = append(popular[row.CategoryID], row.ProductID)
+	}
+	return popular, nil
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RelatedProductCache keeps the precomputed "customers also bought" and popular-in-category ProductIDs in Redis.
+// Keys expire after TTL, so products that are no longer bought together drop out when a batch skips them.
+type RelatedProductCache struct {
+	Client *redis.Client
+	TTL    time.Duration
+}
+
+// NewRelatedProductCache create new RelatedProductCache, mainly used for ProductService
+func NewRelatedProductCache(client *redis.Client, ttl time.Duration) *RelatedProductCache {
+	return &RelatedProductCache{
+		Client: client,
+		TTL:    ttl,
+	}
+}
+
+func relatedProductsKey(productID uint64) string {
+	return "related:product:" + strconv.FormatUint(productID, 10)
+}
+
+func popularProductsKey(categoryID uint64) string {
+	return "popular:category:" + strconv.FormatUint(categoryID, 10)
+}
+
+// SetRelatedProducts save related ProductIDs of each product
+func (c *RelatedProductCache) SetRelatedProducts(ctx context.Context, related map[uint64][]uint64) error {
+	return c.setAll(ctx, related, relatedProductsKey)
+}
+
+// SetPopularProducts save popular ProductIDs of each category
+func (c *RelatedProductCache) SetPopularProducts(ctx context.Context, popular map[uint64][]uint64) error {
+	return c.setAll(ctx, popular, popularProductsKey)
+}
+
+// GetRelatedProducts get related ProductIDs of product, nil when they are not precomputed
+func (c *RelatedProductCache) GetRelatedProducts(ctx context.Context, productID uint64) ([]uint64, error) {
+	return c.get(ctx, relatedProductsKey(productID))
+}
+
+// GetPopularProducts get popular ProductIDs of category, nil when they are not precomputed
+func (c *RelatedProductCache) GetPopularProducts(ctx context.Context, categoryID uint64) ([]uint64, error) {
+	return c.get(ctx, popularProductsKey(categoryID))
+}
+
+func (c *RelatedProductCache) setAll(ctx context.Context, values map[uint64][]uint64, key func(uint64) string) error {
+	if c.Client == nil || len(values) == 0 {
+		return nil
+	}
+
+	pipe := c.Client.Pipeline()
+	for id, productIDs := range values {
+		value, err := json.Marshal(productIDs)
+		if err != nil {
+			return err
+		}
+		pipe.Set(ctx, key(id), value, c.TTL)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (c *RelatedProductCache) get(ctx context.Context, key string) ([]uint64, error) {
+	if c.Client == nil {
+		return nil, nil
+	}
+
+	value, err := c.Client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var productIDs []uint64
+	if err := json.Unmarshal(value, &productIDs); err != nil {
+		return nil, err
+	}
+	return productIDs, nil
+}
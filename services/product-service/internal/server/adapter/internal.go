@@ -7,9 +7,11 @@ package adapter
 import (
 	"encoding/json"
 	"product-service/pkg/dto"
+	"product-service/pkg/money"
 	"product-service/pkg/pb"
 
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/datatypes"
 )
 
@@ -34,18 +36,33 @@ func JSONToStructPB(j datatypes.JSON) (*structpb.Struct, error) {
 	return structpb.NewStruct(m)
 }
 
+func MoneyProtoToDTO(m *productpb.Money) money.Money {
+	return money.New(m.GetAmount(), m.GetCurrency())
+}
+func MoneyDTOToProto(m money.Money) *productpb.Money {
+	return &productpb.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
 func ProductProtoToDTO(p *productpb.Product) (*dto.Product, error) {
 	attributes, err := StructPBToJSON(p.GetAttributes())
 	if err != nil {
 		return nil, err
 	}
 	return &dto.Product{
-		ID:         p.Id,
-		Name:       p.Name,
-		Price:      p.Price,
-		SellerID:   p.SellerId,
-		Inventory:  p.Inventory,
-		Attributes: attributes,
+		ID:                p.Id,
+		Name:              p.Name,
+		Price:             MoneyProtoToDTO(p.GetPrice()),
+		SellerID:          p.SellerId,
+		Inventory:         p.Inventory,
+		Attributes:        attributes,
+		SKU:               p.Sku,
+		LowStockThreshold: p.LowStockThreshold,
+		CategoryID:        p.CategoryId,
+		Status:            p.Status,
+		Version:           p.Version,
 	}, nil
 }
 func ProductDTOToProto(p *dto.Product) (*productpb.Product, error) {
@@ -57,12 +74,17 @@ func ProductDTOToProto(p *dto.Product) (*productpb.Product, error) {
 		return nil, err
 	}
 	return &productpb.Product{
-		Id:         p.ID,
-		Name:       p.Name,
-		Price:      p.Price,
-		SellerId:   p.SellerID,
-		Inventory:  p.Inventory,
-		Attributes: attributes,
+		Id:                p.ID,
+		Name:              p.Name,
+		Price:             MoneyDTOToProto(p.Price),
+		SellerId:          p.SellerID,
+		Inventory:         p.Inventory,
+		Attributes:        attributes,
+		Sku:               p.SKU,
+		LowStockThreshold: p.LowStockThreshold,
+		CategoryId:        p.CategoryID,
+		Status:            p.Status,
+		Version:           p.Version,
 	}, nil
 }
 
@@ -84,17 +106,21 @@ func CreProRequestToInput(req *productpb.CreateProductRequest) (*dto.CreateProdu
 		return nil, err
 	}
 	return &dto.CreateProductInput{
-		Name:       req.GetName(),
-		Price:      req.GetPrice(),
-		SellerID:   req.GetSellerId(),
-		Inventory:  req.GetInventory(),
-		Attributes: attributes,
+		Name:              req.GetName(),
+		Price:             MoneyProtoToDTO(req.GetPrice()),
+		SellerID:          req.GetSellerId(),
+		Inventory:         req.GetInventory(),
+		Attributes:        attributes,
+		SKU:               req.GetSku(),
+		LowStockThreshold: req.GetLowStockThreshold(),
+		CategoryID:        req.GetCategoryId(),
 	}, nil
 }
 func CreProOutputToResponse(output *dto.CreateProductOutput) (*productpb.CreateProductResponse, error) {
 	return &productpb.CreateProductResponse{
-		Message: output.Message,
-		Success: output.Success,
+		Message:     output.Message,
+		Success:     output.Success,
+		FieldErrors: FieldErrorsDTOToProto(output.FieldErrors),
 	}, nil
 }
 
@@ -110,6 +136,46 @@ func UpdProRequestToInput(req *productpb.UpdateProductRequest) (*dto.UpdateProdu
 }
 func UpdProOutputToResponse(output *dto.UpdateProductOutput) (*productpb.UpdateProductResponse, error) {
 	return &productpb.UpdateProductResponse{
+		Message:     output.Message,
+		Success:     output.Success,
+		FieldErrors: FieldErrorsDTOToProto(output.FieldErrors),
+	}, nil
+}
+
+func DelProRequestToInput(req *productpb.DeleteProductRequest) (*dto.DeleteProductInput, error) {
+	return &dto.DeleteProductInput{
+		ID:     req.GetId(),
+		UserID: req.GetUserId(),
+	}, nil
+}
+func DelProOutputToResponse(output *dto.DeleteProductOutput) (*productpb.DeleteProductResponse, error) {
+	return &productpb.DeleteProductResponse{
+		Message: output.Message,
+		Success: output.Success,
+	}, nil
+}
+
+func ArcProRequestToInput(req *productpb.ArchiveProductRequest) (*dto.ArchiveProductInput, error) {
+	return &dto.ArchiveProductInput{
+		ID:     req.GetId(),
+		UserID: req.GetUserId(),
+	}, nil
+}
+func ArcProOutputToResponse(output *dto.ArchiveProductOutput) (*productpb.ArchiveProductResponse, error) {
+	return &productpb.ArchiveProductResponse{
+		Message: output.Message,
+		Success: output.Success,
+	}, nil
+}
+
+func ResProRequestToInput(req *productpb.RestoreProductRequest) (*dto.RestoreProductInput, error) {
+	return &dto.RestoreProductInput{
+		ID:     req.GetId(),
+		UserID: req.GetUserId(),
+	}, nil
+}
+func ResProOutputToResponse(output *dto.RestoreProductOutput) (*productpb.RestoreProductResponse, error) {
+	return &productpb.RestoreProductResponse{
 		Message: output.Message,
 		Success: output.Success,
 	}, nil
@@ -152,6 +218,11 @@ func GetProsByIDOutputToResponse(output *dto.GetProductsByIDOutput) (*productpb.
 func GetProsBySelIDRequestToInput(req *productpb.GetProductsBySellerIDRequest) (*dto.GetProductsBySellerIDInput, error) {
 	return &dto.GetProductsBySellerIDInput{
 		SellerID: req.GetSellerId(),
+		Page: dto.PageQuery{
+			PageSize:  req.GetPageSize(),
+			PageToken: req.GetPageToken(),
+			Sort:      req.GetSort(),
+		},
 	}, nil
 }
 func GetProsBySelIDOutputToResponse(output *dto.GetProductsBySellerIDOutput) (*productpb.GetProductsBySellerIDResponse, error) {
@@ -160,9 +231,12 @@ func GetProsBySelIDOutputToResponse(output *dto.GetProductsBySellerIDOutput) (*p
 		return nil, err
 	}
 	return &productpb.GetProductsBySellerIDResponse{
-		Message:  output.Message,
-		Success:  output.Success,
-		Products: products,
+		Message:         output.Message,
+		Success:         output.Success,
+		Products:        products,
+		NextPageToken:   output.Page.NextPageToken,
+		TotalCount:      output.Page.TotalCount,
+		TotalIsEstimate: output.Page.TotalIsEstimate,
 	}, nil
 }
 
@@ -196,8 +270,11 @@ func GetAndDecInvByIDOutputToResponse(output *dto.GetAndDecreaseInventoryByIDOut
 
 func GetProductsRequestToInput(req *productpb.GetProductsRequest) (*dto.GetProductsInput, error) {
 	return &dto.GetProductsInput{
-		Page:     req.GetPage(),
-		PageSize: req.GetPageSize(),
+		Page: dto.PageQuery{
+			PageSize:  req.GetPageSize(),
+			PageToken: req.GetPageToken(),
+			Sort:      req.GetSort(),
+		},
 	}, nil
 }
 
@@ -207,8 +284,460 @@ func GetProductsOutputToResponse(output *dto.GetProductsOutput) (*productpb.GetP
 		return nil, err
 	}
 	return &productpb.GetProductsResponse{
+		Message:         output.Message,
+		Success:         output.Success,
+		Product:         products,
+		NextPageToken:   output.Page.NextPageToken,
+		TotalCount:      output.Page.TotalCount,
+		TotalIsEstimate: output.Page.TotalIsEstimate,
+	}, nil
+}
+
+func ImportJobDTOToProto(job *dto.ImportJob) *productpb.ImportJob {
+	if job == nil {
+		return nil
+	}
+	var rowErrors []*productpb.ImportRowError
+	for _, rowError := range job.Errors {
+		rowErrors = append(rowErrors, &productpb.ImportRowError{
+			Row:     rowError.Row,
+			Sku:     rowError.SKU,
+			Field:   rowError.Field,
+			Message: rowError.Message,
+		})
+	}
+	return &productpb.ImportJob{
+		Id:          job.ID,
+		SellerId:    job.SellerID,
+		Format:      job.Format,
+		Status:      job.Status,
+		TotalRows:   job.TotalRows,
+		SuccessRows: job.SuccessRows,
+		FailedRows:  job.FailedRows,
+		Errors:      rowErrors,
+		CreatedAt:   timestamppb.New(job.CreatedAt),
+		UpdatedAt:   timestamppb.New(job.UpdatedAt),
+	}
+}
+
+func ImpProsRequestToInput(req *productpb.ImportProductsRequest) (*dto.ImportProductsInput, error) {
+	return &dto.ImportProductsInput{
+		SellerID: req.GetSellerId(),
+		Format:   req.GetFormat(),
+		Data:     req.GetData(),
+	}, nil
+}
+func ImpProsOutputToResponse(output *dto.ImportProductsOutput) (*productpb.ImportProductsResponse, error) {
+	return &productpb.ImportProductsResponse{
 		Message: output.Message,
 		Success: output.Success,
-		Product: products,
+		JobId:   output.JobID,
+	}, nil
+}
+
+func GetImpJobRequestToInput(req *productpb.GetImportJobRequest) (*dto.GetImportJobInput, error) {
+	return &dto.GetImportJobInput{
+		JobID:    req.GetJobId(),
+		SellerID: req.GetSellerId(),
+	}, nil
+}
+func GetImpJobOutputToResponse(output *dto.GetImportJobOutput) (*productpb.GetImportJobResponse, error) {
+	return &productpb.GetImportJobResponse{
+		Message: output.Message,
+		Success: output.Success,
+		Job:     ImportJobDTOToProto(output.Job),
+	}, nil
+}
+
+func ExpProsRequestToInput(req *productpb.ExportProductsRequest) (*dto.ExportProductsInput, error) {
+	return &dto.ExportProductsInput{
+		SellerID: req.GetSellerId(),
+		Format:   req.GetFormat(),
+	}, nil
+}
+
+func InventoryMovementDTOToProto(movement *dto.InventoryMovement) *productpb.InventoryMovement {
+	if movement == nil {
+		return nil
+	}
+	return &productpb.InventoryMovement{
+		Id:             movement.ID,
+		ProductId:      movement.ProductID,
+		SellerId:       movement.SellerID,
+		Type:           movement.Type,
+		ReferenceId:    movement.ReferenceID,
+		Quantity:       movement.Quantity,
+		InventoryAfter: movement.InventoryAfter,
+		Reason:         movement.Reason,
+		ActorId:        movement.ActorID,
+		CreatedAt:      timestamppb.New(movement.CreatedAt),
+	}
+}
+
+func AdjInvRequestToInput(req *productpb.AdjustInventoryRequest) (*dto.AdjustInventoryInput, error) {
+	return &dto.AdjustInventoryInput{
+		ProductID: req.GetProductId(),
+		UserID:    req.GetUserId(),
+		Quantity:  req.GetQuantity(),
+		Reason:    req.GetReason(),
+	}, nil
+}
+func AdjInvOutputToResponse(output *dto.AdjustInventoryOutput) (*productpb.AdjustInventoryResponse, error) {
+	return &productpb.AdjustInventoryResponse{
+		Message:   output.Message,
+		Success:   output.Success,
+		Inventory: output.Inventory,
+	}, nil
+}
+
+func GetInvMovsRequestToInput(req *productpb.GetInventoryMovementsRequest) (*dto.GetInventoryMovementsInput, error) {
+	return &dto.GetInventoryMovementsInput{
+		SellerID:  req.GetSellerId(),
+		ProductID: req.GetProductId(),
+		Type:      req.GetType(),
+		Page:      req.GetPage(),
+		PageSize:  req.GetPageSize(),
+	}, nil
+}
+func GetInvMovsOutputToResponse(output *dto.GetInventoryMovementsOutput) (*productpb.GetInventoryMovementsResponse, error) {
+	var movements []*productpb.InventoryMovement
+	for _, movement := range output.Movements {
+		movements = append(movements, InventoryMovementDTOToProto(movement))
+	}
+	return &productpb.GetInventoryMovementsResponse{
+		Message:   output.Message,
+		Success:   output.Success,
+		Movements: movements,
+	}, nil
+}
+
+func FieldErrorsDTOToProto(fieldErrors []*dto.FieldError) []*productpb.FieldError {
+	var fieldErrorsProto []*productpb.FieldError
+	for _, fieldError := range fieldErrors {
+		fieldErrorsProto = append(fieldErrorsProto, &productpb.FieldError{
+			Field:   fieldError.Field,
+			Message: fieldError.Message,
+		})
+	}
+	return fieldErrorsProto
+}
+
+func CategoryDTOToProto(category *dto.Category) *productpb.Category {
+	if category == nil {
+		return nil
+	}
+	return &productpb.Category{
+		Id:         category.ID,
+		Name:       category.Name,
+		Attributes: AttributeDefinitionsDTOToProto(category.Attributes),
+		CreatedAt:  timestamppb.New(category.CreatedAt),
+		UpdatedAt:  timestamppb.New(category.UpdatedAt),
+	}
+}
+func CategoriesDTOToProto(categories []*dto.Category) []*productpb.Category {
+	var categoriesProto []*productpb.Category
+	for _, category := range categories {
+		categoriesProto = append(categoriesProto, CategoryDTOToProto(category))
+	}
+	return categoriesProto
+}
+
+func AttributeDefinitionsDTOToProto(attributes []*dto.AttributeDefinition) []*productpb.AttributeDefinition {
+	var attributesProto []*productpb.AttributeDefinition
+	for _, attribute := range attributes {
+		attributesProto = append(attributesProto, &productpb.AttributeDefinition{
+			Name:          attribute.Name,
+			Type:          attribute.Type,
+			AllowedValues: attribute.AllowedValues,
+			Required:      attribute.Required,
+		})
+	}
+	return attributesProto
+}
+func AttributeDefinitionsProtoToDTO(attributes []*productpb.AttributeDefinition) []*dto.AttributeDefinition {
+	var attributesDTO []*dto.AttributeDefinition
+	for _, attribute := range attributes {
+		attributesDTO = append(attributesDTO, &dto.AttributeDefinition{
+			Name:          attribute.GetName(),
+			Type:          attribute.GetType(),
+			AllowedValues: attribute.GetAllowedValues(),
+			Required:      attribute.GetRequired(),
+		})
+	}
+	return attributesDTO
+}
+
+func CreCatRequestToInput(req *productpb.CreateCategoryRequest) (*dto.CreateCategoryInput, error) {
+	return &dto.CreateCategoryInput{
+		Name:       req.GetName(),
+		Attributes: AttributeDefinitionsProtoToDTO(req.GetAttributes()),
+	}, nil
+}
+func CreCatOutputToResponse(output *dto.CreateCategoryOutput) (*productpb.CreateCategoryResponse, error) {
+	return &productpb.CreateCategoryResponse{
+		Message:     output.Message,
+		Success:     output.Success,
+		Category:    CategoryDTOToProto(output.Category),
+		FieldErrors: FieldErrorsDTOToProto(output.FieldErrors),
+	}, nil
+}
+
+func UpdCatRequestToInput(req *productpb.UpdateCategoryRequest) (*dto.UpdateCategoryInput, error) {
+	return &dto.UpdateCategoryInput{
+		ID:         req.GetId(),
+		Name:       req.GetName(),
+		Attributes: AttributeDefinitionsProtoToDTO(req.GetAttributes()),
+	}, nil
+}
+func UpdCatOutputToResponse(output *dto.UpdateCategoryOutput) (*productpb.UpdateCategoryResponse, error) {
+	return &productpb.UpdateCategoryResponse{
+		Message:     output.Message,
+		Success:     output.Success,
+		Category:    CategoryDTOToProto(output.Category),
+		FieldErrors: FieldErrorsDTOToProto(output.FieldErrors),
+	}, nil
+}
+
+func DelCatRequestToInput(req *productpb.DeleteCategoryRequest) (*dto.DeleteCategoryInput, error) {
+	return &dto.DeleteCategoryInput{
+		ID: req.GetId(),
+	}, nil
+}
+func DelCatOutputToResponse(output *dto.DeleteCategoryOutput) (*productpb.DeleteCategoryResponse, error) {
+	return &productpb.DeleteCategoryResponse{
+		Message: output.Message,
+		Success: output.Success,
+	}, nil
+}
+
+func GetCatByIDRequestToInput(req *productpb.GetCategoryByIDRequest) (*dto.GetCategoryByIDInput, error) {
+	return &dto.GetCategoryByIDInput{
+		ID: req.GetId(),
+	}, nil
+}
+func GetCatByIDOutputToResponse(output *dto.GetCategoryByIDOutput) (*productpb.GetCategoryByIDResponse, error) {
+	return &productpb.GetCategoryByIDResponse{
+		Message:  output.Message,
+		Success:  output.Success,
+		Category: CategoryDTOToProto(output.Category),
+	}, nil
+}
+
+func GetCatsRequestToInput(req *productpb.GetCategoriesRequest) (*dto.GetCategoriesInput, error) {
+	return &dto.GetCategoriesInput{}, nil
+}
+func GetCatsOutputToResponse(output *dto.GetCategoriesOutput) (*productpb.GetCategoriesResponse, error) {
+	return &productpb.GetCategoriesResponse{
+		Message:    output.Message,
+		Success:    output.Success,
+		Categories: CategoriesDTOToProto(output.Categories),
+	}, nil
+}
+
+func GetRelProsRequestToInput(req *productpb.GetRelatedProductsRequest) (*dto.GetRelatedProductsInput, error) {
+	return &dto.GetRelatedProductsInput{
+		ProductID: req.GetProductId(),
+		Limit:     req.GetLimit(),
+	}, nil
+}
+func GetRelProsOutputToResponse(output *dto.GetRelatedProductsOutput) (*productpb.GetRelatedProductsResponse, error) {
+	products, err := ProductsDTOToProto(output.Products)
+	if err != nil {
+		return nil, err
+	}
+	return &productpb.GetRelatedProductsResponse{
+		Message:  output.Message,
+		Success:  output.Success,
+		Products: products,
+	}, nil
+}
+
+func QuestionDTOToProto(question *dto.ProductQuestion) *productpb.ProductQuestion {
+	if question == nil {
+		return nil
+	}
+	return &productpb.ProductQuestion{
+		Id:        question.ID,
+		ProductId: question.ProductID,
+		BuyerId:   question.BuyerID,
+		Body:      question.Body,
+		Answers:   AnswersDTOToProto(question.Answers),
+		CreatedAt: timestamppb.New(question.CreatedAt),
+	}
+}
+func QuestionsDTOToProto(questions []*dto.ProductQuestion) []*productpb.ProductQuestion {
+	var questionsProto []*productpb.ProductQuestion
+	for _, question := range questions {
+		questionsProto = append(questionsProto, QuestionDTOToProto(question))
+	}
+	return questionsProto
+}
+
+func AnswerDTOToProto(answer *dto.ProductAnswer) *productpb.ProductAnswer {
+	if answer == nil {
+		return nil
+	}
+	return &productpb.ProductAnswer{
+		Id:          answer.ID,
+		QuestionId:  answer.QuestionID,
+		ResponderId: answer.ResponderID,
+		Body:        answer.Body,
+		CreatedAt:   timestamppb.New(answer.CreatedAt),
+	}
+}
+func AnswersDTOToProto(answers []*dto.ProductAnswer) []*productpb.ProductAnswer {
+	var answersProto []*productpb.ProductAnswer
+	for _, answer := range answers {
+		answersProto = append(answersProto, AnswerDTOToProto(answer))
+	}
+	return answersProto
+}
+
+func AskQueRequestToInput(req *productpb.AskQuestionRequest) (*dto.AskQuestionInput, error) {
+	return &dto.AskQuestionInput{
+		ProductID: req.GetProductId(),
+		BuyerID:   req.GetBuyerId(),
+		Body:      req.GetBody(),
+	}, nil
+}
+func AskQueOutputToResponse(output *dto.AskQuestionOutput) (*productpb.AskQuestionResponse, error) {
+	return &productpb.AskQuestionResponse{
+		Message:  output.Message,
+		Success:  output.Success,
+		Question: QuestionDTOToProto(output.Question),
+	}, nil
+}
+
+func AnsQueRequestToInput(req *productpb.AnswerQuestionRequest) (*dto.AnswerQuestionInput, error) {
+	return &dto.AnswerQuestionInput{
+		QuestionID: req.GetQuestionId(),
+		UserID:     req.GetUserId(),
+		Body:       req.GetBody(),
+	}, nil
+}
+func AnsQueOutputToResponse(output *dto.AnswerQuestionOutput) (*productpb.AnswerQuestionResponse, error) {
+	return &productpb.AnswerQuestionResponse{
+		Message: output.Message,
+		Success: output.Success,
+		Answer:  AnswerDTOToProto(output.Answer),
+	}, nil
+}
+
+func GetProQuesRequestToInput(req *productpb.GetProductQuestionsRequest) (*dto.GetProductQuestionsInput, error) {
+	return &dto.GetProductQuestionsInput{
+		ProductID: req.GetProductId(),
+		Page:      req.GetPage(),
+		PageSize:  req.GetPageSize(),
+	}, nil
+}
+func GetProQuesOutputToResponse(output *dto.GetProductQuestionsOutput) (*productpb.GetProductQuestionsResponse, error) {
+	return &productpb.GetProductQuestionsResponse{
+		Message:   output.Message,
+		Success:   output.Success,
+		Questions: QuestionsDTOToProto(output.Questions),
+		Total:     output.Total,
+	}, nil
+}
+
+func FlagQARequestToInput(req *productpb.FlagQARequest) (*dto.FlagQAInput, error) {
+	return &dto.FlagQAInput{
+		TargetType: req.GetTargetType(),
+		TargetID:   req.GetTargetId(),
+		UserID:     req.GetUserId(),
+		Reason:     req.GetReason(),
+	}, nil
+}
+func FlagQAOutputToResponse(output *dto.FlagQAOutput) (*productpb.FlagQAResponse, error) {
+	return &productpb.FlagQAResponse{
+		Message: output.Message,
+		Success: output.Success,
+	}, nil
+}
+
+func ModQARequestToInput(req *productpb.ModerateQARequest) (*dto.ModerateQAInput, error) {
+	return &dto.ModerateQAInput{
+		TargetType: req.GetTargetType(),
+		TargetID:   req.GetTargetId(),
+		Status:     req.GetStatus(),
+	}, nil
+}
+func ModQAOutputToResponse(output *dto.ModerateQAOutput) (*productpb.ModerateQAResponse, error) {
+	return &productpb.ModerateQAResponse{
+		Message: output.Message,
+		Success: output.Success,
+	}, nil
+}
+
+func GetFlaQARequestToInput(req *productpb.GetFlaggedQARequest) (*dto.GetFlaggedQAInput, error) {
+	return &dto.GetFlaggedQAInput{
+		TargetType: req.GetTargetType(),
+		Page:       req.GetPage(),
+		PageSize:   req.GetPageSize(),
+	}, nil
+}
+func GetFlaQAOutputToResponse(output *dto.GetFlaggedQAOutput) (*productpb.GetFlaggedQAResponse, error) {
+	var items []*productpb.FlaggedQA
+	for _, item := range output.Items {
+		items = append(items, &productpb.FlaggedQA{
+			TargetType: item.TargetType,
+			TargetId:   item.TargetID,
+			ProductId:  item.ProductID,
+			QuestionId: item.QuestionID,
+			AuthorId:   item.AuthorID,
+			Body:       item.Body,
+			FlagCount:  item.FlagCount,
+			CreatedAt:  timestamppb.New(item.CreatedAt),
+		})
+	}
+	return &productpb.GetFlaggedQAResponse{
+		Message: output.Message,
+		Success: output.Success,
+		Items:   items,
+		Total:   output.Total,
+	}, nil
+}
+
+func GetExcRatsRequestToInput(req *productpb.GetExchangeRatesRequest) (*dto.GetExchangeRatesInput, error) {
+	return &dto.GetExchangeRatesInput{}, nil
+}
+func GetExcRatsOutputToResponse(output *dto.GetExchangeRatesOutput) (*productpb.GetExchangeRatesResponse, error) {
+	var rates []*productpb.ExchangeRate
+	for _, rate := range output.Rates {
+		rates = append(rates, &productpb.ExchangeRate{
+			Currency: rate.Currency,
+			Rate:     rate.Rate,
+		})
+	}
+	response := &productpb.GetExchangeRatesResponse{
+		Message: output.Message,
+		Success: output.Success,
+		Base:    output.Base,
+		Rates:   rates,
+	}
+	if !output.UpdatedAt.IsZero() {
+		response.UpdatedAt = timestamppb.New(output.UpdatedAt)
+	}
+	return response, nil
+}
+
+func SeaProsRequestToInput(req *productpb.SearchProductsRequest) (*dto.SearchProductsInput, error) {
+	return &dto.SearchProductsInput{
+		Query:      req.GetQuery(),
+		SellerID:   req.GetSellerId(),
+		CategoryID: req.GetCategoryId(),
+		Page:       req.GetPage(),
+		PageSize:   req.GetPageSize(),
+	}, nil
+}
+func SeaProsOutputToResponse(output *dto.SearchProductsOutput) (*productpb.SearchProductsResponse, error) {
+	products, err := ProductsDTOToProto(output.Products)
+	if err != nil {
+		return nil, err
+	}
+	return &productpb.SearchProductsResponse{
+		Message:  output.Message,
+		Success:  output.Success,
+		Products: products,
+		Total:    output.Total,
 	}, nil
 }
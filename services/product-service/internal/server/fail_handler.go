@@ -21,6 +21,27 @@ func UpdProFailResponse(message string, err error, code codes.Code) (*productpb.
 	}, status.Error(code, err.Error())
 }
 
+func DelProFailResponse(message string, err error, code codes.Code) (*productpb.DeleteProductResponse, error) {
+	return &productpb.DeleteProductResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func ArcProFailResponse(message string, err error, code codes.Code) (*productpb.ArchiveProductResponse, error) {
+	return &productpb.ArchiveProductResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func ResProFailResponse(message string, err error, code codes.Code) (*productpb.RestoreProductResponse, error) {
+	return &productpb.RestoreProductResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
 func GetProByIDFailResponse(message string, err error, code codes.Code) (*productpb.GetProductByIDResponse, error) {
 	return &productpb.GetProductByIDResponse{
 		Message: message,
@@ -66,3 +87,142 @@ func GetProductsFailResponse(message string, err error, code codes.Code) (*produ
 		Success: false,
 	}, status.Error(code, err.Error())
 }
+
+func ImpProsFailResponse(message string, err error, code codes.Code) (*productpb.ImportProductsResponse, error) {
+	return &productpb.ImportProductsResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func GetImpJobFailResponse(message string, err error, code codes.Code) (*productpb.GetImportJobResponse, error) {
+	return &productpb.GetImportJobResponse{
+		Message: message,
+		Success: false,
+		Job:     nil,
+	}, status.Error(code, err.Error())
+}
+
+func AdjInvFailResponse(message string, err error, code codes.Code) (*productpb.AdjustInventoryResponse, error) {
+	return &productpb.AdjustInventoryResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func GetInvMovsFailResponse(message string, err error, code codes.Code) (*productpb.GetInventoryMovementsResponse, error) {
+	return &productpb.GetInventoryMovementsResponse{
+		Message:   message,
+		Success:   false,
+		Movements: nil,
+	}, status.Error(code, err.Error())
+}
+
+func CreCatFailResponse(message string, err error, code codes.Code) (*productpb.CreateCategoryResponse, error) {
+	return &productpb.CreateCategoryResponse{
+		Message:  message,
+		Success:  false,
+		Category: nil,
+	}, status.Error(code, err.Error())
+}
+
+func UpdCatFailResponse(message string, err error, code codes.Code) (*productpb.UpdateCategoryResponse, error) {
+	return &productpb.UpdateCategoryResponse{
+		Message:  message,
+		Success:  false,
+		Category: nil,
+	}, status.Error(code, err.Error())
+}
+
+func DelCatFailResponse(message string, err error, code codes.Code) (*productpb.DeleteCategoryResponse, error) {
+	return &productpb.DeleteCategoryResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func GetCatByIDFailResponse(message string, err error, code codes.Code) (*productpb.GetCategoryByIDResponse, error) {
+	return &productpb.GetCategoryByIDResponse{
+		Message:  message,
+		Success:  false,
+		Category: nil,
+	}, status.Error(code, err.Error())
+}
+
+func GetCatsFailResponse(message string, err error, code codes.Code) (*productpb.GetCategoriesResponse, error) {
+	return &productpb.GetCategoriesResponse{
+		Message:    message,
+		Success:    false,
+		Categories: nil,
+	}, status.Error(code, err.Error())
+}
+
+func GetRelProsFailResponse(message string, err error, code codes.Code) (*productpb.GetRelatedProductsResponse, error) {
+	return &productpb.GetRelatedProductsResponse{
+		Message:  message,
+		Success:  false,
+		Products: nil,
+	}, status.Error(code, err.Error())
+}
+
+func AskQueFailResponse(message string, err error, code codes.Code) (*productpb.AskQuestionResponse, error) {
+	return &productpb.AskQuestionResponse{
+		Message:  message,
+		Success:  false,
+		Question: nil,
+	}, status.Error(code, err.Error())
+}
+
+func AnsQueFailResponse(message string, err error, code codes.Code) (*productpb.AnswerQuestionResponse, error) {
+	return &productpb.AnswerQuestionResponse{
+		Message: message,
+		Success: false,
+		Answer:  nil,
+	}, status.Error(code, err.Error())
+}
+
+func GetProQuesFailResponse(message string, err error, code codes.Code) (*productpb.GetProductQuestionsResponse, error) {
+	return &productpb.GetProductQuestionsResponse{
+		Message:   message,
+		Success:   false,
+		Questions: nil,
+	}, status.Error(code, err.Error())
+}
+
+func FlagQAFailResponse(message string, err error, code codes.Code) (*productpb.FlagQAResponse, error) {
+	return &productpb.FlagQAResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func ModQAFailResponse(message string, err error, code codes.Code) (*productpb.ModerateQAResponse, error) {
+	return &productpb.ModerateQAResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func GetFlaQAFailResponse(message string, err error, code codes.Code) (*productpb.GetFlaggedQAResponse, error) {
+	return &productpb.GetFlaggedQAResponse{
+		Message: message,
+		Success: false,
+		Items:   nil,
+	}, status.Error(code, err.Error())
+}
+
+func GetExcRatsFailResponse(message string, err error, code codes.Code) (*productpb.GetExchangeRatesResponse, error) {
+	return &productpb.GetExchangeRatesResponse{
+		Message: message,
+		Success: false,
+		Rates:   nil,
+	}, status.Error(code, err.Error())
+}
+
+func SeaProsFailResponse(message string, err error, code codes.Code) (*productpb.SearchProductsResponse, error) {
+	return &productpb.SearchProductsResponse{
+		Message:  message,
+		Success:  false,
+		Products: nil,
+	}, status.Error(code, err.Error())
+}
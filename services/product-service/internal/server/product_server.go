@@ -2,13 +2,16 @@ package server
 
 import (
 	"context"
+	"errors"
 	"product-service/internal/server/adapter"
 	"product-service/internal/service"
 	"product-service/pkg/pb"
 
 	"buf.build/go/protovalidate"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type ProductServer struct {
@@ -89,6 +92,114 @@ func (s *ProductServer) UpdateProduct(ctx context.Context, req *productpb.Update
 	return res, nil
 }
 
+// DeleteProduct handle logic for Delete Product gRPC request in Server
+func (s *ProductServer) DeleteProduct(ctx context.Context, req *productpb.DeleteProductRequest) (*productpb.DeleteProductResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for DeleteProduct", zap.Error(err))
+		return DelProFailResponse("Invalid request for DeleteProduct", err, codes.InvalidArgument)
+	}
+	input, err := adapter.DelProRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse DeleteProduct request to input error", zap.Error(err))
+		return DelProFailResponse("Parse DeleteProduct request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.DeleteProduct(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: DeleteProduct error in ProductService", zap.Error(err))
+		return DelProFailResponse("DeleteProduct error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.DelProOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse DeleteProduct output to response error", zap.Error(err))
+		return DelProFailResponse("Parse DeleteProduct output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for DeleteProduct", zap.Error(err))
+		return DelProFailResponse("Invalid response for DeleteProduct", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// ArchiveProduct handle logic for Archive Product gRPC request in Server
+func (s *ProductServer) ArchiveProduct(ctx context.Context, req *productpb.ArchiveProductRequest) (*productpb.ArchiveProductResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for ArchiveProduct", zap.Error(err))
+		return ArcProFailResponse("Invalid request for ArchiveProduct", err, codes.InvalidArgument)
+	}
+	input, err := adapter.ArcProRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse ArchiveProduct request to input error", zap.Error(err))
+		return ArcProFailResponse("Parse ArchiveProduct request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.ArchiveProduct(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: ArchiveProduct error in ProductService", zap.Error(err))
+		return ArcProFailResponse("ArchiveProduct error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.ArcProOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse ArchiveProduct output to response error", zap.Error(err))
+		return ArcProFailResponse("Parse ArchiveProduct output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for ArchiveProduct", zap.Error(err))
+		return ArcProFailResponse("Invalid response for ArchiveProduct", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// RestoreProduct handle logic for Restore Product gRPC request in Server
+func (s *ProductServer) RestoreProduct(ctx context.Context, req *productpb.RestoreProductRequest) (*productpb.RestoreProductResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for RestoreProduct", zap.Error(err))
+		return ResProFailResponse("Invalid request for RestoreProduct", err, codes.InvalidArgument)
+	}
+	input, err := adapter.ResProRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse RestoreProduct request to input error", zap.Error(err))
+		return ResProFailResponse("Parse RestoreProduct request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.RestoreProduct(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: RestoreProduct error in ProductService", zap.Error(err))
+		return ResProFailResponse("RestoreProduct error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.ResProOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse RestoreProduct output to response error", zap.Error(err))
+		return ResProFailResponse("Parse RestoreProduct output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for RestoreProduct", zap.Error(err))
+		return ResProFailResponse("Invalid response for RestoreProduct", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
 // GetProductByID handle logic for Get Product By ID gRPC request in Server
 func (s *ProductServer) GetProductByID(ctx context.Context, req *productpb.GetProductByIDRequest) (*productpb.GetProductByIDResponse, error) {
 
@@ -304,3 +415,679 @@ func (s *ProductServer) GetProducts(ctx context.Context, req *productpb.GetProdu
 	// Return valid response
 	return res, nil
 }
+
+// ImportProducts handle logic for Import Products gRPC request in Server
+func (s *ProductServer) ImportProducts(ctx context.Context, req *productpb.ImportProductsRequest) (*productpb.ImportProductsResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for ImportProducts", zap.Error(err))
+		return ImpProsFailResponse("Invalid request for ImportProducts", err, codes.InvalidArgument)
+	}
+	input, err := adapter.ImpProsRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse ImportProducts request to input error", zap.Error(err))
+		return ImpProsFailResponse("Parse ImportProducts request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.ImportProducts(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: ImportProducts error in ProductService", zap.Error(err))
+		return ImpProsFailResponse("ImportProducts error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.ImpProsOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse ImportProducts output to response error", zap.Error(err))
+		return ImpProsFailResponse("Parse ImportProducts output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for ImportProducts", zap.Error(err))
+		return ImpProsFailResponse("Invalid response for ImportProducts", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// GetImportJob handle logic for Get Import Job gRPC request in Server
+func (s *ProductServer) GetImportJob(ctx context.Context, req *productpb.GetImportJobRequest) (*productpb.GetImportJobResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for GetImportJob", zap.Error(err))
+		return GetImpJobFailResponse("Invalid request for GetImportJob", err, codes.InvalidArgument)
+	}
+	input, err := adapter.GetImpJobRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse GetImportJob request to input error", zap.Error(err))
+		return GetImpJobFailResponse("Parse GetImportJob request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.GetImportJob(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: GetImportJob error in ProductService", zap.Error(err))
+		return GetImpJobFailResponse("GetImportJob error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.GetImpJobOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse GetImportJob output to response error", zap.Error(err))
+		return GetImpJobFailResponse("Parse GetImportJob output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for GetImportJob", zap.Error(err))
+		return GetImpJobFailResponse("Invalid response for GetImportJob", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// ExportProducts handle logic for Export Products gRPC request in Server, the file is streamed in chunks
+func (s *ProductServer) ExportProducts(req *productpb.ExportProductsRequest, stream grpc.ServerStreamingServer[productpb.ExportProductsChunk]) error {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for ExportProducts", zap.Error(err))
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	input, err := adapter.ExpProsRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse ExportProducts request to input error", zap.Error(err))
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Send each chunk from ProductService
+	send := func(data []byte) error {
+		return stream.Send(&productpb.ExportProductsChunk{Data: data})
+	}
+	if err := s.ProductService.ExportProducts(stream.Context(), input, send); err != nil {
+		s.ZapLogger.Warn("ProductServer: ExportProducts error in ProductService", zap.Error(err))
+		if errors.Is(err, service.ErrActionNotAllowed) {
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// AdjustInventory handle logic for Adjust Inventory gRPC request in Server
+func (s *ProductServer) AdjustInventory(ctx context.Context, req *productpb.AdjustInventoryRequest) (*productpb.AdjustInventoryResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for AdjustInventory", zap.Error(err))
+		return AdjInvFailResponse("Invalid request for AdjustInventory", err, codes.InvalidArgument)
+	}
+	input, err := adapter.AdjInvRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse AdjustInventory request to input error", zap.Error(err))
+		return AdjInvFailResponse("Parse AdjustInventory request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.AdjustInventory(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: AdjustInventory error in ProductService", zap.Error(err))
+		return AdjInvFailResponse("AdjustInventory error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.AdjInvOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse AdjustInventory output to response error", zap.Error(err))
+		return AdjInvFailResponse("Parse AdjustInventory output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for AdjustInventory", zap.Error(err))
+		return AdjInvFailResponse("Invalid response for AdjustInventory", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// GetInventoryMovements handle logic for Get Inventory Movements gRPC request in Server
+func (s *ProductServer) GetInventoryMovements(ctx context.Context, req *productpb.GetInventoryMovementsRequest) (*productpb.GetInventoryMovementsResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for GetInventoryMovements", zap.Error(err))
+		return GetInvMovsFailResponse("Invalid request for GetInventoryMovements", err, codes.InvalidArgument)
+	}
+	input, err := adapter.GetInvMovsRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse GetInventoryMovements request to input error", zap.Error(err))
+		return GetInvMovsFailResponse("Parse GetInventoryMovements request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.GetInventoryMovements(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: GetInventoryMovements error in ProductService", zap.Error(err))
+		return GetInvMovsFailResponse("GetInventoryMovements error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.GetInvMovsOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse GetInventoryMovements output to response error", zap.Error(err))
+		return GetInvMovsFailResponse("Parse GetInventoryMovements output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for GetInventoryMovements", zap.Error(err))
+		return GetInvMovsFailResponse("Invalid response for GetInventoryMovements", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// CreateCategory handle logic for Create Category gRPC request in Server
+func (s *ProductServer) CreateCategory(ctx context.Context, req *productpb.CreateCategoryRequest) (*productpb.CreateCategoryResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for CreateCategory", zap.Error(err))
+		return CreCatFailResponse("Invalid request for CreateCategory", err, codes.InvalidArgument)
+	}
+	input, err := adapter.CreCatRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse CreateCategory request to input error", zap.Error(err))
+		return CreCatFailResponse("Parse CreateCategory request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.CreateCategory(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: CreateCategory error in ProductService", zap.Error(err))
+		return CreCatFailResponse("CreateCategory error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.CreCatOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse CreateCategory output to response error", zap.Error(err))
+		return CreCatFailResponse("Parse CreateCategory output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for CreateCategory", zap.Error(err))
+		return CreCatFailResponse("Invalid response for CreateCategory", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// UpdateCategory handle logic for Update Category gRPC request in Server
+func (s *ProductServer) UpdateCategory(ctx context.Context, req *productpb.UpdateCategoryRequest) (*productpb.UpdateCategoryResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for UpdateCategory", zap.Error(err))
+		return UpdCatFailResponse("Invalid request for UpdateCategory", err, codes.InvalidArgument)
+	}
+	input, err := adapter.UpdCatRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse UpdateCategory request to input error", zap.Error(err))
+		return UpdCatFailResponse("Parse UpdateCategory request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.UpdateCategory(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: UpdateCategory error in ProductService", zap.Error(err))
+		return UpdCatFailResponse("UpdateCategory error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.UpdCatOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse UpdateCategory output to response error", zap.Error(err))
+		return UpdCatFailResponse("Parse UpdateCategory output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for UpdateCategory", zap.Error(err))
+		return UpdCatFailResponse("Invalid response for UpdateCategory", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// DeleteCategory handle logic for Delete Category gRPC request in Server
+func (s *ProductServer) DeleteCategory(ctx context.Context, req *productpb.DeleteCategoryRequest) (*productpb.DeleteCategoryResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for DeleteCategory", zap.Error(err))
+		return DelCatFailResponse("Invalid request for DeleteCategory", err, codes.InvalidArgument)
+	}
+	input, err := adapter.DelCatRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse DeleteCategory request to input error", zap.Error(err))
+		return DelCatFailResponse("Parse DeleteCategory request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.DeleteCategory(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: DeleteCategory error in ProductService", zap.Error(err))
+		return DelCatFailResponse("DeleteCategory error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.DelCatOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse DeleteCategory output to response error", zap.Error(err))
+		return DelCatFailResponse("Parse DeleteCategory output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for DeleteCategory", zap.Error(err))
+		return DelCatFailResponse("Invalid response for DeleteCategory", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// GetCategoryByID handle logic for Get Category By ID gRPC request in Server
+func (s *ProductServer) GetCategoryByID(ctx context.Context, req *productpb.GetCategoryByIDRequest) (*productpb.GetCategoryByIDResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for GetCategoryByID", zap.Error(err))
+		return GetCatByIDFailResponse("Invalid request for GetCategoryByID", err, codes.InvalidArgument)
+	}
+	input, err := adapter.GetCatByIDRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse GetCategoryByID request to input error", zap.Error(err))
+		return GetCatByIDFailResponse("Parse GetCategoryByID request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.GetCategoryByID(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: GetCategoryByID error in ProductService", zap.Error(err))
+		return GetCatByIDFailResponse("GetCategoryByID error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.GetCatByIDOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse GetCategoryByID output to response error", zap.Error(err))
+		return GetCatByIDFailResponse("Parse GetCategoryByID output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for GetCategoryByID", zap.Error(err))
+		return GetCatByIDFailResponse("Invalid response for GetCategoryByID", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// GetCategories handle logic for Get Categories gRPC request in Server
+func (s *ProductServer) GetCategories(ctx context.Context, req *productpb.GetCategoriesRequest) (*productpb.GetCategoriesResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for GetCategories", zap.Error(err))
+		return GetCatsFailResponse("Invalid request for GetCategories", err, codes.InvalidArgument)
+	}
+	input, err := adapter.GetCatsRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse GetCategories request to input error", zap.Error(err))
+		return GetCatsFailResponse("Parse GetCategories request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.GetCategories(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: GetCategories error in ProductService", zap.Error(err))
+		return GetCatsFailResponse("GetCategories error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.GetCatsOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse GetCategories output to response error", zap.Error(err))
+		return GetCatsFailResponse("Parse GetCategories output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for GetCategories", zap.Error(err))
+		return GetCatsFailResponse("Invalid response for GetCategories", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// GetRelatedProducts handle logic for Get Related Products gRPC request in Server
+func (s *ProductServer) GetRelatedProducts(ctx context.Context, req *productpb.GetRelatedProductsRequest) (*productpb.GetRelatedProductsResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for GetRelatedProducts", zap.Error(err))
+		return GetRelProsFailResponse("Invalid request for GetRelatedProducts", err, codes.InvalidArgument)
+	}
+	input, err := adapter.GetRelProsRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse GetRelatedProducts request to input error", zap.Error(err))
+		return GetRelProsFailResponse("Parse GetRelatedProducts request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.GetRelatedProducts(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: GetRelatedProducts error in ProductService", zap.Error(err))
+		return GetRelProsFailResponse("GetRelatedProducts error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.GetRelProsOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse GetRelatedProducts output to response error", zap.Error(err))
+		return GetRelProsFailResponse("Parse GetRelatedProducts output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for GetRelatedProducts", zap.Error(err))
+		return GetRelProsFailResponse("Invalid response for GetRelatedProducts", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// AskQuestion handle logic for Ask Question gRPC request in Server
+func (s *ProductServer) AskQuestion(ctx context.Context, req *productpb.AskQuestionRequest) (*productpb.AskQuestionResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for AskQuestion", zap.Error(err))
+		return AskQueFailResponse("Invalid request for AskQuestion", err, codes.InvalidArgument)
+	}
+	input, err := adapter.AskQueRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse AskQuestion request to input error", zap.Error(err))
+		return AskQueFailResponse("Parse AskQuestion request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.AskQuestion(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: AskQuestion error in ProductService", zap.Error(err))
+		return AskQueFailResponse("AskQuestion error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.AskQueOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse AskQuestion output to response error", zap.Error(err))
+		return AskQueFailResponse("Parse AskQuestion output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for AskQuestion", zap.Error(err))
+		return AskQueFailResponse("Invalid response for AskQuestion", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// AnswerQuestion handle logic for Answer Question gRPC request in Server
+func (s *ProductServer) AnswerQuestion(ctx context.Context, req *productpb.AnswerQuestionRequest) (*productpb.AnswerQuestionResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for AnswerQuestion", zap.Error(err))
+		return AnsQueFailResponse("Invalid request for AnswerQuestion", err, codes.InvalidArgument)
+	}
+	input, err := adapter.AnsQueRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse AnswerQuestion request to input error", zap.Error(err))
+		return AnsQueFailResponse("Parse AnswerQuestion request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.AnswerQuestion(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: AnswerQuestion error in ProductService", zap.Error(err))
+		return AnsQueFailResponse("AnswerQuestion error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.AnsQueOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse AnswerQuestion output to response error", zap.Error(err))
+		return AnsQueFailResponse("Parse AnswerQuestion output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for AnswerQuestion", zap.Error(err))
+		return AnsQueFailResponse("Invalid response for AnswerQuestion", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// GetProductQuestions handle logic for Get Product Questions gRPC request in Server
+func (s *ProductServer) GetProductQuestions(ctx context.Context, req *productpb.GetProductQuestionsRequest) (*productpb.GetProductQuestionsResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for GetProductQuestions", zap.Error(err))
+		return GetProQuesFailResponse("Invalid request for GetProductQuestions", err, codes.InvalidArgument)
+	}
+	input, err := adapter.GetProQuesRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse GetProductQuestions request to input error", zap.Error(err))
+		return GetProQuesFailResponse("Parse GetProductQuestions request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.GetProductQuestions(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: GetProductQuestions error in ProductService", zap.Error(err))
+		return GetProQuesFailResponse("GetProductQuestions error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.GetProQuesOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse GetProductQuestions output to response error", zap.Error(err))
+		return GetProQuesFailResponse("Parse GetProductQuestions output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for GetProductQuestions", zap.Error(err))
+		return GetProQuesFailResponse("Invalid response for GetProductQuestions", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// FlagQA handle logic for Flag QA gRPC request in Server
+func (s *ProductServer) FlagQA(ctx context.Context, req *productpb.FlagQARequest) (*productpb.FlagQAResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for FlagQA", zap.Error(err))
+		return FlagQAFailResponse("Invalid request for FlagQA", err, codes.InvalidArgument)
+	}
+	input, err := adapter.FlagQARequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse FlagQA request to input error", zap.Error(err))
+		return FlagQAFailResponse("Parse FlagQA request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.FlagQA(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: FlagQA error in ProductService", zap.Error(err))
+		return FlagQAFailResponse("FlagQA error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.FlagQAOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse FlagQA output to response error", zap.Error(err))
+		return FlagQAFailResponse("Parse FlagQA output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for FlagQA", zap.Error(err))
+		return FlagQAFailResponse("Invalid response for FlagQA", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// ModerateQA handle logic for Moderate QA gRPC request in Server
+func (s *ProductServer) ModerateQA(ctx context.Context, req *productpb.ModerateQARequest) (*productpb.ModerateQAResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for ModerateQA", zap.Error(err))
+		return ModQAFailResponse("Invalid request for ModerateQA", err, codes.InvalidArgument)
+	}
+	input, err := adapter.ModQARequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse ModerateQA request to input error", zap.Error(err))
+		return ModQAFailResponse("Parse ModerateQA request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.ModerateQA(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: ModerateQA error in ProductService", zap.Error(err))
+		return ModQAFailResponse("ModerateQA error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.ModQAOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse ModerateQA output to response error", zap.Error(err))
+		return ModQAFailResponse("Parse ModerateQA output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for ModerateQA", zap.Error(err))
+		return ModQAFailResponse("Invalid response for ModerateQA", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// GetFlaggedQA handle logic for Get Flagged QA gRPC request in Server
+func (s *ProductServer) GetFlaggedQA(ctx context.Context, req *productpb.GetFlaggedQARequest) (*productpb.GetFlaggedQAResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for GetFlaggedQA", zap.Error(err))
+		return GetFlaQAFailResponse("Invalid request for GetFlaggedQA", err, codes.InvalidArgument)
+	}
+	input, err := adapter.GetFlaQARequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse GetFlaggedQA request to input error", zap.Error(err))
+		return GetFlaQAFailResponse("Parse GetFlaggedQA request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.GetFlaggedQA(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: GetFlaggedQA error in ProductService", zap.Error(err))
+		return GetFlaQAFailResponse("GetFlaggedQA error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.GetFlaQAOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse GetFlaggedQA output to response error", zap.Error(err))
+		return GetFlaQAFailResponse("Parse GetFlaggedQA output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for GetFlaggedQA", zap.Error(err))
+		return GetFlaQAFailResponse("Invalid response for GetFlaggedQA", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// GetExchangeRates handle logic for Get Exchange Rates gRPC request in Server
+func (s *ProductServer) GetExchangeRates(ctx context.Context, req *productpb.GetExchangeRatesRequest) (*productpb.GetExchangeRatesResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for GetExchangeRates", zap.Error(err))
+		return GetExcRatsFailResponse("Invalid request for GetExchangeRates", err, codes.InvalidArgument)
+	}
+	input, err := adapter.GetExcRatsRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse GetExchangeRates request to input error", zap.Error(err))
+		return GetExcRatsFailResponse("Parse GetExchangeRates request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.GetExchangeRates(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: GetExchangeRates error in ProductService", zap.Error(err))
+		return GetExcRatsFailResponse("GetExchangeRates error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.GetExcRatsOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse GetExchangeRates output to response error", zap.Error(err))
+		return GetExcRatsFailResponse("Parse GetExchangeRates output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for GetExchangeRates", zap.Error(err))
+		return GetExcRatsFailResponse("Invalid response for GetExchangeRates", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+// SearchProducts handle logic for Search Products gRPC request in Server
+func (s *ProductServer) SearchProducts(ctx context.Context, req *productpb.SearchProductsRequest) (*productpb.SearchProductsResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid request for SearchProducts", zap.Error(err))
+		return SeaProsFailResponse("Invalid request for SearchProducts", err, codes.InvalidArgument)
+	}
+	input, err := adapter.SeaProsRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse SearchProducts request to input error", zap.Error(err))
+		return SeaProsFailResponse("Parse SearchProducts request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.ProductService.SearchProducts(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: SearchProducts error in ProductService", zap.Error(err))
+		return SeaProsFailResponse("SearchProducts error in ProductService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.SeaProsOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Warn("ProductServer: parse SearchProducts output to response error", zap.Error(err))
+		return SeaProsFailResponse("Parse SearchProducts output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("ProductServer: invalid response for SearchProducts", zap.Error(err))
+		return SeaProsFailResponse("Invalid response for SearchProducts", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
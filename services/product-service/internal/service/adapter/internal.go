@@ -5,8 +5,17 @@
 package adapter
 
 import (
+	"encoding/json"
+	"fmt"
+	"product-service/internal/service/search"
 	"product-service/pkg/dto"
 	"product-service/pkg/model"
+	"product-service/pkg/money"
+	"product-service/pkg/outbox"
+	"sort"
+	"strings"
+
+	"gorm.io/datatypes"
 )
 
 func ProductDTOToModel(product *dto.Product) *model.Product {
@@ -14,25 +23,38 @@ func ProductDTOToModel(product *dto.Product) *model.Product {
 		return nil
 	}
 	return &model.Product{
-		ID:         product.ID,
-		Name:       product.Name,
-		Price:      product.Price,
-		SellerID:   product.SellerID,
-		Inventory:  product.Inventory,
-		Attributes: product.Attributes,
+		ID:                product.ID,
+		Name:              product.Name,
+		PriceAmount:       product.Price.Amount,
+		Currency:          product.Price.Currency,
+		SellerID:          product.SellerID,
+		Inventory:         product.Inventory,
+		Attributes:        product.Attributes,
+		SKU:               product.SKU,
+		LowStockThreshold: product.LowStockThreshold,
+		CategoryID:        product.CategoryID,
 	}
 }
 func ProductModelToDTO(product *model.Product) *dto.Product {
 	if product == nil {
 		return nil
 	}
+	status := product.Status
+	if product.DeletedAt.Valid {
+		status = model.ProductStatusDeleted
+	}
 	return &dto.Product{
-		ID:         product.ID,
-		Name:       product.Name,
-		Price:      product.Price,
-		SellerID:   product.SellerID,
-		Inventory:  product.Inventory,
-		Attributes: product.Attributes,
+		ID:                product.ID,
+		Name:              product.Name,
+		Price:             money.New(product.PriceAmount, product.Currency),
+		SellerID:          product.SellerID,
+		Inventory:         product.Inventory,
+		Attributes:        product.Attributes,
+		SKU:               product.SKU,
+		LowStockThreshold: product.LowStockThreshold,
+		CategoryID:        product.CategoryID,
+		Status:            status,
+		Version:           product.Version,
 	}
 }
 
@@ -51,3 +73,255 @@ func ProductsModelToDTO(products []*model.Product) []*dto.Product {
 	}
 	return productsDTO
 }
+
+// ProductRowToModel parse row priced in currency, the price is rejected when it has more decimals than currency
+func ProductRowToModel(row *dto.ProductRow, sellerID uint64, currency string) (*model.Product, error) {
+	if row == nil {
+		return nil, nil
+	}
+	price, err := money.Parse(row.Price.String(), currency)
+	if err != nil {
+		return nil, err
+	}
+	return &model.Product{
+		Name:        row.Name,
+		PriceAmount: price.Amount,
+		Currency:    currency,
+		SellerID:    sellerID,
+		Inventory:   row.Inventory,
+		Attributes:  row.Attributes,
+		SKU:         row.SKU,
+	}, nil
+}
+func ProductModelToRow(product *model.Product) *dto.ProductRow {
+	if product == nil {
+		return nil
+	}
+	return &dto.ProductRow{
+		SKU:        product.SKU,
+		Name:       product.Name,
+		Price:      json.Number(money.New(product.PriceAmount, product.Currency).Decimal()),
+		Currency:   product.Currency,
+		Inventory:  product.Inventory,
+		Attributes: product.Attributes,
+	}
+}
+
+func ImportJobModelToDTO(job *model.ImportJob) (*dto.ImportJob, error) {
+	if job == nil {
+		return nil, nil
+	}
+	var rowErrors []*dto.ImportRowError
+	if len(job.Errors) > 0 {
+		if err := json.Unmarshal(job.Errors, &rowErrors); err != nil {
+			return nil, err
+		}
+	}
+	return &dto.ImportJob{
+		ID:          job.ID,
+		SellerID:    job.SellerID,
+		Format:      job.Format,
+		Status:      job.Status,
+		TotalRows:   job.TotalRows,
+		SuccessRows: job.SuccessRows,
+		FailedRows:  job.FailedRows,
+		Errors:      rowErrors,
+		CreatedAt:   job.CreatedAt,
+		UpdatedAt:   job.UpdatedAt,
+	}, nil
+}
+
+func InventoryMovementModelToDTO(movement *model.InventoryMovement) *dto.InventoryMovement {
+	if movement == nil {
+		return nil
+	}
+	return &dto.InventoryMovement{
+		ID:             movement.ID,
+		ProductID:      movement.ProductID,
+		SellerID:       movement.SellerID,
+		Type:           movement.Type,
+		ReferenceID:    movement.ReferenceID,
+		Quantity:       movement.Quantity,
+		InventoryAfter: movement.InventoryAfter,
+		Reason:         movement.Reason,
+		ActorID:        movement.ActorID,
+		CreatedAt:      movement.CreatedAt,
+	}
+}
+
+func InventoryMovementsModelToDTO(movements []*model.InventoryMovement) []*dto.InventoryMovement {
+	var movementsDTO []*dto.InventoryMovement
+	for _, movement := range movements {
+		movementsDTO = append(movementsDTO, InventoryMovementModelToDTO(movement))
+	}
+	return movementsDTO
+}
+
+func CategoryModelToDTO(category *model.Category) *dto.Category {
+	if category == nil {
+		return nil
+	}
+	return &dto.Category{
+		ID:         category.ID,
+		Name:       category.Name,
+		Attributes: AttributeDefinitionsModelToDTO(category.Attributes),
+		CreatedAt:  category.CreatedAt,
+		UpdatedAt:  category.UpdatedAt,
+	}
+}
+func CategoriesModelToDTO(categories []*model.Category) []*dto.Category {
+	var categoriesDTO = make([]*dto.Category, len(categories))
+	for i, category := range categories {
+		categoriesDTO[i] = CategoryModelToDTO(category)
+	}
+	return categoriesDTO
+}
+
+func AttributeDefinitionsModelToDTO(attributes []*model.AttributeDefinition) []*dto.AttributeDefinition {
+	var attributesDTO = make([]*dto.AttributeDefinition, len(attributes))
+	for i, attribute := range attributes {
+		attributesDTO[i] = &dto.AttributeDefinition{
+			Name:          attribute.Name,
+			Type:          attribute.Type,
+			AllowedValues: attribute.AllowedValues,
+			Required:      attribute.Required,
+		}
+	}
+	return attributesDTO
+}
+func AttributeDefinitionsDTOToModel(attributes []*dto.AttributeDefinition) []*model.AttributeDefinition {
+	var attributesModel = make([]*model.AttributeDefinition, len(attributes))
+	for i, attribute := range attributes {
+		allowedValues := attribute.AllowedValues
+		if allowedValues == nil {
+			allowedValues = []string{}
+		}
+		attributesModel[i] = &model.AttributeDefinition{
+			Name:          attribute.Name,
+			Type:          attribute.Type,
+			AllowedValues: allowedValues,
+			Required:      attribute.Required,
+		}
+	}
+	return attributesModel
+}
+
+func QuestionModelToDTO(question *model.ProductQuestion) *dto.ProductQuestion {
+	if question == nil {
+		return nil
+	}
+	return &dto.ProductQuestion{
+		ID:        question.ID,
+		ProductID: question.ProductID,
+		BuyerID:   question.BuyerID,
+		Body:      question.Body,
+		Answers:   AnswersModelToDTO(question.Answers),
+		CreatedAt: question.CreatedAt,
+	}
+}
+func QuestionsModelToDTO(questions []*model.ProductQuestion) []*dto.ProductQuestion {
+	var questionsDTO = make([]*dto.ProductQuestion, len(questions))
+	for i, question := range questions {
+		questionsDTO[i] = QuestionModelToDTO(question)
+	}
+	return questionsDTO
+}
+
+func AnswerModelToDTO(answer *model.ProductAnswer) *dto.ProductAnswer {
+	if answer == nil {
+		return nil
+	}
+	return &dto.ProductAnswer{
+		ID:          answer.ID,
+		QuestionID:  answer.QuestionID,
+		ResponderID: answer.ResponderID,
+		Body:        answer.Body,
+		CreatedAt:   answer.CreatedAt,
+	}
+}
+func AnswersModelToDTO(answers []*model.ProductAnswer) []*dto.ProductAnswer {
+	var answersDTO = make([]*dto.ProductAnswer, len(answers))
+	for i, answer := range answers {
+		answersDTO[i] = AnswerModelToDTO(answer)
+	}
+	return answersDTO
+}
+
+// QuestionModelToFlaggedDTO convert a question of the moderation queue
+func QuestionModelToFlaggedDTO(question *model.ProductQuestion) *dto.FlaggedQA {
+	return &dto.FlaggedQA{
+		TargetType: model.FlagTargetQuestion,
+		TargetID:   question.ID,
+		ProductID:  question.ProductID,
+		QuestionID: question.ID,
+		AuthorID:   question.BuyerID,
+		Body:       question.Body,
+		FlagCount:  question.FlagCount,
+		CreatedAt:  question.CreatedAt,
+	}
+}
+
+// AnswerModelToFlaggedDTO convert an answer of the moderation queue, question is nil when it was deleted
+func AnswerModelToFlaggedDTO(answer *model.ProductAnswer, question *model.ProductQuestion) *dto.FlaggedQA {
+	flagged := &dto.FlaggedQA{
+		TargetType: model.FlagTargetAnswer,
+		TargetID:   answer.ID,
+		QuestionID: answer.QuestionID,
+		AuthorID:   answer.ResponderID,
+		Body:       answer.Body,
+		FlagCount:  answer.FlagCount,
+		CreatedAt:  answer.CreatedAt,
+	}
+	if question != nil {
+		flagged.ProductID = question.ProductID
+	}
+	return flagged
+}
+
+// ProductModelToSearchDocument convert product to the document of search.SearchIndex
+func ProductModelToSearchDocument(product *model.Product) *search.Document {
+	return &search.Document{
+		ID:          product.ID,
+		Name:        product.Name,
+		SKU:         product.SKU,
+		SellerID:    product.SellerID,
+		CategoryID:  product.CategoryID,
+		PriceAmount: product.PriceAmount,
+		Currency:    product.Currency,
+		Attributes:  attributesText(product.Attributes),
+		Version:     product.Version,
+	}
+}
+
+// ProductSnapshotToSearchDocument convert product of a product event to the document of search.SearchIndex
+func ProductSnapshotToSearchDocument(product *outbox.ProductSnapshot) *search.Document {
+	return &search.Document{
+		ID:          product.ID,
+		Name:        product.Name,
+		SKU:         product.SKU,
+		SellerID:    product.SellerID,
+		CategoryID:  product.CategoryID,
+		PriceAmount: product.Price.Amount,
+		Currency:    product.Price.Currency,
+		Attributes:  attributesText(product.Attributes),
+		Version:     product.Version,
+	}
+}
+
+// attributesText join attribute values sorted by name, invalid attributes are not searchable
+func attributesText(attributes datatypes.JSON) string {
+	var values map[string]any
+	if err := json.Unmarshal(attributes, &values); err != nil {
+		return ""
+	}
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	texts := make([]string, 0, len(names))
+	for _, name := range names {
+		texts = append(texts, fmt.Sprint(values[name]))
+	}
+	return strings.Join(texts, " ")
+}
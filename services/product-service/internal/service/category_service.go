@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"product-service/internal/repository"
+	"product-service/internal/service/adapter"
+	"product-service/pkg/dto"
+	"product-service/pkg/model"
+	"slices"
+	"sort"
+
+	"go.uber.org/zap"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// CreateCategory handle logic for Create Category gRPC request in Service
+func (s *ProductService) CreateCategory(ctx context.Context, input *dto.CreateCategoryInput) (*dto.CreateCategoryOutput, error) {
+
+	// Validate attribute definitions
+	if fieldErrors := validateCategorySchema(input.Attributes); len(fieldErrors) > 0 {
+		return &dto.CreateCategoryOutput{
+			Message:     "Attribute definitions are invalid",
+			Success:     false,
+			FieldErrors: fieldErrors,
+		}, nil
+	}
+
+	// Handle in repository
+	category := &model.Category{
+		Name:       input.Name,
+		Attributes: adapter.AttributeDefinitionsDTOToModel(input.Attributes),
+	}
+	if err := s.ProductRepo.CreateCategory(ctx, category); err != nil {
+		if errors.Is(err, repository.ErrCategoryNameExists) {
+			return &dto.CreateCategoryOutput{
+				Message:     "Category name already exists",
+				Success:     false,
+				FieldErrors: []*dto.FieldError{{Field: "name", Message: "already exists"}},
+			}, nil
+		}
+		s.ZapLogger.Warn("ProductService: failed to create category", zap.Error(err))
+		return nil, err
+	}
+	return &dto.CreateCategoryOutput{
+		Message:  "Category created successfully",
+		Success:  true,
+		Category: adapter.CategoryModelToDTO(category),
+	}, nil
+}
+
+// UpdateCategory handle logic for Update Category gRPC request in Service
+func (s *ProductService) UpdateCategory(ctx context.Context, input *dto.UpdateCategoryInput) (*dto.UpdateCategoryOutput, error) {
+
+	// Validate attribute definitions
+	if fieldErrors := validateCategorySchema(input.Attributes); len(fieldErrors) > 0 {
+		return &dto.UpdateCategoryOutput{
+			Message:     "Attribute definitions are invalid",
+			Success:     false,
+			FieldErrors: fieldErrors,
+		}, nil
+	}
+
+	// Handle in repository
+	category := &model.Category{
+		ID:         input.ID,
+		Name:       input.Name,
+		Attributes: adapter.AttributeDefinitionsDTOToModel(input.Attributes),
+	}
+	if err := s.ProductRepo.UpdateCategory(ctx, category); err != nil {
+		if errors.Is(err, repository.ErrCategoryNameExists) {
+			return &dto.UpdateCategoryOutput{
+				Message:     "Category name already exists",
+				Success:     false,
+				FieldErrors: []*dto.FieldError{{Field: "name", Message: "already exists"}},
+			}, nil
+		}
+		s.ZapLogger.Warn("ProductService: failed to update category", zap.Error(err))
+		return nil, err
+	}
+
+	// Get updated category
+	updated, err := s.ProductRepo.GetCategoryByID(ctx, input.ID)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get category", zap.Error(err))
+		return nil, err
+	}
+	return &dto.UpdateCategoryOutput{
+		Message:  "Category updated successfully",
+		Success:  true,
+		Category: adapter.CategoryModelToDTO(updated),
+	}, nil
+}
+
+// DeleteCategory handle logic for Delete Category gRPC request in Service
+func (s *ProductService) DeleteCategory(ctx context.Context, input *dto.DeleteCategoryInput) (*dto.DeleteCategoryOutput, error) {
+
+	// Handle in repository
+	if err := s.ProductRepo.DeleteCategory(ctx, input.ID); err != nil {
+		if errors.Is(err, repository.ErrCategoryInUse) {
+			return &dto.DeleteCategoryOutput{
+				Message: "Category still has products",
+				Success: false,
+			}, nil
+		}
+		s.ZapLogger.Warn("ProductService: failed to delete category", zap.Error(err))
+		return nil, err
+	}
+	return &dto.DeleteCategoryOutput{
+		Message: "Category deleted successfully",
+		Success: true,
+	}, nil
+}
+
+// GetCategoryByID handle logic for Get Category By ID gRPC request in Service
+func (s *ProductService) GetCategoryByID(ctx context.Context, input *dto.GetCategoryByIDInput) (*dto.GetCategoryByIDOutput, error) {
+
+	// Get category from repository
+	category, err := s.ProductRepo.GetCategoryByID(ctx, input.ID)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get category", zap.Error(err))
+		return nil, err
+	}
+	return &dto.GetCategoryByIDOutput{
+		Message:  fmt.Sprintf("Get category by ID %v", input.ID),
+		Success:  true,
+		Category: adapter.CategoryModelToDTO(category),
+	}, nil
+}
+
+// GetCategories handle logic for Get Categories gRPC request in Service
+func (s *ProductService) GetCategories(ctx context.Context, input *dto.GetCategoriesInput) (*dto.GetCategoriesOutput, error) {
+
+	// Get categories from repository
+	categories, err := s.ProductRepo.GetCategories(ctx)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get categories", zap.Error(err))
+		return nil, err
+	}
+	return &dto.GetCategoriesOutput{
+		Message:    "Get categories",
+		Success:    true,
+		Categories: adapter.CategoriesModelToDTO(categories),
+	}, nil
+}
+
+// validateCategorySchema check rules of attribute definitions that protovalidate can not express
+func validateCategorySchema(attributes []*dto.AttributeDefinition) []*dto.FieldError {
+	var fieldErrors []*dto.FieldError
+	names := make(map[string]bool, len(attributes))
+	for i, attribute := range attributes {
+		field := fmt.Sprintf("attributes[%d]", i)
+		if names[attribute.Name] {
+			fieldErrors = append(fieldErrors, &dto.FieldError{Field: field + ".name", Message: "is defined more than once"})
+		}
+		names[attribute.Name] = true
+		if len(attribute.AllowedValues) > 0 && attribute.Type != model.AttributeTypeString {
+			fieldErrors = append(fieldErrors, &dto.FieldError{Field: field + ".allowed_values", Message: "is only supported for string attributes"})
+		}
+	}
+	return fieldErrors
+}
+
+// validateProductAttributes check attributes of a product against the schema of its category.
+// Attributes must be a JSON object whose keys are defined by the category, with values of the defined type.
+// Uncategorized products only need a JSON object.
+func (s *ProductService) validateProductAttributes(ctx context.Context, categoryID uint64, attributes datatypes.JSON) ([]*dto.FieldError, error) {
+	values := map[string]any{}
+	if len(attributes) > 0 && string(attributes) != "null" {
+		if err := json.Unmarshal(attributes, &values); err != nil {
+			return []*dto.FieldError{{Field: "attributes", Message: "must be a JSON object"}}, nil
+		}
+	}
+	if categoryID == 0 {
+		return nil, nil
+	}
+
+	// Get schema of category
+	category, err := s.ProductRepo.GetCategoryByID(ctx, categoryID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []*dto.FieldError{{Field: "category_id", Message: "category does not exist"}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var fieldErrors []*dto.FieldError
+	defined := make(map[string]bool, len(category.Attributes))
+	for _, attribute := range category.Attributes {
+		defined[attribute.Name] = true
+		field := "attributes." + attribute.Name
+		value, ok := values[attribute.Name]
+		if !ok || value == nil {
+			if attribute.Required {
+				fieldErrors = append(fieldErrors, &dto.FieldError{Field: field, Message: "is required"})
+			}
+			continue
+		}
+		switch attribute.Type {
+		case model.AttributeTypeString:
+			str, ok := value.(string)
+			if !ok {
+				fieldErrors = append(fieldErrors, &dto.FieldError{Field: field, Message: "must be a string"})
+			} else if len(attribute.AllowedValues) > 0 && !slices.Contains(attribute.AllowedValues, str) {
+				fieldErrors = append(fieldErrors, &dto.FieldError{Field: field, Message: fmt.Sprintf("must be one of %v", []string(attribute.AllowedValues))})
+			}
+		case model.AttributeTypeNumber:
+			if _, ok := value.(float64); !ok {
+				fieldErrors = append(fieldErrors, &dto.FieldError{Field: field, Message: "must be a number"})
+			}
+		case model.AttributeTypeBoolean:
+			if _, ok := value.(bool); !ok {
+				fieldErrors = append(fieldErrors, &dto.FieldError{Field: field, Message: "must be a boolean"})
+			}
+		}
+	}
+	for name := range values {
+		if !defined[name] {
+			fieldErrors = append(fieldErrors, &dto.FieldError{Field: "attributes." + name, Message: "is not defined for category " + category.Name})
+		}
+	}
+	sort.Slice(fieldErrors, func(i, j int) bool {
+		return fieldErrors[i].Field < fieldErrors[j].Field
+	})
+	return fieldErrors, nil
+}
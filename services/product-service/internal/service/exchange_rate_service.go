@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"product-service/pkg/dto"
+	"product-service/pkg/model"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// GetExchangeRates handle logic for Get Exchange Rates gRPC request in Service
+func (s *ProductService) GetExchangeRates(ctx context.Context, input *dto.GetExchangeRatesInput) (*dto.GetExchangeRatesOutput, error) {
+
+	// Get rates of the last refresh
+	rates, err := s.ProductRepo.GetExchangeRates(ctx)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get exchange rates", zap.Error(err))
+		return nil, err
+	}
+	if len(rates) == 0 {
+		return &dto.GetExchangeRatesOutput{
+			Message: "Exchange rates are not loaded yet",
+			Success: false,
+		}, nil
+	}
+
+	output := &dto.GetExchangeRatesOutput{
+		Message:   fmt.Sprintf("Get %v exchange rates", len(rates)),
+		Success:   true,
+		Base:      rates[0].Base,
+		UpdatedAt: rates[0].UpdatedAt,
+	}
+	for _, rate := range rates {
+		output.Rates = append(output.Rates, &dto.ExchangeRate{
+			Currency: rate.Currency,
+			Rate:     rate.Rate,
+		})
+	}
+	return output, nil
+}
+
+// validateCurrency return a field error when currency has no exchange rate, an empty currency is valid
+func (s *ProductService) validateCurrency(ctx context.Context, currency string) ([]*dto.FieldError, error) {
+	if currency == "" {
+		return nil, nil
+	}
+	exists, err := s.ProductRepo.ExistsExchangeRate(ctx, currency)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return []*dto.FieldError{{Field: "currency", Message: fmt.Sprintf("currency %s is not supported", currency)}}, nil
+	}
+	return nil, nil
+}
+
+// ExchangeRateWorker load exchange rates from the rate source, then every interval in a goroutine.
+// The first load is done before returning, so that currencies of products created at start are known.
+func (s *ProductService) ExchangeRateWorker(ctx context.Context, interval time.Duration) {
+	if err := s.refreshExchangeRates(ctx); err != nil {
+		s.ZapLogger.Warn("ProductService: error in procedure refresh exchange rates", zap.Error(err))
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			// Cancel by context
+			case <-ctx.Done():
+				s.ZapLogger.Info("ProductService: Worker refresh exchange rates stop by context")
+				return
+			// Interval time
+			case <-ticker.C:
+			}
+
+			if err := s.refreshExchangeRates(ctx); err != nil {
+				s.ZapLogger.Warn("ProductService: error in procedure refresh exchange rates", zap.Error(err))
+			}
+		}
+	}()
+}
+
+func (s *ProductService) refreshExchangeRates(ctx context.Context) error {
+	if s.RateSource == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, 9*time.Second)
+	defer cancel()
+
+	base, rates, err := s.RateSource.FetchRates(ctx)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	models := make([]*model.ExchangeRate, 0, len(rates))
+	for currency, rate := range rates {
+		models = append(models, &model.ExchangeRate{
+			Currency:  currency,
+			Base:      base,
+			Rate:      rate,
+			Source:    s.RateSource.Name(),
+			UpdatedAt: now,
+		})
+	}
+	if err := s.ProductRepo.ReplaceExchangeRates(ctx, models); err != nil {
+		return err
+	}
+
+	s.ZapLogger.Info("ProductService: refresh exchange rates success", zap.String("base", base), zap.Int("currencies", len(rates)))
+	return nil
+}
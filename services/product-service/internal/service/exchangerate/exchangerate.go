@@ -0,0 +1,69 @@
+// Package exchangerate defines where exchange rates come from. A Source is asked for all rates
+// on every refresh, so new sources only have to implement FetchRates.
+package exchangerate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Source return rates as units of each currency for one unit of base
+type Source interface {
+	Name() string
+	FetchRates(ctx context.Context) (base string, rates map[string]float64, err error)
+}
+
+// StaticFileSource read rates from a JSON file like {"base": "USD", "rates": {"VND": 25000, "EUR": 0.92}}.
+// The file is read again on every fetch, so it can be edited without restarting the service.
+type StaticFileSource struct {
+	Path string
+}
+
+// NewStaticFileSource create new StaticFileSource reading path
+func NewStaticFileSource(path string) *StaticFileSource {
+	return &StaticFileSource{Path: path}
+}
+
+func (s *StaticFileSource) Name() string {
+	return "static:" + s.Path
+}
+
+func (s *StaticFileSource) FetchRates(ctx context.Context) (string, map[string]float64, error) {
+	data, err := os.ReadFile(s.Path)
+	if err != nil {
+		return "", nil, err
+	}
+	var file struct {
+		Base  string             `json:"base"`
+		Rates map[string]float64 `json:"rates"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return "", nil, fmt.Errorf("invalid rates file %s: %v", s.Path, err)
+	}
+	return Normalize(file.Base, file.Rates)
+}
+
+// Normalize uppercase and check base and rates, the base currency is added with rate 1
+func Normalize(base string, rates map[string]float64) (string, map[string]float64, error) {
+	base = strings.ToUpper(base)
+	if len(base) != 3 {
+		return "", nil, errors.New("base currency must be a 3-letter ISO 4217 code")
+	}
+	normalized := make(map[string]float64, len(rates)+1)
+	for currency, rate := range rates {
+		currency = strings.ToUpper(currency)
+		if len(currency) != 3 {
+			return "", nil, fmt.Errorf("invalid currency %q", currency)
+		}
+		if rate <= 0 {
+			return "", nil, fmt.Errorf("rate of %s must be greater than 0", currency)
+		}
+		normalized[currency] = rate
+	}
+	normalized[base] = 1
+	return base, normalized, nil
+}
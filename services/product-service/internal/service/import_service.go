@@ -0,0 +1,314 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"product-service/internal/service/adapter"
+	"product-service/internal/service/productfile"
+	"product-service/pkg/dto"
+	"product-service/pkg/model"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// exportChunkRows is the number of products sent in each ExportProducts chunk
+const exportChunkRows = 100
+
+// ImportProducts save the file as a PENDING import job, rows are handled later by ImportJobWorker
+func (s *ProductService) ImportProducts(ctx context.Context, input *dto.ImportProductsInput) (*dto.ImportProductsOutput, error) {
+
+	// Check role of seller can create and update products
+	allowed, err := s.canActOnProduct(ctx, input.SellerID, input.SellerID, ActionProductWrite)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to check permission", zap.Error(err))
+		return nil, err
+	}
+	if !allowed {
+		return &dto.ImportProductsOutput{
+			Message: "User is not allowed to import products",
+			Success: false,
+		}, nil
+	}
+
+	// Create import job
+	job := &model.ImportJob{
+		SellerID: input.SellerID,
+		Format:   input.Format,
+		Status:   "PENDING",
+		Payload:  input.Data,
+		Errors:   []byte("[]"),
+	}
+	if err := s.ProductRepo.CreateImportJob(ctx, job); err != nil {
+		s.ZapLogger.Warn("ProductService: failed to create import job", zap.Error(err))
+		return nil, err
+	}
+	return &dto.ImportProductsOutput{
+		Message: "Import job created successfully",
+		Success: true,
+		JobID:   job.ID,
+	}, nil
+}
+
+// GetImportJob handle logic for Get Import Job gRPC request in Service
+func (s *ProductService) GetImportJob(ctx context.Context, input *dto.GetImportJobInput) (*dto.GetImportJobOutput, error) {
+
+	// Get job
+	job, err := s.ProductRepo.GetImportJobByID(ctx, input.JobID)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get import job", zap.Error(err))
+		return nil, err
+	}
+
+	// Check job was created by staff of the store of seller
+	sellerIDs, allowed, err := s.storeSellers(ctx, input.SellerID, ActionProductWrite)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to check permission", zap.Error(err))
+		return nil, err
+	}
+	if !allowed || !slices.Contains(sellerIDs, job.SellerID) {
+		s.ZapLogger.Warn("ProductService: seller is not owner of import job", zap.Uint64("jobID", job.ID))
+		return &dto.GetImportJobOutput{
+			Message: "Seller is not owner of import job",
+			Success: false,
+		}, nil
+	}
+
+	// Parse ImportJobModel to ImportJobDTO
+	jobDTO, err := adapter.ImportJobModelToDTO(job)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to parse import job", zap.Error(err))
+		return nil, err
+	}
+	return &dto.GetImportJobOutput{
+		Message: fmt.Sprintf("Get import job with id %v successfully", job.ID),
+		Success: true,
+		Job:     jobDTO,
+	}, nil
+}
+
+// ExportProducts encode all products of the store of a seller and pass them to send in chunks of exportChunkRows products
+func (s *ProductService) ExportProducts(ctx context.Context, input *dto.ExportProductsInput, send func(data []byte) error) error {
+
+	// Check role of seller can write products, any of its store
+	sellerIDs, allowed, err := s.storeSellers(ctx, input.SellerID, ActionProductWrite)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to check permission", zap.Error(err))
+		return err
+	}
+	if !allowed {
+		return ErrActionNotAllowed
+	}
+
+	// Get products
+	products, err := s.ProductRepo.GetProductsBySellerIDs(ctx, sellerIDs)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get products for export", zap.Error(err))
+		return err
+	}
+
+	// Encode and send each chunk
+	var buf bytes.Buffer
+	encoder, err := productfile.NewEncoder(input.Format, &buf)
+	if err != nil {
+		return err
+	}
+	flush := func() error {
+		if err := encoder.Flush(); err != nil {
+			return err
+		}
+		if buf.Len() == 0 {
+			return nil
+		}
+		chunk := make([]byte, buf.Len())
+		copy(chunk, buf.Bytes())
+		buf.Reset()
+		return send(chunk)
+	}
+	for i, product := range products {
+		if err := encoder.Encode(adapter.ProductModelToRow(product)); err != nil {
+			return err
+		}
+		if (i+1)%exportChunkRows == 0 {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return flush()
+}
+
+// ImportJobWorker process PENDING import jobs every interval
+func (s *ProductService) ImportJobWorker(ctx context.Context, interval time.Duration, limit int) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			// Cancel by context
+			case <-ctx.Done():
+				s.ZapLogger.Info("ProductService: Worker import products stop by context")
+				return
+			// Interval time
+			case <-ticker.C:
+				if err := s.processImportJobBatch(ctx, limit); err != nil {
+					s.ZapLogger.Warn("ProductService: error in process import job batch", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+func (s *ProductService) processImportJobBatch(ctx context.Context, limit int) error {
+	jobs, err := s.ProductRepo.GetPendingImportJobs(ctx, limit)
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, job := range jobs {
+		if err := s.processImportJob(ctx, job); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (s *ProductService) processImportJob(ctx context.Context, job *model.ImportJob) error {
+
+	// Claim job so that only one worker handle it
+	claimed, err := s.ProductRepo.ClaimImportJob(ctx, job.ID)
+	if err != nil || !claimed {
+		return err
+	}
+
+	// Rows update the products of the store of the seller, its permission may have changed since the upload
+	var rowErrors []*dto.ImportRowError
+	sellerIDs, allowed, err := s.storeSellers(ctx, job.SellerID, ActionProductWrite)
+	if err != nil || !allowed {
+		s.ZapLogger.Warn("ProductService: seller can not import products", zap.Uint64("jobID", job.ID), zap.Error(err))
+		job.Status = "FAILED"
+		rowErrors = append(rowErrors, &dto.ImportRowError{Message: "seller is not allowed to import products"})
+		return s.finishImportJob(ctx, job, rowErrors)
+	}
+
+	// Parse file, a file that can not be read fails the whole job
+	records, err := productfile.Decode(job.Format, job.Payload)
+	if err != nil {
+		job.Status = "FAILED"
+		rowErrors = append(rowErrors, &dto.ImportRowError{Message: err.Error()})
+		return s.finishImportJob(ctx, job, rowErrors)
+	}
+
+	// Upsert each valid row, invalid rows are only reported
+	job.TotalRows = uint64(len(records))
+	for _, record := range records {
+		if !record.Valid() {
+			job.FailedRows++
+			rowErrors = append(rowErrors, record.Errors...)
+			continue
+		}
+		product, err := s.importedProduct(ctx, record.Product, job.SellerID, sellerIDs)
+		if err != nil {
+			job.FailedRows++
+			rowErrors = append(rowErrors, &dto.ImportRowError{
+				Row:     record.Row,
+				SKU:     record.Product.SKU,
+				Field:   "price",
+				Message: err.Error(),
+			})
+			continue
+		}
+
+		// Currency must have a rate, existing products keep their category so their attributes must match its schema
+		fieldErrors, err := s.validateCurrency(ctx, product.Currency)
+		if err != nil {
+			s.ZapLogger.Warn("ProductService: failed to validate imported currency", zap.Uint64("jobID", job.ID), zap.Error(err))
+			fieldErrors = []*dto.FieldError{{Field: "currency", Message: "can not validate currency"}}
+		}
+		attributeErrors, err := s.validateImportedAttributes(ctx, product, sellerIDs)
+		if err != nil {
+			s.ZapLogger.Warn("ProductService: failed to validate imported attributes", zap.Uint64("jobID", job.ID), zap.Error(err))
+			attributeErrors = []*dto.FieldError{{Message: "can not validate attributes"}}
+		}
+		fieldErrors = append(fieldErrors, attributeErrors...)
+		if len(fieldErrors) > 0 {
+			job.FailedRows++
+			for _, fieldError := range fieldErrors {
+				rowErrors = append(rowErrors, &dto.ImportRowError{
+					Row:     record.Row,
+					SKU:     record.Product.SKU,
+					Field:   fieldError.Field,
+					Message: fieldError.Message,
+				})
+			}
+			continue
+		}
+		if err := s.ProductRepo.UpsertProductBySKU(ctx, product, sellerIDs, job.ID); err != nil {
+			s.ZapLogger.Warn("ProductService: failed to upsert imported product", zap.Uint64("jobID", job.ID), zap.Error(err))
+			job.FailedRows++
+			rowErrors = append(rowErrors, &dto.ImportRowError{
+				Row:     record.Row,
+				SKU:     record.Product.SKU,
+				Message: "can not save product",
+			})
+			continue
+		}
+		s.invalidateProducts(ctx, product.ID)
+		job.SuccessRows++
+	}
+
+	job.Status = "COMPLETED"
+	return s.finishImportJob(ctx, job, rowErrors)
+}
+
+func (s *ProductService) finishImportJob(ctx context.Context, job *model.ImportJob, rowErrors []*dto.ImportRowError) error {
+	if rowErrors == nil {
+		rowErrors = []*dto.ImportRowError{}
+	}
+	errorsJSON, err := json.Marshal(rowErrors)
+	if err != nil {
+		return err
+	}
+	job.Errors = errorsJSON
+	if err := s.ProductRepo.FinishImportJob(ctx, job); err != nil {
+		s.ZapLogger.Warn("ProductService: failed to save import job result", zap.Uint64("jobID", job.ID), zap.Error(err))
+		return err
+	}
+
+	s.ZapLogger.Info("ProductService: import job finished", zap.Uint64("jobID", job.ID), zap.String("status", job.Status),
+		zap.Uint64("successRows", job.SuccessRows), zap.Uint64("failedRows", job.FailedRows))
+	return nil
+}
+
+// importedProduct parse row to a product of sellerID, a row without currency is priced in the currency of the product
+// of sellerIDs with the same SKU, or DefaultCurrency for a new product
+func (s *ProductService) importedProduct(ctx context.Context, row *dto.ProductRow, sellerID uint64, sellerIDs []uint64) (*model.Product, error) {
+	currency := row.Currency
+	if currency == "" {
+		var err error
+		if currency, err = s.ProductRepo.GetCurrencyBySKU(ctx, sellerIDs, row.SKU); err != nil {
+			s.ZapLogger.Warn("ProductService: failed to get currency of imported product", zap.Error(err))
+			return nil, errors.New("can not get currency of product")
+		}
+	}
+	if currency == "" {
+		currency = model.DefaultCurrency
+	}
+	return adapter.ProductRowToModel(row, sellerID, currency)
+}
+
+// validateImportedAttributes validate attributes of an imported product against the category of the product
+// of sellerIDs with the same SKU
+func (s *ProductService) validateImportedAttributes(ctx context.Context, product *model.Product, sellerIDs []uint64) ([]*dto.FieldError, error) {
+	categoryID, err := s.ProductRepo.GetCategoryIDBySKU(ctx, sellerIDs, product.SKU)
+	if err != nil {
+		return nil, err
+	}
+	return s.validateProductAttributes(ctx, categoryID, product.Attributes)
+}
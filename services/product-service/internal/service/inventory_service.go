@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product-service/internal/repository"
+	"product-service/internal/service/adapter"
+	"product-service/pkg/dto"
+	"product-service/pkg/model"
+
+	"go.uber.org/zap"
+)
+
+// AdjustInventory handle logic for Adjust Inventory gRPC request in Service
+func (s *ProductService) AdjustInventory(ctx context.Context, input *dto.AdjustInventoryInput) (*dto.AdjustInventoryOutput, error) {
+
+	// Check user is staff of the store owning product
+	sellerID, err := s.ProductRepo.GetSellerIDByID(ctx, input.ProductID)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get product", zap.Error(err))
+		return nil, err
+	}
+	allowed, err := s.canActOnProduct(ctx, input.UserID, sellerID, ActionInventoryWrite)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to check permission", zap.Error(err))
+		return nil, err
+	}
+	if !allowed {
+		s.ZapLogger.Warn("ProductService: user can not adjust inventory", zap.Uint64("productID", input.ProductID))
+		return &dto.AdjustInventoryOutput{
+			Message: "User is not allowed to adjust inventory of product",
+			Success: false,
+		}, nil
+	}
+
+	// Change inventory and record movement
+	movement := &model.InventoryMovement{
+		ProductID: input.ProductID,
+		Type:      model.MovementManualAdjustment,
+		Quantity:  input.Quantity,
+		Reason:    input.Reason,
+		ActorID:   input.UserID,
+	}
+	if err := s.ProductRepo.AdjustInventory(ctx, movement); err != nil {
+		if errors.Is(err, repository.ErrInsufficientInventory) {
+			return &dto.AdjustInventoryOutput{
+				Message: "Inventory can not be negative",
+				Success: false,
+			}, nil
+		}
+		s.ZapLogger.Warn("ProductService: failed to adjust inventory", zap.Error(err))
+		return nil, err
+	}
+	s.invalidateProducts(ctx, input.ProductID)
+	return &dto.AdjustInventoryOutput{
+		Message:   "Inventory adjusted successfully",
+		Success:   true,
+		Inventory: movement.InventoryAfter,
+	}, nil
+}
+
+// GetInventoryMovements handle logic for Get Inventory Movements gRPC request in Service
+func (s *ProductService) GetInventoryMovements(ctx context.Context, input *dto.GetInventoryMovementsInput) (*dto.GetInventoryMovementsOutput, error) {
+
+	// Check role of user can read inventory, the ledger covers every product of its store
+	sellerIDs, allowed, err := s.storeSellers(ctx, input.SellerID, ActionInventoryRead)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to check permission", zap.Error(err))
+		return nil, err
+	}
+	if !allowed {
+		return &dto.GetInventoryMovementsOutput{
+			Message: "User is not allowed to read inventory movements",
+			Success: false,
+		}, nil
+	}
+
+	// Get movements of store
+	movements, err := s.ProductRepo.GetInventoryMovements(ctx, sellerIDs, input.ProductID, input.Type, input.Page, input.PageSize)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get inventory movements", zap.Error(err))
+		return nil, err
+	}
+
+	// Parse MovementsModel to MovementsDTO
+	movementsDTO := adapter.InventoryMovementsModelToDTO(movements)
+	return &dto.GetInventoryMovementsOutput{
+		Message:   fmt.Sprintf("Get inventory movements by sellerID %v", input.SellerID),
+		Success:   true,
+		Movements: movementsDTO,
+	}, nil
+}
@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"log"
 	"product-service/pkg/dto"
+	"product-service/pkg/model"
 	"product-service/pkg/outbox"
+	"strconv"
 	"strings"
 	"time"
 
@@ -42,6 +44,38 @@ func (s *ProductService) ValidateProductInventory(ctx context.Context, msg *kafk
 		s.ProductRepo.CreateOrUpdateValOrdEvent(s.ProductRepo.DB.WithContext(ctx), eventDTO.OrderID, false, true)
 		return err
 	}
+	productIDs := make([]uint64, 0, len(eventDTO.Items))
+	for _, item := range eventDTO.Items {
+		productIDs = append(productIDs, item.ProductID)
+	}
+	s.invalidateProducts(ctx, productIDs...)
+	return nil
+}
+
+// CancelOrderInventory restock inventory reserved by a canceled order
+func (s *ProductService) CancelOrderInventory(ctx context.Context, msg *kafka.Message) error {
+	return s.restockOrderInventory(ctx, msg, model.MovementOrderCancellation)
+}
+
+// ReturnOrderInventory restock inventory of returned order items
+func (s *ProductService) ReturnOrderInventory(ctx context.Context, msg *kafka.Message) error {
+	return s.restockOrderInventory(ctx, msg, model.MovementOrderReturn)
+}
+
+func (s *ProductService) restockOrderInventory(ctx context.Context, msg *kafka.Message, movementType string) error {
+	var eventDTO dto.RestockOrderKafkaEvent
+	if err := json.Unmarshal(msg.Value, &eventDTO); err != nil {
+		s.ZapLogger.Error("failed to unmarshal event", zap.Error(err))
+		return err
+	}
+
+	productIDs, err := s.ProductRepo.RestockOrderInventory(ctx, eventDTO.OrderID, eventDTO.Items, movementType)
+	if err != nil {
+		s.ZapLogger.Error("failed to restock order inventory", zap.Uint64("orderID", eventDTO.OrderID),
+			zap.String("type", movementType), zap.Error(err))
+		return err
+	}
+	s.invalidateProducts(ctx, productIDs...)
 	return nil
 }
 
@@ -117,6 +151,252 @@ func (s *ProductService) producerValOrdKafkaEvent(ctx context.Context, eventMode
 	return nil
 }
 
+// stockLevelTopics is the Kafka topic of each stock level event type
+var stockLevelTopics = map[string]string{
+	outbox.StockLevelLow:        "product.low_stock",
+	outbox.StockLevelOutOfStock: "product.out_of_stock",
+}
+
+func (s *ProductService) ProducerStoLevKafkaEventWorker(ctx context.Context, interval time.Duration, limit int) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			// Cancel by context
+			case <-ctx.Done():
+				s.ZapLogger.Info("ProductService: Worker send StockLevel Kafka event stop by context")
+				return
+			// Interval time
+			case <-ticker.C:
+				if err := s.producerStoLevKafkaEventBatch(ctx, limit); err != nil {
+					s.ZapLogger.Warn("ProductService: error in procedure StoLevKafkaEvent batch", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+func (s *ProductService) producerStoLevKafkaEventBatch(ctx context.Context, limit int) error {
+	// Create context for function
+	ctxEachEvent, cancel := context.WithTimeout(ctx, 9*time.Second)
+	defer cancel()
+
+	// Get models from DB
+	eventsModel, err := s.ProductRepo.GetStockLevelEventNotPublish(limit)
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, eventModel := range eventsModel {
+		if err := s.producerStoLevKafkaEvent(ctxEachEvent, eventModel); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (s *ProductService) producerStoLevKafkaEvent(ctx context.Context, eventModel *outbox.StockLevelEvent) error {
+	// Parse event model to json
+	eventJson, err := json.Marshal(&outbox.StockLevelKafkaEvent{
+		EventID:   eventModel.ID,
+		Type:      eventModel.Type,
+		ProductID: eventModel.ProductID,
+		SellerID:  eventModel.SellerID,
+		Inventory: eventModel.Inventory,
+		Threshold: eventModel.Threshold,
+		CreatedAt: eventModel.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Publish event, keyed by product so that events of a product keep their order
+	key := []byte(strconv.FormatUint(eventModel.ProductID, 10))
+	if err := s.MQProducer.Publish(ctx, &kafka.Hash{}, stockLevelTopics[eventModel.Type], key, eventJson); err != nil {
+		s.ZapLogger.Warn("ProductService: publish to Kafka failure", zap.Error(err))
+		if err2 := s.ProductRepo.UpdateStockLevelEventStatus(ctx, eventModel.ID, "FAILED"); err2 != nil {
+			s.ZapLogger.Warn("ProductService: publish to Kafka failure and can not update OutboxDB")
+			return err2
+		}
+		return err
+	}
+	// Update OutboxDB if procedure successfully
+	if err := s.ProductRepo.UpdateStockLevelEventStatus(ctx, eventModel.ID, "SUCCESS"); err != nil {
+		s.ZapLogger.Warn("ProductService: publish to Kafka success but update to OutboxDB failed")
+		return err
+	}
+
+	s.ZapLogger.Info("ProductService: publish StockLevel to Kafka success", zap.String("type", eventModel.Type),
+		zap.Uint64("productID", eventModel.ProductID))
+	return nil
+}
+
+// productEventTopics is the Kafka topic of each product event type
+var productEventTopics = map[string]string{
+	outbox.ProductCreated: "product.created",
+	outbox.ProductUpdated: "product.updated",
+	outbox.ProductDeleted: "product.deleted",
+}
+
+func (s *ProductService) ProducerProEveKafkaEventWorker(ctx context.Context, interval time.Duration, limit int) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			// Cancel by context
+			case <-ctx.Done():
+				s.ZapLogger.Info("ProductService: Worker send ProductEvent Kafka event stop by context")
+				return
+			// Interval time
+			case <-ticker.C:
+				if err := s.producerProEveKafkaEventBatch(ctx, limit); err != nil {
+					s.ZapLogger.Warn("ProductService: error in procedure ProEveKafkaEvent batch", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+func (s *ProductService) producerProEveKafkaEventBatch(ctx context.Context, limit int) error {
+	// Create context for function
+	ctxEachEvent, cancel := context.WithTimeout(ctx, 9*time.Second)
+	defer cancel()
+
+	// Get models from DB, oldest first
+	eventsModel, err := s.ProductRepo.GetProductEventNotPublish(limit)
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, eventModel := range eventsModel {
+		if err := s.producerProEveKafkaEvent(ctxEachEvent, eventModel); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (s *ProductService) producerProEveKafkaEvent(ctx context.Context, eventModel *outbox.ProductEvent) error {
+	// Parse event model to json
+	eventJson, err := json.Marshal(&outbox.ProductKafkaEvent{
+		EventID:   eventModel.ID,
+		Type:      eventModel.Type,
+		ProductID: eventModel.ProductID,
+		Version:   eventModel.Version,
+		Product:   json.RawMessage(eventModel.Snapshot),
+		CreatedAt: eventModel.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Publish event, keyed by product so that events of a product keep their order
+	key := []byte(strconv.FormatUint(eventModel.ProductID, 10))
+	if err := s.MQProducer.Publish(ctx, &kafka.Hash{}, productEventTopics[eventModel.Type], key, eventJson); err != nil {
+		s.ZapLogger.Warn("ProductService: publish to Kafka failure", zap.Error(err))
+		if err2 := s.ProductRepo.UpdateProductEventStatus(ctx, eventModel.ID, "FAILED"); err2 != nil {
+			s.ZapLogger.Warn("ProductService: publish to Kafka failure and can not update OutboxDB")
+			return err2
+		}
+		return err
+	}
+	// Update OutboxDB if procedure successfully
+	if err := s.ProductRepo.UpdateProductEventStatus(ctx, eventModel.ID, "SUCCESS"); err != nil {
+		s.ZapLogger.Warn("ProductService: publish to Kafka success but update to OutboxDB failed")
+		return err
+	}
+
+	s.ZapLogger.Info("ProductService: publish ProductEvent to Kafka success", zap.String("type", eventModel.Type),
+		zap.Uint64("productID", eventModel.ProductID), zap.Uint64("version", eventModel.Version))
+	return nil
+}
+
+// questionAnsweredTopic is the Kafka topic of question answered events
+const questionAnsweredTopic = "product.question_answered"
+
+func (s *ProductService) ProducerQueAnsKafkaEventWorker(ctx context.Context, interval time.Duration, limit int) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			// Cancel by context
+			case <-ctx.Done():
+				s.ZapLogger.Info("ProductService: Worker send QuestionAnswered Kafka event stop by context")
+				return
+			// Interval time
+			case <-ticker.C:
+				if err := s.producerQueAnsKafkaEventBatch(ctx, limit); err != nil {
+					s.ZapLogger.Warn("ProductService: error in procedure QueAnsKafkaEvent batch", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+func (s *ProductService) producerQueAnsKafkaEventBatch(ctx context.Context, limit int) error {
+	// Create context for function
+	ctxEachEvent, cancel := context.WithTimeout(ctx, 9*time.Second)
+	defer cancel()
+
+	// Get models from DB
+	eventsModel, err := s.ProductRepo.GetQuestionAnsweredEventNotPublish(limit)
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, eventModel := range eventsModel {
+		if err := s.producerQueAnsKafkaEvent(ctxEachEvent, eventModel); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (s *ProductService) producerQueAnsKafkaEvent(ctx context.Context, eventModel *outbox.QuestionAnsweredEvent) error {
+	// Parse event model to json
+	eventJson, err := json.Marshal(&outbox.QuestionAnsweredKafkaEvent{
+		EventID:     eventModel.ID,
+		QuestionID:  eventModel.QuestionID,
+		AnswerID:    eventModel.AnswerID,
+		ProductID:   eventModel.ProductID,
+		BuyerID:     eventModel.BuyerID,
+		ResponderID: eventModel.ResponderID,
+		CreatedAt:   eventModel.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Publish event, keyed by buyer so that notifications of a buyer keep their order
+	key := []byte(strconv.FormatUint(eventModel.BuyerID, 10))
+	if err := s.MQProducer.Publish(ctx, &kafka.Hash{}, questionAnsweredTopic, key, eventJson); err != nil {
+		s.ZapLogger.Warn("ProductService: publish to Kafka failure", zap.Error(err))
+		if err2 := s.ProductRepo.UpdateQuestionAnsweredEventStatus(ctx, eventModel.ID, "FAILED"); err2 != nil {
+			s.ZapLogger.Warn("ProductService: publish to Kafka failure and can not update OutboxDB")
+			return err2
+		}
+		return err
+	}
+	// Update OutboxDB if procedure successfully
+	if err := s.ProductRepo.UpdateQuestionAnsweredEventStatus(ctx, eventModel.ID, "SUCCESS"); err != nil {
+		s.ZapLogger.Warn("ProductService: publish to Kafka success but update to OutboxDB failed")
+		return err
+	}
+
+	s.ZapLogger.Info("ProductService: publish QuestionAnswered to Kafka success", zap.Uint64("questionID", eventModel.QuestionID),
+		zap.Uint64("answerID", eventModel.AnswerID))
+	return nil
+}
+
 //func (s *ProductService) UpdateStoreIDFromKafka(ctx context.Context, msg *kafka.Message) error {
 //
 //	fmt.Println("UpdateStoreIDFromKafka")
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"product-service/internal/client/authclient"
+	"slices"
+
+	"go.uber.org/zap"
+)
+
+// Actions of store staff on products, they are permissions of auth-service roles
+const (
+	ActionProductWrite   = "product:write"   // create, update
+	ActionProductDelete  = "product:delete"  // delete, archive, restore
+	ActionInventoryRead  = "inventory:read"  // read inventory movements
+	ActionInventoryWrite = "inventory:write" // manual inventory adjustment
+	ActionQuestionAnswer = "question:answer" // answer questions of buyers
+)
+
+// ErrActionNotAllowed is returned by streaming calls when the user lacks the permission for them
+var ErrActionNotAllowed = errors.New("user is not allowed to take this action")
+
+// canActOnProduct report whether user may take action on a product created by sellerID.
+// A product is owned by the store of the account that created it, so any staff of that store with the
+// permission may act. An account without store only owns the products it created itself.
+func (s *ProductService) canActOnProduct(ctx context.Context, userID, sellerID uint64, action string) (bool, error) {
+
+	// Check role of user has the permission, system and custom store roles alike
+	user, err := s.AuthClient.GetStoreIDRoleByID(ctx, &authclient.GetStoreIDRoleByIDInput{ID: userID})
+	if err != nil {
+		return false, err
+	}
+	if !slices.Contains(user.Permissions, action) {
+		s.ZapLogger.Warn("ProductService: role has no permission", zap.String("role", user.Role), zap.String("action", action))
+		return false, nil
+	}
+	if userID == sellerID {
+		return true, nil
+	}
+	if user.StoreID == 0 {
+		return false, nil
+	}
+
+	// Check user is staff of the store owning product
+	seller, err := s.AuthClient.GetStoreIDRoleByID(ctx, &authclient.GetStoreIDRoleByIDInput{ID: sellerID})
+	if err != nil {
+		return false, err
+	}
+	return seller.StoreID == user.StoreID, nil
+}
+
+// storeSellers report whether userID may take action on the products of its store, and return the accounts
+// whose products the store owns: every account of the store, or only userID for an account without store
+func (s *ProductService) storeSellers(ctx context.Context, userID uint64, action string) ([]uint64, bool, error) {
+	user, err := s.AuthClient.GetStoreIDRoleByID(ctx, &authclient.GetStoreIDRoleByIDInput{ID: userID})
+	if err != nil {
+		return nil, false, err
+	}
+	if !slices.Contains(user.Permissions, action) {
+		s.ZapLogger.Warn("ProductService: role has no permission", zap.String("role", user.Role), zap.String("action", action))
+		return nil, false, nil
+	}
+	if user.StoreID == 0 {
+		return []uint64{userID}, true, nil
+	}
+	store, err := s.AuthClient.GetStoreAccountIDs(ctx, &authclient.GetStoreAccountIDsInput{StoreID: user.StoreID})
+	if err != nil {
+		return nil, false, err
+	}
+	sellerIDs := store.UserIDs
+	if !slices.Contains(sellerIDs, userID) {
+		sellerIDs = append(sellerIDs, userID)
+	}
+	return sellerIDs, true, nil
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"product-service/internal/client/authclient"
+	authpb "product-service/pkg/client/authclient"
+	"slices"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeAuthServiceClient answers the store lookups of ProductService from accounts
+type fakeAuthServiceClient struct {
+	authpb.AuthServiceClient
+	accounts map[uint64]*authpb.GetStoreIDRoleByIDResponse
+}
+
+func (f *fakeAuthServiceClient) GetStoreIDRoleById(ctx context.Context, in *authpb.GetStoreIDRoleByIDRequest, opts ...grpc.CallOption) (*authpb.GetStoreIDRoleByIDResponse, error) {
+	account, ok := f.accounts[in.GetID()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "account not found")
+	}
+	return account, nil
+}
+
+func (f *fakeAuthServiceClient) GetStoreAccountIDs(ctx context.Context, in *authpb.GetStoreAccountIDsRequest, opts ...grpc.CallOption) (*authpb.GetStoreAccountIDsResponse, error) {
+	res := &authpb.GetStoreAccountIDsResponse{Success: true}
+	for id, account := range f.accounts {
+		if account.GetStoreId() == in.GetStoreId() {
+			res.UserIds = append(res.UserIds, id)
+		}
+	}
+	slices.Sort(res.UserIds)
+	return res, nil
+}
+
+// newPermissionTestService return a ProductService of a store 7 with an admin 1, an employee 2, a custom role 3
+// without inventory permissions, a seller 4 without store and a seller 5 of store 8
+func newPermissionTestService() *ProductService {
+	employee := []string{ActionProductWrite, ActionInventoryRead, ActionInventoryWrite, ActionQuestionAnswer}
+	admin := append([]string{ActionProductDelete}, employee...)
+	client := &fakeAuthServiceClient{accounts: map[uint64]*authpb.GetStoreIDRoleByIDResponse{
+		1: {Role: "seller_admin", StoreId: 7, Permissions: admin},
+		2: {Role: "seller_employee", StoreId: 7, Permissions: employee},
+		3: {Role: "lister", StoreId: 7, Permissions: []string{ActionProductWrite}},
+		4: {Role: "seller_admin", StoreId: 0, Permissions: admin},
+		5: {Role: "seller_admin", StoreId: 8, Permissions: admin},
+	}}
+	return &ProductService{
+		AuthClient: authclient.NewAuthClient(client, nil, zap.NewNop()),
+		ZapLogger:  zap.NewNop(),
+	}
+}
+
+func TestStoreSellers(t *testing.T) {
+	s := newPermissionTestService()
+	tests := []struct {
+		name        string
+		userID      uint64
+		action      string
+		wantSellers []uint64
+		wantAllowed bool
+	}{
+		{"employee reads ledger of store", 2, ActionInventoryRead, []uint64{1, 2, 3}, true},
+		{"admin reads ledger of store", 1, ActionInventoryRead, []uint64{1, 2, 3}, true},
+		{"custom role exports store", 3, ActionProductWrite, []uint64{1, 2, 3}, true},
+		{"custom role without inventory permission", 3, ActionInventoryRead, nil, false},
+		{"seller without store", 4, ActionInventoryRead, []uint64{4}, true},
+		{"other store", 5, ActionProductWrite, []uint64{5}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sellers, allowed, err := s.storeSellers(context.Background(), tt.userID, tt.action)
+			if err != nil {
+				t.Fatalf("storeSellers() error = %v", err)
+			}
+			if allowed != tt.wantAllowed || !slices.Equal(sellers, tt.wantSellers) {
+				t.Errorf("storeSellers() = %v, %v, want %v, %v", sellers, allowed, tt.wantSellers, tt.wantAllowed)
+			}
+		})
+	}
+
+	if _, _, err := s.storeSellers(context.Background(), 99, ActionProductWrite); err == nil {
+		t.Error("storeSellers() of unknown account succeeded")
+	}
+}
+
+func TestCanActOnProduct(t *testing.T) {
+	s := newPermissionTestService()
+	tests := []struct {
+		name     string
+		userID   uint64
+		sellerID uint64
+		action   string
+		want     bool
+	}{
+		{"own product", 4, 4, ActionProductWrite, true},
+		{"product of store staff", 2, 1, ActionProductWrite, true},
+		{"custom role on product of store", 3, 2, ActionProductWrite, true},
+		{"custom role without permission", 3, 2, ActionProductDelete, false},
+		{"employee without delete permission", 2, 1, ActionProductDelete, false},
+		{"product of other store", 5, 1, ActionProductWrite, false},
+		{"account without store on product of store", 4, 1, ActionProductWrite, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.canActOnProduct(context.Background(), tt.userID, tt.sellerID, tt.action)
+			if err != nil {
+				t.Fatalf("canActOnProduct() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("canActOnProduct() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,47 +2,100 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"product-service/internal/client/authclient"
 	"product-service/internal/config/messagequeue"
 	"product-service/internal/config/messagequeue/kafkaimpl"
 	"product-service/internal/repository"
 	"product-service/internal/service/adapter"
+	"product-service/internal/service/exchangerate"
+	"product-service/internal/service/search"
 	"product-service/pkg/dto"
 	"product-service/pkg/model"
 
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 type ProductService struct {
-	ProductRepo *repository.ProductRepository
-	MQProducer  messagequeue.Producer
-	MQConsumer  messagequeue.Consumer
-	KafkaClient *kafkaimpl.KafkaClient
-	ZapLogger   *zap.Logger
+	ProductRepo  *repository.ProductRepository
+	ProductCache *repository.ProductCache
+	RelatedCache *repository.RelatedProductCache
+	AuthClient   *authclient.AuthClient
+	RateSource   exchangerate.Source
+	SearchIndex  search.SearchIndex
+	MQProducer   messagequeue.Producer
+	MQConsumer   messagequeue.Consumer
+	KafkaClient  *kafkaimpl.KafkaClient
+	ZapLogger    *zap.Logger
 }
 
 // NewProductService create new ProductService
-func NewProductService(productRepo *repository.ProductRepository, logger *zap.Logger,
-	producer messagequeue.Producer, consumer messagequeue.Consumer, kafkaClient *kafkaimpl.KafkaClient) *ProductService {
+func NewProductService(productRepo *repository.ProductRepository, productCache *repository.ProductCache, relatedCache *repository.RelatedProductCache,
+	authClient *authclient.AuthClient, rateSource exchangerate.Source, searchIndex search.SearchIndex, logger *zap.Logger, producer messagequeue.Producer, consumer messagequeue.Consumer,
+	kafkaClient *kafkaimpl.KafkaClient) *ProductService {
 	return &ProductService{
-		ProductRepo: productRepo,
-		MQProducer:  producer,
-		MQConsumer:  consumer,
-		KafkaClient: kafkaClient,
-		ZapLogger:   logger,
+		ProductRepo:  productRepo,
+		ProductCache: productCache,
+		RelatedCache: relatedCache,
+		AuthClient:   authClient,
+		RateSource:   rateSource,
+		SearchIndex:  searchIndex,
+		MQProducer:   producer,
+		MQConsumer:   consumer,
+		KafkaClient:  kafkaClient,
+		ZapLogger:    logger,
 	}
 }
 
 // CreateProduct handle logic for Create Product gRPC request in Service
 func (s *ProductService) CreateProduct(ctx context.Context, input *dto.CreateProductInput) (*dto.CreateProductOutput, error) {
 
+	// Check role of seller can create products
+	allowed, err := s.canActOnProduct(ctx, input.SellerID, input.SellerID, ActionProductWrite)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to check permission", zap.Error(err))
+		return nil, err
+	}
+	if !allowed {
+		return &dto.CreateProductOutput{
+			Message: "User is not allowed to create product",
+			Success: false,
+		}, nil
+	}
+
+	// Validate currency has a rate and attributes against category schema
+	fieldErrors, err := s.validateCurrency(ctx, input.Price.Currency)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to validate currency", zap.Error(err))
+		return nil, err
+	}
+	attributeErrors, err := s.validateProductAttributes(ctx, input.CategoryID, input.Attributes)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to validate attributes", zap.Error(err))
+		return nil, err
+	}
+	fieldErrors = append(fieldErrors, attributeErrors...)
+	if len(fieldErrors) > 0 {
+		return &dto.CreateProductOutput{
+			Message:     "Product fields are invalid",
+			Success:     false,
+			FieldErrors: fieldErrors,
+		}, nil
+	}
+
 	// Create product
 	var product = &model.Product{
-		Name:       input.Name,
-		Price:      input.Price,
-		Inventory:  input.Inventory,
-		SellerID:   input.SellerID,
-		Attributes: input.Attributes,
+		Name:              input.Name,
+		PriceAmount:       input.Price.Amount,
+		Currency:          input.Price.Currency,
+		Inventory:         input.Inventory,
+		SellerID:          input.SellerID,
+		Attributes:        input.Attributes,
+		SKU:               input.SKU,
+		LowStockThreshold: input.LowStockThreshold,
+		CategoryID:        input.CategoryID,
 	}
 
 	// Handle in repository
@@ -66,36 +119,176 @@ func (s *ProductService) UpdateProduct(ctx context.Context, input *dto.UpdatePro
 		return nil, err
 	}
 
-	// Check user is owner of product
-	if oldProduct.SellerID != input.UserID {
-		s.ZapLogger.Error("ProductService: user is not owner of product", zap.Any("old", oldProduct.SellerID))
+	// Check user is staff of the store owning product
+	allowed, err := s.canActOnProduct(ctx, input.UserID, oldProduct.SellerID, ActionProductWrite)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to check permission", zap.Error(err))
+		return nil, err
+	}
+	if !allowed {
+		s.ZapLogger.Warn("ProductService: user can not update product", zap.Uint64("productID", input.Product.ID))
 		return &dto.UpdateProductOutput{
-			Message: fmt.Sprintf("Seller is not owner of product"),
+			Message: "User is not allowed to update product",
 			Success: false,
 		}, nil
 	}
 
+	// Validate currency and attributes against category schema, fields left empty keep their old value
+	categoryID, attributes := oldProduct.CategoryID, oldProduct.Attributes
+	if input.Product.CategoryID != 0 {
+		categoryID = input.Product.CategoryID
+	}
+	if len(input.Product.Attributes) > 0 {
+		attributes = input.Product.Attributes
+	}
+	fieldErrors, err := s.validateCurrency(ctx, input.Product.Price.Currency)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to validate currency", zap.Error(err))
+		return nil, err
+	}
+	attributeErrors, err := s.validateProductAttributes(ctx, categoryID, attributes)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to validate attributes", zap.Error(err))
+		return nil, err
+	}
+	fieldErrors = append(fieldErrors, attributeErrors...)
+	if len(fieldErrors) > 0 {
+		return &dto.UpdateProductOutput{
+			Message:     "Product fields are invalid",
+			Success:     false,
+			FieldErrors: fieldErrors,
+		}, nil
+	}
+
 	// Parse ProductModel to Product DTO
 	productModel := adapter.ProductDTOToModel(input.Product)
-	if err := s.ProductRepo.UpdateProduct(ctx, productModel); err != nil {
+	if err := s.ProductRepo.UpdateProduct(ctx, productModel, input.UserID); err != nil {
 		s.ZapLogger.Warn("ProductService: failed to update product", zap.Error(err))
 		return nil, err
 	}
+	s.invalidateProducts(ctx, productModel.ID)
 	return &dto.UpdateProductOutput{
 		Message: "Product updated successfully",
 		Success: true,
 	}, nil
 }
 
+// DeleteProduct handle logic for Delete Product gRPC request in Service
+func (s *ProductService) DeleteProduct(ctx context.Context, input *dto.DeleteProductInput) (*dto.DeleteProductOutput, error) {
+
+	// Check user is staff of the store owning product
+	product, err := s.ProductRepo.GetProductByID(ctx, input.ID)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get product", zap.Error(err))
+		return nil, err
+	}
+	allowed, err := s.canActOnProduct(ctx, input.UserID, product.SellerID, ActionProductDelete)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to check permission", zap.Error(err))
+		return nil, err
+	}
+	if !allowed {
+		s.ZapLogger.Warn("ProductService: user can not delete product", zap.Uint64("productID", input.ID))
+		return &dto.DeleteProductOutput{
+			Message: "User is not allowed to delete product",
+			Success: false,
+		}, nil
+	}
+
+	// Handle in repository
+	if err := s.ProductRepo.DeleteProduct(ctx, input.ID); err != nil {
+		s.ZapLogger.Warn("ProductService: failed to delete product", zap.Error(err))
+		return nil, err
+	}
+	s.invalidateProducts(ctx, input.ID)
+	return &dto.DeleteProductOutput{
+		Message: "Product deleted successfully",
+		Success: true,
+	}, nil
+}
+
+// ArchiveProduct handle logic for Archive Product gRPC request in Service
+func (s *ProductService) ArchiveProduct(ctx context.Context, input *dto.ArchiveProductInput) (*dto.ArchiveProductOutput, error) {
+
+	// Check user is staff of the store owning product
+	product, err := s.ProductRepo.GetProductByID(ctx, input.ID)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get product", zap.Error(err))
+		return nil, err
+	}
+	allowed, err := s.canActOnProduct(ctx, input.UserID, product.SellerID, ActionProductDelete)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to check permission", zap.Error(err))
+		return nil, err
+	}
+	if !allowed {
+		s.ZapLogger.Warn("ProductService: user can not archive product", zap.Uint64("productID", input.ID))
+		return &dto.ArchiveProductOutput{
+			Message: "User is not allowed to archive product",
+			Success: false,
+		}, nil
+	}
+
+	// Handle in repository
+	if err := s.ProductRepo.ArchiveProduct(ctx, input.ID); err != nil {
+		s.ZapLogger.Warn("ProductService: failed to archive product", zap.Error(err))
+		return nil, err
+	}
+	s.invalidateProducts(ctx, input.ID)
+	return &dto.ArchiveProductOutput{
+		Message: "Product archived successfully",
+		Success: true,
+	}, nil
+}
+
+// RestoreProduct handle logic for Restore Product gRPC request in Service
+func (s *ProductService) RestoreProduct(ctx context.Context, input *dto.RestoreProductInput) (*dto.RestoreProductOutput, error) {
+
+	// Check user is staff of the store owning product
+	product, err := s.ProductRepo.GetProductByIDUnscoped(ctx, input.ID)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get product", zap.Error(err))
+		return nil, err
+	}
+	allowed, err := s.canActOnProduct(ctx, input.UserID, product.SellerID, ActionProductDelete)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to check permission", zap.Error(err))
+		return nil, err
+	}
+	if !allowed {
+		s.ZapLogger.Warn("ProductService: user can not restore product", zap.Uint64("productID", input.ID))
+		return &dto.RestoreProductOutput{
+			Message: "User is not allowed to restore product",
+			Success: false,
+		}, nil
+	}
+
+	// Handle in repository
+	if err := s.ProductRepo.RestoreProduct(ctx, input.ID); err != nil {
+		s.ZapLogger.Warn("ProductService: failed to restore product", zap.Error(err))
+		return nil, err
+	}
+	s.invalidateProducts(ctx, input.ID)
+	return &dto.RestoreProductOutput{
+		Message: "Product restored successfully",
+		Success: true,
+	}, nil
+}
+
 // GetProductByID handle logic for Get Product By ID gRPC request in Service
 func (s *ProductService) GetProductByID(ctx context.Context, input *dto.GetProductByIDInput) (*dto.GetProductByIDOutput, error) {
 
-	// Get product
-	product, err := s.ProductRepo.GetProductByID(ctx, input.ID)
+	// Get product, a deleted product is only returned by GetProductsByID
+	products, err := s.getProductsByID(ctx, []uint64{input.ID})
 	if err != nil {
 		s.ZapLogger.Warn("ProductService: failed to get product", zap.Error(err))
 		return nil, err
 	}
+	if len(products) == 0 || products[0].DeletedAt.Valid {
+		s.ZapLogger.Warn("ProductService: failed to get product", zap.Error(gorm.ErrRecordNotFound))
+		return nil, gorm.ErrRecordNotFound
+	}
+	product := products[0]
 
 	// Parse ProductModel to ProductDTO
 	productDTO := adapter.ProductModelToDTO(product)
@@ -110,7 +303,7 @@ func (s *ProductService) GetProductByID(ctx context.Context, input *dto.GetProdu
 func (s *ProductService) GetProductsByID(ctx context.Context, input *dto.GetProductsByIDInput) (*dto.GetProductsByIDOutput, error) {
 
 	// Get product
-	products, err := s.ProductRepo.GetProductsByID(ctx, input.IDs)
+	products, err := s.getProductsByID(ctx, input.IDs)
 	if err != nil {
 		s.ZapLogger.Warn("ProductService: failed to get products", zap.Error(err))
 		return nil, err
@@ -129,7 +322,13 @@ func (s *ProductService) GetProductsByID(ctx context.Context, input *dto.GetProd
 func (s *ProductService) GetProductsBySellerID(ctx context.Context, input *dto.GetProductsBySellerIDInput) (*dto.GetProductsBySellerIDOutput, error) {
 
 	// Get products
-	products, err := s.ProductRepo.GetProductsBySellerID(ctx, input.SellerID)
+	products, page, err := s.ProductRepo.GetProductsPageBySellerID(ctx, input.SellerID, input.Page)
+	if errors.Is(err, repository.ErrInvalidPageToken) {
+		return &dto.GetProductsBySellerIDOutput{
+			Message: "Invalid page token",
+			Success: false,
+		}, nil
+	}
 	if err != nil {
 		s.ZapLogger.Warn("ProductService: failed to get products", zap.Error(err))
 		return nil, err
@@ -141,6 +340,7 @@ func (s *ProductService) GetProductsBySellerID(ctx context.Context, input *dto.G
 		Message:  fmt.Sprintf("Get products by sellerID %v", input.SellerID),
 		Success:  true,
 		Products: productsDTO,
+		Page:     *page,
 	}, nil
 }
 
@@ -164,10 +364,11 @@ func (s *ProductService) GetInventoryByID(ctx context.Context, input *dto.GetInv
 func (s *ProductService) GetAndDecreaseInventoryByID(ctx context.Context, input *dto.GetAndDecreaseInventoryByIDInput) (*dto.GetAndDecreaseInventoryByIDOutput, error) {
 
 	// Get and decrease inventory
-	if err := s.ProductRepo.GetAndDecreaseInventoryByID(ctx, input.ID, input.Quantity); err != nil {
+	if err := s.ProductRepo.GetAndDecreaseInventoryByID(ctx, input.ID, input.Quantity, input.UserID); err != nil {
 		s.ZapLogger.Warn("ProductService: failed to decrease inventory", zap.Error(err))
 		return nil, err
 	}
+	s.invalidateProducts(ctx, input.ID)
 	return &dto.GetAndDecreaseInventoryByIDOutput{
 		Message: "Product decreased successfully",
 		Success: true,
@@ -178,7 +379,13 @@ func (s *ProductService) GetAndDecreaseInventoryByID(ctx context.Context, input
 func (s *ProductService) GetProducts(ctx context.Context, input *dto.GetProductsInput) (*dto.GetProductsOutput, error) {
 
 	// Get Products
-	products, err := s.ProductRepo.GetProducts(ctx, input.Page, input.PageSize)
+	products, page, err := s.ProductRepo.GetProducts(ctx, input.Page)
+	if errors.Is(err, repository.ErrInvalidPageToken) {
+		return &dto.GetProductsOutput{
+			Message: "Invalid page token",
+			Success: false,
+		}, nil
+	}
 	if err != nil {
 		s.ZapLogger.Warn("ProductService: failed to get products", zap.Error(err))
 		return nil, err
@@ -188,5 +395,52 @@ func (s *ProductService) GetProducts(ctx context.Context, input *dto.GetProducts
 		Message:  "Get products successfully",
 		Success:  true,
 		Products: productsDTO,
+		Page:     *page,
 	}, nil
 }
+
+// getProductsByID get products from cache, products not in cache are read from DB and cached.
+// Products are returned in the order of productIDs, missing ones are left out. Cache errors are logged and fall back to DB.
+func (s *ProductService) getProductsByID(ctx context.Context, productIDs []uint64) ([]*model.Product, error) {
+	cached, missing, err := s.ProductCache.GetProducts(ctx, productIDs)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get products from cache", zap.Error(err))
+	}
+	if len(missing) == 0 {
+		return productsInOrder(productIDs, cached, nil), nil
+	}
+
+	productsDB, err := s.ProductRepo.GetProductsByID(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ProductCache.SetProducts(ctx, productsDB); err != nil {
+		s.ZapLogger.Warn("ProductService: failed to cache products", zap.Error(err))
+	}
+	return productsInOrder(productIDs, cached, productsDB), nil
+}
+
+// productsInOrder return the products of productIDs found in cached or productsDB, in the order of productIDs
+func productsInOrder(productIDs []uint64, cached map[uint64]*model.Product, productsDB []*model.Product) []*model.Product {
+	found := make(map[uint64]*model.Product, len(cached)+len(productsDB))
+	for productID, product := range cached {
+		found[productID] = product
+	}
+	for _, product := range productsDB {
+		found[product.ID] = product
+	}
+	products := make([]*model.Product, 0, len(productIDs))
+	for _, productID := range productIDs {
+		if product, ok := found[productID]; ok {
+			products = append(products, product)
+		}
+	}
+	return products
+}
+
+// invalidateProducts remove changed products from cache, the cache TTL bounds staleness if it fails
+func (s *ProductService) invalidateProducts(ctx context.Context, productIDs ...uint64) {
+	if err := s.ProductCache.Invalidate(ctx, productIDs...); err != nil {
+		s.ZapLogger.Warn("ProductService: failed to invalidate product cache", zap.Uint64s("productIDs", productIDs), zap.Error(err))
+	}
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"product-service/pkg/model"
+	"slices"
+	"testing"
+)
+
+func TestProductsInOrder(t *testing.T) {
+	cached := map[uint64]*model.Product{3: {ID: 3}, 7: {ID: 7}}
+	productsDB := []*model.Product{{ID: 1}, {ID: 5}}
+	tests := []struct {
+		name       string
+		productIDs []uint64
+		cached     map[uint64]*model.Product
+		productsDB []*model.Product
+		want       []uint64
+	}{
+		{"cached and read mixed", []uint64{5, 3, 1, 7}, cached, productsDB, []uint64{5, 3, 1, 7}},
+		{"all cached", []uint64{7, 3}, cached, nil, []uint64{7, 3}},
+		{"all read", []uint64{5, 1}, nil, productsDB, []uint64{5, 1}},
+		{"missing left out", []uint64{9, 1, 8, 3}, cached, productsDB, []uint64{1, 3}},
+		{"repeated ID", []uint64{3, 5, 3}, cached, productsDB, []uint64{3, 5, 3}},
+		{"no IDs", nil, cached, productsDB, []uint64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []uint64{}
+			for _, product := range productsInOrder(tt.productIDs, tt.cached, tt.productsDB) {
+				got = append(got, product.ID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("productsInOrder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
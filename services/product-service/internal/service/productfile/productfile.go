@@ -0,0 +1,278 @@
+// Package productfile reads and writes product rows in the formats used by
+// bulk import and export: CSV with a header line, and JSON Lines.
+package productfile
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"product-service/pkg/dto"
+	"strconv"
+	"strings"
+)
+
+const (
+	FormatCSV   = "csv"
+	FormatJSONL = "jsonl"
+
+	maxSKULen     = 64
+	maxLineLength = 1 << 20
+)
+
+var csvHeader = []string{"sku", "name", "price", "inventory", "attributes", "currency"}
+
+// Record is one data row of an import file, with the errors found while parsing and validating it
+type Record struct {
+	Row     uint64
+	Product *dto.ProductRow
+	Errors  []*dto.ImportRowError
+}
+
+func (r *Record) addError(field, message string) {
+	sku := ""
+	if r.Product != nil {
+		sku = r.Product.SKU
+	}
+	r.Errors = append(r.Errors, &dto.ImportRowError{
+		Row:     r.Row,
+		SKU:     sku,
+		Field:   field,
+		Message: message,
+	})
+}
+
+// Valid return true if the record has no error
+func (r *Record) Valid() bool {
+	return len(r.Errors) == 0
+}
+
+// Decode parse data into records, row numbers start at 1 and do not count the CSV header.
+// Only errors that make the whole file unreadable are returned, row errors are kept in each Record.
+func Decode(format string, data []byte) ([]*Record, error) {
+	switch format {
+	case FormatCSV:
+		return decodeCSV(data)
+	case FormatJSONL:
+		return decodeJSONL(data)
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+func decodeCSV(data []byte) ([]*Record, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	// Map header columns, column order is free but the required ones must exist
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("file is empty")
+		}
+		return nil, fmt.Errorf("can not read header: %v", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range csvHeader[:4] {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing column %q in header", name)
+		}
+	}
+
+	var records []*Record
+	var row uint64
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		row++
+		record := &Record{Row: row, Product: &dto.ProductRow{}}
+		records = append(records, record)
+		if err != nil {
+			record.addError("", err.Error())
+			continue
+		}
+
+		get := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(fields) {
+				return ""
+			}
+			return strings.TrimSpace(fields[i])
+		}
+		record.Product.SKU = get("sku")
+		record.Product.Name = get("name")
+		record.Product.Price = json.Number(get("price"))
+		if inventory, err := strconv.ParseInt(get("inventory"), 10, 64); err != nil {
+			record.addError("inventory", "inventory must be an integer")
+		} else {
+			record.Product.Inventory = inventory
+		}
+		record.Product.Attributes = []byte(get("attributes"))
+		record.Product.Currency = get("currency")
+		record.validate()
+	}
+	return records, nil
+}
+
+func decodeJSONL(data []byte) ([]*Record, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineLength)
+
+	var records []*Record
+	var row uint64
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		row++
+		record := &Record{Row: row, Product: &dto.ProductRow{}}
+		records = append(records, record)
+
+		if err := json.Unmarshal(line, record.Product); err != nil {
+			var typeErr *json.UnmarshalTypeError
+			if errors.As(err, &typeErr) {
+				record.addError(typeErr.Field, fmt.Sprintf("%s has an invalid type %s", typeErr.Field, typeErr.Value))
+			} else {
+				record.addError("", "invalid JSON line")
+			}
+			continue
+		}
+		record.validate()
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("file is empty")
+	}
+	return records, nil
+}
+
+// validate check the same rules as CreateProductRequest, plus a required SKU
+func (r *Record) validate() {
+	p := r.Product
+	if p.SKU == "" {
+		r.addError("sku", "sku is required")
+	} else if len(p.SKU) > maxSKULen {
+		r.addError("sku", fmt.Sprintf("sku must be at most %d characters", maxSKULen))
+	}
+	if p.Name == "" {
+		r.addError("name", "name is required")
+	}
+	if price, err := strconv.ParseFloat(p.Price.String(), 64); err != nil {
+		r.addError("price", "price must be a number")
+	} else if price < 0 {
+		r.addError("price", "price must be greater than or equal to 0")
+	}
+	if p.Inventory < 0 {
+		r.addError("inventory", "inventory must be greater than or equal to 0")
+	}
+	p.Currency = strings.ToUpper(p.Currency)
+	if p.Currency != "" && !isCurrencyCode(p.Currency) {
+		r.addError("currency", "currency must be a 3-letter ISO 4217 code")
+	}
+
+	attributes := bytes.TrimSpace(p.Attributes)
+	if len(attributes) == 0 || string(attributes) == "null" {
+		p.Attributes = []byte("{}")
+		return
+	}
+	var m map[string]any
+	if err := json.Unmarshal(attributes, &m); err != nil {
+		r.addError("attributes", "attributes must be a JSON object")
+		return
+	}
+	p.Attributes = attributes
+}
+
+// Encoder write product rows in one of the export formats
+type Encoder struct {
+	format      string
+	w           io.Writer
+	csvWriter   *csv.Writer
+	wroteHeader bool
+}
+
+// NewEncoder create new Encoder writing to w
+func NewEncoder(format string, w io.Writer) (*Encoder, error) {
+	e := &Encoder{format: format, w: w}
+	switch format {
+	case FormatCSV:
+		e.csvWriter = csv.NewWriter(w)
+	case FormatJSONL:
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+	return e, nil
+}
+
+// Encode write one product row
+func (e *Encoder) Encode(p *dto.ProductRow) error {
+	if e.format == FormatJSONL {
+		line, err := json.Marshal(p)
+		if err != nil {
+			return err
+		}
+		_, err = e.w.Write(append(line, '\n'))
+		return err
+	}
+
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	attributes := string(p.Attributes)
+	if attributes == "" {
+		attributes = "{}"
+	}
+	return e.csvWriter.Write([]string{
+		p.SKU,
+		p.Name,
+		p.Price.String(),
+		strconv.FormatInt(p.Inventory, 10),
+		attributes,
+		p.Currency,
+	})
+}
+
+// Flush write buffered data, a CSV export without any product still gets its header
+func (e *Encoder) Flush() error {
+	if e.csvWriter == nil {
+		return nil
+	}
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	e.csvWriter.Flush()
+	return e.csvWriter.Error()
+}
+
+func (e *Encoder) writeHeader() error {
+	if e.wroteHeader {
+		return nil
+	}
+	e.wroteHeader = true
+	return e.csvWriter.Write(csvHeader)
+}
+
+// isCurrencyCode return true if code has the shape of an ISO 4217 code, 3 uppercase letters
+func isCurrencyCode(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, c := range code {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
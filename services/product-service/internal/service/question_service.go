@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product-service/internal/repository"
+	"product-service/internal/service/adapter"
+	"product-service/pkg/dto"
+	"product-service/pkg/model"
+	"strings"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// AskQuestion handle logic for Ask Question gRPC request in Service
+func (s *ProductService) AskQuestion(ctx context.Context, input *dto.AskQuestionInput) (*dto.AskQuestionOutput, error) {
+
+	// Only active products can be asked about
+	product, err := s.ProductRepo.GetProductByID(ctx, input.ProductID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.ZapLogger.Warn("ProductService: failed to get product", zap.Error(err))
+		return nil, err
+	}
+	if product == nil || product.Status != model.ProductStatusActive {
+		return &dto.AskQuestionOutput{
+			Message: "Product not found or not active",
+			Success: false,
+		}, nil
+	}
+
+	// Handle in repository
+	question := &model.ProductQuestion{
+		ProductID: input.ProductID,
+		BuyerID:   input.BuyerID,
+		Body:      strings.TrimSpace(input.Body),
+		Status:    model.QAStatusVisible,
+	}
+	if err := s.ProductRepo.CreateQuestion(ctx, question); err != nil {
+		s.ZapLogger.Warn("ProductService: failed to create question", zap.Error(err))
+		return nil, err
+	}
+	return &dto.AskQuestionOutput{
+		Message:  "Question created successfully",
+		Success:  true,
+		Question: adapter.QuestionModelToDTO(question),
+	}, nil
+}
+
+// AnswerQuestion handle logic for Answer Question gRPC request in Service
+func (s *ProductService) AnswerQuestion(ctx context.Context, input *dto.AnswerQuestionInput) (*dto.AnswerQuestionOutput, error) {
+
+	// Get question and the seller of its product
+	question, err := s.ProductRepo.GetQuestionByID(ctx, input.QuestionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &dto.AnswerQuestionOutput{
+			Message: "Question not found",
+			Success: false,
+		}, nil
+	}
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get question", zap.Error(err))
+		return nil, err
+	}
+	if question.Status != model.QAStatusVisible {
+		return questionNotVisibleOutput(), nil
+	}
+	product, err := s.ProductRepo.GetProductByIDUnscoped(ctx, question.ProductID)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get product", zap.Error(err))
+		return nil, err
+	}
+
+	// Check user is staff of the store owning product
+	allowed, err := s.canActOnProduct(ctx, input.UserID, product.SellerID, ActionQuestionAnswer)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to check permission", zap.Error(err))
+		return nil, err
+	}
+	if !allowed {
+		s.ZapLogger.Warn("ProductService: user can not answer question", zap.Uint64("questionID", input.QuestionID))
+		return &dto.AnswerQuestionOutput{
+			Message: "User is not allowed to answer questions of product",
+			Success: false,
+		}, nil
+	}
+
+	// Handle in repository, the buyer is notified by the outbox event
+	answer := &model.ProductAnswer{
+		ResponderID: input.UserID,
+		Body:        strings.TrimSpace(input.Body),
+		Status:      model.QAStatusVisible,
+	}
+	err = s.ProductRepo.CreateAnswer(ctx, question, answer)
+	if errors.Is(err, repository.ErrQANotVisible) {
+		return questionNotVisibleOutput(), nil
+	}
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to create answer", zap.Error(err))
+		return nil, err
+	}
+	return &dto.AnswerQuestionOutput{
+		Message: "Answer created successfully",
+		Success: true,
+		Answer:  adapter.AnswerModelToDTO(answer),
+	}, nil
+}
+
+// questionNotVisibleOutput is the output of an answer to a question that is flagged or hidden by moderation
+func questionNotVisibleOutput() *dto.AnswerQuestionOutput {
+	return &dto.AnswerQuestionOutput{
+		Message: "Question is under moderation and can not be answered",
+		Success: false,
+	}
+}
+
+// GetProductQuestions handle logic for Get Product Questions gRPC request in Service
+func (s *ProductService) GetProductQuestions(ctx context.Context, input *dto.GetProductQuestionsInput) (*dto.GetProductQuestionsOutput, error) {
+	questions, total, err := s.ProductRepo.GetQuestionsByProductID(ctx, input.ProductID, input.Page, input.PageSize)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get questions", zap.Error(err))
+		return nil, err
+	}
+	return &dto.GetProductQuestionsOutput{
+		Message:   fmt.Sprintf("Get questions by productID %v", input.ProductID),
+		Success:   true,
+		Questions: adapter.QuestionsModelToDTO(questions),
+		Total:     total,
+	}, nil
+}
+
+// FlagQA handle logic for Flag QA gRPC request in Service
+func (s *ProductService) FlagQA(ctx context.Context, input *dto.FlagQAInput) (*dto.FlagQAOutput, error) {
+	flagged, err := s.ProductRepo.FlagQA(ctx, &model.QAFlag{
+		TargetType: input.TargetType,
+		TargetID:   input.TargetID,
+		UserID:     input.UserID,
+		Reason:     strings.TrimSpace(input.Reason),
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &dto.FlagQAOutput{
+			Message: fmt.Sprintf("%v not found", strings.ToLower(input.TargetType)),
+			Success: false,
+		}, nil
+	}
+	if errors.Is(err, repository.ErrQANotVisible) {
+		return &dto.FlagQAOutput{
+			Message: fmt.Sprintf("%v is already under moderation", strings.ToLower(input.TargetType)),
+			Success: false,
+		}, nil
+	}
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to flag", zap.String("targetType", input.TargetType), zap.Error(err))
+		return nil, err
+	}
+	if !flagged {
+		return &dto.FlagQAOutput{
+			Message: "User already flagged this " + strings.ToLower(input.TargetType),
+			Success: false,
+		}, nil
+	}
+	return &dto.FlagQAOutput{
+		Message: "Flagged successfully",
+		Success: true,
+	}, nil
+}
+
+// ModerateQA handle logic for Moderate QA gRPC request in Service
+func (s *ProductService) ModerateQA(ctx context.Context, input *dto.ModerateQAInput) (*dto.ModerateQAOutput, error) {
+	err := s.ProductRepo.UpdateQAStatus(ctx, input.TargetType, input.TargetID, input.Status)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &dto.ModerateQAOutput{
+			Message: fmt.Sprintf("%v not found", strings.ToLower(input.TargetType)),
+			Success: false,
+		}, nil
+	}
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to moderate", zap.String("targetType", input.TargetType), zap.Error(err))
+		return nil, err
+	}
+	return &dto.ModerateQAOutput{
+		Message: "Status updated successfully",
+		Success: true,
+	}, nil
+}
+
+// GetFlaggedQA handle logic for Get Flagged QA gRPC request in Service
+func (s *ProductService) GetFlaggedQA(ctx context.Context, input *dto.GetFlaggedQAInput) (*dto.GetFlaggedQAOutput, error) {
+	var items []*dto.FlaggedQA
+	var total int64
+	if input.TargetType == model.FlagTargetAnswer {
+		answers, count, err := s.ProductRepo.GetFlaggedAnswers(ctx, input.Page, input.PageSize)
+		if err != nil {
+			s.ZapLogger.Warn("ProductService: failed to get flagged answers", zap.Error(err))
+			return nil, err
+		}
+		questionIDs := make([]uint64, 0, len(answers))
+		for _, answer := range answers {
+			questionIDs = append(questionIDs, answer.QuestionID)
+		}
+		questions, err := s.ProductRepo.GetQuestionsByIDs(ctx, questionIDs)
+		if err != nil {
+			s.ZapLogger.Warn("ProductService: failed to get questions of flagged answers", zap.Error(err))
+			return nil, err
+		}
+		questionsByID := make(map[uint64]*model.ProductQuestion, len(questions))
+		for _, question := range questions {
+			questionsByID[question.ID] = question
+		}
+		for _, answer := range answers {
+			items = append(items, adapter.AnswerModelToFlaggedDTO(answer, questionsByID[answer.QuestionID]))
+		}
+		total = count
+	} else {
+		questions, count, err := s.ProductRepo.GetFlaggedQuestions(ctx, input.Page, input.PageSize)
+		if err != nil {
+			s.ZapLogger.Warn("ProductService: failed to get flagged questions", zap.Error(err))
+			return nil, err
+		}
+		for _, question := range questions {
+			items = append(items, adapter.QuestionModelToFlaggedDTO(question))
+		}
+		total = count
+	}
+	return &dto.GetFlaggedQAOutput{
+		Message: fmt.Sprintf("Get flagged %vs", strings.ToLower(input.TargetType)),
+		Success: true,
+		Items:   items,
+		Total:   total,
+	}, nil
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"product-service/internal/service/adapter"
+	"product-service/pkg/dto"
+	"product-service/pkg/model"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// defaultRelatedProductsLimit is used when GetRelatedProducts is called without limit
+const defaultRelatedProductsLimit = 10
+
+// relatedProductsBatchSize is how many related and popular ProductIDs are precomputed, the largest limit served
+const relatedProductsBatchSize = 50
+
+// GetRelatedProducts handle logic for Get Related Products gRPC request in Service.
+// Products bought together with the product are served first, padded with popular products of its category.
+func (s *ProductService) GetRelatedProducts(ctx context.Context, input *dto.GetRelatedProductsInput) (*dto.GetRelatedProductsOutput, error) {
+	limit := int(input.Limit)
+	if limit == 0 {
+		limit = defaultRelatedProductsLimit
+	}
+
+	// Get product
+	products, err := s.getProductsByID(ctx, []uint64{input.ProductID})
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get product", zap.Error(err))
+		return nil, err
+	}
+	if len(products) == 0 || products[0].DeletedAt.Valid {
+		return nil, gorm.ErrRecordNotFound
+	}
+	product := products[0]
+
+	// Get precomputed ProductIDs, a cache error only leaves the list shorter
+	candidateIDs, err := s.RelatedCache.GetRelatedProducts(ctx, product.ID)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get related products from cache", zap.Error(err))
+	}
+	popularIDs, err := s.RelatedCache.GetPopularProducts(ctx, product.CategoryID)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get popular products from cache", zap.Error(err))
+	}
+	candidateIDs = append(candidateIDs, popularIDs...)
+
+	// Keep active products in order, without the product itself and duplicates
+	seen := map[uint64]bool{product.ID: true}
+	var productIDs []uint64
+	for _, productID := range candidateIDs {
+		if !seen[productID] {
+			seen[productID] = true
+			productIDs = append(productIDs, productID)
+		}
+	}
+	candidates, err := s.getProductsByID(ctx, productIDs)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get related products", zap.Error(err))
+		return nil, err
+	}
+	byID := make(map[uint64]*model.Product, len(candidates))
+	for _, candidate := range candidates {
+		byID[candidate.ID] = candidate
+	}
+	related := make([]*model.Product, 0, limit)
+	for _, productID := range productIDs {
+		candidate, ok := byID[productID]
+		if !ok || candidate.DeletedAt.Valid || candidate.Status != model.ProductStatusActive {
+			continue
+		}
+		related = append(related, candidate)
+		if len(related) == limit {
+			break
+		}
+	}
+
+	return &dto.GetRelatedProductsOutput{
+		Message:  fmt.Sprintf("Get related products by productID %v", input.ProductID),
+		Success:  true,
+		Products: adapter.ProductsModelToDTO(related),
+	}, nil
+}
+
+// RelatedProductsWorker precompute related and popular products from orders of the last lookback into Redis,
+// once at start and then every interval
+func (s *ProductService) RelatedProductsWorker(ctx context.Context, interval, lookback time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := s.refreshRelatedProducts(ctx, lookback); err != nil {
+				s.ZapLogger.Warn("ProductService: error in procedure refresh related products", zap.Error(err))
+			}
+
+			select {
+			// Cancel by context
+			case <-ctx.Done():
+				s.ZapLogger.Info("ProductService: Worker refresh related products stop by context")
+				return
+			// Interval time
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (s *ProductService) refreshRelatedProducts(ctx context.Context, lookback time.Duration) error {
+	since := time.Now().Add(-lookback)
+
+	// Products bought together
+	related, err := s.ProductRepo.GetCoPurchasedProducts(ctx, since, relatedProductsBatchSize)
+	if err != nil {
+		return err
+	}
+	if err := s.RelatedCache.SetRelatedProducts(ctx, related); err != nil {
+		return err
+	}
+
+	// Popular products of each category
+	popular, err := s.ProductRepo.GetPopularProductsByCategory(ctx, since, relatedProductsBatchSize)
+	if err != nil {
+		return err
+	}
+	if err := s.RelatedCache.SetPopularProducts(ctx, popular); err != nil {
+		return err
+	}
+
+	s.ZapLogger.Info("ProductService: refresh related products success", zap.Int("products", len(related)),
+		zap.Int("categories", len(popular)))
+	return nil
+}
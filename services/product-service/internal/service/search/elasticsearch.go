@@ -0,0 +1,301 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ElasticsearchIndex keep documents in Elasticsearch 7 through its REST API, see dockers/elastic-compose.yml.
+// Alias points to the current index, Reindex fills a new index and moves Alias to it when done.
+// Documents are written with external versioning, so Elasticsearch rejects a version that is not higher.
+type ElasticsearchIndex struct {
+	URL    string
+	Alias  string
+	Client *http.Client
+
+	mu    sync.Mutex
+	ready bool // Alias exists
+}
+
+// NewElasticsearchIndex create new ElasticsearchIndex on the cluster at url, the index behind alias is created on first use
+func NewElasticsearchIndex(url, alias string) *ElasticsearchIndex {
+	return &ElasticsearchIndex{
+		URL:    strings.TrimRight(url, "/"),
+		Alias:  alias,
+		Client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// elasticsearchMappings is the mapping of new indices, attributes are text since their types differ between products
+var elasticsearchMappings = map[string]any{
+	"properties": map[string]any{
+		"id":           map[string]any{"type": "long"},
+		"name":         map[string]any{"type": "text"},
+		"sku":          map[string]any{"type": "text", "analyzer": "simple"},
+		"seller_id":    map[string]any{"type": "long"},
+		"category_id":  map[string]any{"type": "long"},
+		"price_amount": map[string]any{"type": "long"},
+		"currency":     map[string]any{"type": "keyword"},
+		"attributes":   map[string]any{"type": "text"},
+	},
+}
+
+func (e *ElasticsearchIndex) Name() string {
+	return BackendElasticsearch + ":" + e.URL + "/" + e.Alias
+}
+
+func (e *ElasticsearchIndex) Upsert(ctx context.Context, documents ...*Document) error {
+	if len(documents) == 0 {
+		return nil
+	}
+	if err := e.ensureAlias(ctx); err != nil {
+		return err
+	}
+	return e.bulkIndex(ctx, e.Alias, documents)
+}
+
+func (e *ElasticsearchIndex) Delete(ctx context.Context, id, version uint64) error {
+	if err := e.ensureAlias(ctx); err != nil {
+		return err
+	}
+	path := fmt.Sprintf("/%s/_doc/%d?version=%d&version_type=external", e.Alias, id, version)
+	_, err := e.do(ctx, http.MethodDelete, path, nil, nil, http.StatusNotFound, http.StatusConflict)
+	return err
+}
+
+func (e *ElasticsearchIndex) Search(ctx context.Context, query *Query) (*Result, error) {
+	if err := e.ensureAlias(ctx); err != nil {
+		return nil, err
+	}
+
+	must := []any{map[string]any{"match_all": map[string]any{}}}
+	if strings.TrimSpace(query.Text) != "" {
+		must = []any{map[string]any{"multi_match": map[string]any{
+			"query":     query.Text,
+			"fields":    []string{"name^3", "sku^2", "attributes"},
+			"operator":  "and",
+			"fuzziness": "AUTO",
+		}}}
+	}
+	filter := []any{}
+	if query.SellerID != 0 {
+		filter = append(filter, map[string]any{"term": map[string]any{"seller_id": query.SellerID}})
+	}
+	if query.CategoryID != 0 {
+		filter = append(filter, map[string]any{"term": map[string]any{"category_id": query.CategoryID}})
+	}
+	body, err := json.Marshal(map[string]any{
+		"from":             query.Offset,
+		"size":             query.Limit,
+		"track_total_hits": true,
+		"_source":          false,
+		"query":            map[string]any{"bool": map[string]any{"must": must, "filter": filter}},
+		"sort":             []any{"_score", map[string]any{"id": "asc"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Hits struct {
+			Total struct {
+				Value uint64 `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				ID string `json:"_id"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if _, err := e.do(ctx, http.MethodPost, "/"+e.Alias+"/_search", body, &response); err != nil {
+		return nil, err
+	}
+	result := &Result{Total: response.Hits.Total.Value, IDs: make([]uint64, 0, len(response.Hits.Hits))}
+	for _, hit := range response.Hits.Hits {
+		id, err := strconv.ParseUint(hit.ID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid document id %q: %v", hit.ID, err)
+		}
+		result.IDs = append(result.IDs, id)
+	}
+	return result, nil
+}
+
+// Reindex fill a new index from next, then move Alias to it and delete the old indices.
+// Changes indexed through Alias while it runs are not copied, the events after the move are.
+func (e *ElasticsearchIndex) Reindex(ctx context.Context, next func(ctx context.Context) ([]*Document, error)) error {
+	index := fmt.Sprintf("%s-%d", e.Alias, time.Now().UnixNano())
+	if err := e.createIndex(ctx, index, false); err != nil {
+		return err
+	}
+	for {
+		documents, err := next(ctx)
+		if err != nil {
+			return err
+		}
+		if len(documents) == 0 {
+			break
+		}
+		if err := e.bulkIndex(ctx, index, documents); err != nil {
+			return err
+		}
+	}
+
+	// Move Alias in one request, so searches never see a missing or half-filled index
+	var aliases map[string]any
+	status, err := e.do(ctx, http.MethodGet, "/_alias/"+e.Alias, nil, &aliases, http.StatusNotFound)
+	if err != nil {
+		return err
+	}
+	actions := []any{}
+	oldIndices := []string{}
+	if status != http.StatusNotFound {
+		for oldIndex := range aliases {
+			actions = append(actions, map[string]any{"remove": map[string]any{"index": oldIndex, "alias": e.Alias}})
+			oldIndices = append(oldIndices, oldIndex)
+		}
+	}
+	actions = append(actions, map[string]any{"add": map[string]any{"index": index, "alias": e.Alias}})
+	body, err := json.Marshal(map[string]any{"actions": actions})
+	if err != nil {
+		return err
+	}
+	if _, err := e.do(ctx, http.MethodPost, "/_aliases", body, nil); err != nil {
+		return err
+	}
+	e.mu.Lock()
+	e.ready = true
+	e.mu.Unlock()
+
+	for _, oldIndex := range oldIndices {
+		if _, err := e.do(ctx, http.MethodDelete, "/"+oldIndex, nil, nil, http.StatusNotFound); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureAlias create the first index with Alias when Alias does not exist yet
+func (e *ElasticsearchIndex) ensureAlias(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.ready {
+		return nil
+	}
+	status, err := e.do(ctx, http.MethodHead, "/_alias/"+e.Alias, nil, nil, http.StatusNotFound)
+	if err != nil {
+		return err
+	}
+	if status == http.StatusNotFound {
+		if err := e.createIndex(ctx, fmt.Sprintf("%s-%d", e.Alias, time.Now().UnixNano()), true); err != nil {
+			return err
+		}
+	}
+	e.ready = true
+	return nil
+}
+
+// createIndex create index with elasticsearchMappings, and with Alias when withAlias
+func (e *ElasticsearchIndex) createIndex(ctx context.Context, index string, withAlias bool) error {
+	settings := map[string]any{"mappings": elasticsearchMappings}
+	if withAlias {
+		settings["aliases"] = map[string]any{e.Alias: map[string]any{}}
+	}
+	body, err := json.Marshal(settings)
+	if err != nil {
+		return err
+	}
+	_, err = e.do(ctx, http.MethodPut, "/"+index, body, nil)
+	return err
+}
+
+// bulkIndex write documents to index in one bulk request, version conflicts are stale documents and ignored
+func (e *ElasticsearchIndex) bulkIndex(ctx context.Context, index string, documents []*Document) error {
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, document := range documents {
+		action := map[string]any{"index": map[string]any{
+			"_index":       index,
+			"_id":          strconv.FormatUint(document.ID, 10),
+			"version":      document.Version,
+			"version_type": "external",
+		}}
+		if err := encoder.Encode(action); err != nil {
+			return err
+		}
+		if err := encoder.Encode(document); err != nil {
+			return err
+		}
+	}
+
+	var response struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			ID     string          `json:"_id"`
+			Status int             `json:"status"`
+			Error  json.RawMessage `json:"error"`
+		} `json:"items"`
+	}
+	if _, err := e.do(ctx, http.MethodPost, "/_bulk", body.Bytes(), &response); err != nil {
+		return err
+	}
+	if !response.Errors {
+		return nil
+	}
+	for _, item := range response.Items {
+		for _, result := range item {
+			if result.Status >= 300 && result.Status != http.StatusConflict {
+				return fmt.Errorf("elasticsearch: failed to index document %s: %s", result.ID, result.Error)
+			}
+		}
+	}
+	return nil
+}
+
+// do send a request with a JSON or NDJSON body and decode a JSON response into out.
+// Statuses other than 2xx and allowed are returned as error.
+func (e *ElasticsearchIndex) do(ctx context.Context, method, path string, body []byte, out any, allowed ...int) (int, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, e.URL+path, reader)
+	if err != nil {
+		return 0, err
+	}
+	if body != nil {
+		contentType := "application/json"
+		if strings.HasPrefix(path, "/_bulk") {
+			contentType = "application/x-ndjson"
+		}
+		req.Header.Set("Content-Type", contentType)
+	}
+	res, err := e.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	for _, status := range allowed {
+		if res.StatusCode == status {
+			return res.StatusCode, nil
+		}
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return res.StatusCode, fmt.Errorf("elasticsearch: %s %s returned %d: %s", method, path, res.StatusCode, message)
+	}
+	if out != nil {
+		if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+			return res.StatusCode, err
+		}
+	}
+	return res.StatusCode, nil
+}
@@ -0,0 +1,147 @@
+package search
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MemoryIndex keep documents in a map and match every term as a substring of name, SKU or attributes.
+// It is meant for tests and single-instance development, documents are lost on restart.
+type MemoryIndex struct {
+	mu        sync.RWMutex
+	documents map[uint64]*Document
+	versions  map[uint64]uint64 // versions of indexed and deleted documents
+}
+
+// NewMemoryIndex create new empty MemoryIndex
+func NewMemoryIndex() *MemoryIndex {
+	return &MemoryIndex{
+		documents: make(map[uint64]*Document),
+		versions:  make(map[uint64]uint64),
+	}
+}
+
+func (m *MemoryIndex) Name() string {
+	return BackendMemory
+}
+
+func (m *MemoryIndex) Upsert(ctx context.Context, documents ...*Document) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, document := range documents {
+		if version, ok := m.versions[document.ID]; ok && document.Version <= version {
+			continue
+		}
+		copied := *document
+		m.documents[document.ID] = &copied
+		m.versions[document.ID] = document.Version
+	}
+	return nil
+}
+
+func (m *MemoryIndex) Delete(ctx context.Context, id, version uint64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if indexed, ok := m.versions[id]; ok && version <= indexed {
+		return nil
+	}
+	delete(m.documents, id)
+	m.versions[id] = version
+	return nil
+}
+
+func (m *MemoryIndex) Search(ctx context.Context, query *Query) (*Result, error) {
+	queryTerms := terms(query.Text)
+
+	m.mu.RLock()
+	type match struct {
+		id    uint64
+		score int
+	}
+	var matches []match
+	for _, document := range m.documents {
+		if query.SellerID != 0 && document.SellerID != query.SellerID {
+			continue
+		}
+		if query.CategoryID != 0 && document.CategoryID != query.CategoryID {
+			continue
+		}
+		if score, ok := m.score(document, queryTerms); ok {
+			matches = append(matches, match{id: document.ID, score: score})
+		}
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return matches[i].id < matches[j].id
+	})
+	result := &Result{Total: uint64(len(matches)), IDs: []uint64{}}
+	for i := query.Offset; i < uint64(len(matches)) && i < query.Offset+query.Limit; i++ {
+		result.IDs = append(result.IDs, matches[i].id)
+	}
+	return result, nil
+}
+
+// score return whether document has every term, terms found in the name score higher
+func (m *MemoryIndex) score(document *Document, queryTerms []string) (int, bool) {
+	name := strings.ToLower(document.Name)
+	other := strings.ToLower(document.SKU + " " + document.Attributes)
+	score := 0
+	for _, term := range queryTerms {
+		switch {
+		case strings.Contains(name, term):
+			score += 2
+		case strings.Contains(other, term):
+			score++
+		default:
+			return 0, false
+		}
+	}
+	return score, true
+}
+
+// Reindex build a new index from next, documents changed by Upsert or Delete meanwhile keep their change
+func (m *MemoryIndex) Reindex(ctx context.Context, next func(ctx context.Context) ([]*Document, error)) error {
+	m.mu.RLock()
+	startVersions := make(map[uint64]uint64, len(m.versions))
+	for id, version := range m.versions {
+		startVersions[id] = version
+	}
+	m.mu.RUnlock()
+
+	index := NewMemoryIndex()
+	for {
+		documents, err := next(ctx)
+		if err != nil {
+			return err
+		}
+		if len(documents) == 0 {
+			break
+		}
+		if err := index.Upsert(ctx, documents...); err != nil {
+			return err
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, version := range m.versions {
+		if version == startVersions[id] || version <= index.versions[id] {
+			continue
+		}
+		if document, ok := m.documents[id]; ok {
+			index.documents[id] = document
+		} else {
+			delete(index.documents, id)
+		}
+		index.versions[id] = version
+	}
+	m.documents = index.documents
+	m.versions = index.versions
+	return nil
+}
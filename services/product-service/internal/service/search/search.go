@@ -0,0 +1,103 @@
+// Package search defines where products are searched. A SearchIndex only stores what is needed to match
+// and filter products, search results are product IDs that are read from the product repository.
+package search
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Search backends
+const (
+	BackendMemory        = "memory"
+	BackendElasticsearch = "elasticsearch"
+)
+
+// indexerGroupID is the consumer group of product events that keep a shared index in sync
+const indexerGroupID = "product-search-indexer"
+
+// ErrUnknownBackend is returned by New for a backend that is not implemented
+var ErrUnknownBackend = errors.New("unknown search backend")
+
+// Document is an active product as it is indexed
+type Document struct {
+	ID          uint64 `json:"id"`
+	Name        string `json:"name"`
+	SKU         string `json:"sku"`
+	SellerID    uint64 `json:"seller_id"`
+	CategoryID  uint64 `json:"category_id"`
+	PriceAmount int64  `json:"price_amount"`
+	Currency    string `json:"currency"`
+	Attributes  string `json:"attributes"` // attribute values joined by spaces, matched as text
+	Version     uint64 `json:"-"`          // documents are only replaced by a higher version
+}
+
+// Query match products by text, zero filters match every product
+type Query struct {
+	Text       string
+	SellerID   uint64
+	CategoryID uint64
+	Offset     uint64
+	Limit      uint64
+}
+
+// Result is the page of matched product IDs, best match first, and the number of matched products
+type Result struct {
+	IDs   []uint64
+	Total uint64
+}
+
+// SearchIndex keep the documents of active products.
+// Upsert and Delete ignore a version that is not higher than the indexed one, so events can be applied out of order.
+type SearchIndex interface {
+	Name() string
+	Upsert(ctx context.Context, documents ...*Document) error
+	Delete(ctx context.Context, id, version uint64) error
+	Search(ctx context.Context, query *Query) (*Result, error)
+
+	// Reindex replace all documents with the batches returned by next, until it returns an empty batch
+	Reindex(ctx context.Context, next func(ctx context.Context) ([]*Document, error)) error
+}
+
+// Config select and configure a backend
+type Config struct {
+	Backend          string
+	ElasticsearchURL string
+	IndexName        string
+}
+
+// New create the SearchIndex of config.Backend
+func New(config *Config) (SearchIndex, error) {
+	switch strings.ToLower(config.Backend) {
+	case "", BackendMemory:
+		return NewMemoryIndex(), nil
+	case BackendElasticsearch:
+		return NewElasticsearchIndex(config.ElasticsearchURL, config.IndexName), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownBackend, config.Backend)
+	}
+}
+
+// IndexerGroupID return the consumer group of the product events that keep index in sync.
+// Instances share an Elasticsearch index, so they split the events in one group. A memory index is only
+// filled by its instance, which must read every event in a group of its own, named after the host so a
+// restarted instance resumes where it stopped.
+func IndexerGroupID(index SearchIndex) string {
+	if index.Name() != BackendMemory {
+		return indexerGroupID
+	}
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = rand.Text()
+	}
+	return indexerGroupID + "-" + hostname
+}
+
+// terms split text to lowercase words
+func terms(text string) []string {
+	return strings.Fields(strings.ToLower(text))
+}
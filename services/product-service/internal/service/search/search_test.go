@@ -0,0 +1,59 @@
+package search
+
+import (
+	"context"
+	"os"
+	"slices"
+	"testing"
+)
+
+func TestIndexerGroupID(t *testing.T) {
+	if got := IndexerGroupID(NewElasticsearchIndex("http://localhost:9200", "products")); got != indexerGroupID {
+		t.Errorf("IndexerGroupID() of a shared index = %q, want %q", got, indexerGroupID)
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		t.Skip("no hostname")
+	}
+	if got, want := IndexerGroupID(NewMemoryIndex()), indexerGroupID+"-"+hostname; got != want {
+		t.Errorf("IndexerGroupID() of a memory index = %q, want %q", got, want)
+	}
+}
+
+func TestMemoryIndexSearch(t *testing.T) {
+	ctx := context.Background()
+	index := NewMemoryIndex()
+	index.Upsert(ctx,
+		&Document{ID: 1, Name: "Blue shirt", SKU: "SH-1", SellerID: 10, CategoryID: 1, Version: 1},
+		&Document{ID: 2, Name: "Red shirt", SKU: "SH-2", SellerID: 20, CategoryID: 1, Version: 1},
+		&Document{ID: 3, Name: "Blue jeans", SKU: "JE-1", SellerID: 10, CategoryID: 2, Attributes: "shirt pocket", Version: 1},
+	)
+	index.Delete(ctx, 2, 2)
+	index.Upsert(ctx, &Document{ID: 2, Name: "Red shirt", SellerID: 20, CategoryID: 1, Version: 1}) // older than the delete
+
+	tests := []struct {
+		name  string
+		query Query
+		want  []uint64
+		total uint64
+	}{
+		{"name first", Query{Text: "shirt", Limit: 10}, []uint64{1, 3}, 2},
+		{"every term", Query{Text: "blue jeans", Limit: 10}, []uint64{3}, 1},
+		{"by sku", Query{Text: "sh-1", Limit: 10}, []uint64{1}, 1},
+		{"seller", Query{SellerID: 10, Limit: 10}, []uint64{1, 3}, 2},
+		{"category", Query{CategoryID: 2, Limit: 10}, []uint64{3}, 1},
+		{"page", Query{Text: "shirt", Offset: 1, Limit: 1}, []uint64{3}, 2},
+		{"no match", Query{Text: "hat", Limit: 10}, []uint64{}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := index.Search(ctx, &tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(result.IDs, tt.want) || result.Total != tt.total {
+				t.Errorf("Search() = %v, %d, want %v, %d", result.IDs, result.Total, tt.want, tt.total)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"product-service/internal/service/adapter"
+	"product-service/internal/service/search"
+	"product-service/pkg/dto"
+	"product-service/pkg/model"
+	"product-service/pkg/outbox"
+
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// reindexBatchSize is the number of products read and indexed at a time by ReindexProducts
+const reindexBatchSize = 500
+
+// SearchProducts handle logic for Search Products gRPC request in Service.
+// The index only holds active products, product events remove the others, so it pages and counts the matches.
+func (s *ProductService) SearchProducts(ctx context.Context, input *dto.SearchProductsInput) (*dto.SearchProductsOutput, error) {
+
+	// Search the IDs of the page in the configured index
+	result, err := s.SearchIndex.Search(ctx, &search.Query{
+		Text:       input.Query,
+		SellerID:   input.SellerID,
+		CategoryID: input.CategoryID,
+		Offset:     (input.Page - 1) * input.PageSize,
+		Limit:      input.PageSize,
+	})
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to search products", zap.String("index", s.SearchIndex.Name()), zap.Error(err))
+		return nil, err
+	}
+
+	// Read products in the order of the result
+	products, err := s.getProductsByID(ctx, result.IDs)
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to get products", zap.Error(err))
+		return nil, err
+	}
+	return &dto.SearchProductsOutput{
+		Message:  "Search products successfully",
+		Success:  true,
+		Products: activeSearchHits(result.IDs, products),
+		Total:    result.Total,
+	}, nil
+}
+
+// activeSearchHits return the products of hits in their order. Products that are no longer active while their
+// event is on its way to the index are left out, they are still counted in the total until the index drops them.
+func activeSearchHits(hits []uint64, products []*model.Product) []*dto.Product {
+	productsByID := make(map[uint64]*model.Product, len(products))
+	for _, product := range products {
+		productsByID[product.ID] = product
+	}
+	productsDTO := make([]*dto.Product, 0, len(hits))
+	for _, productID := range hits {
+		product, ok := productsByID[productID]
+		if !ok || product.Status != model.ProductStatusActive || product.DeletedAt.Valid {
+			continue
+		}
+		productsDTO = append(productsDTO, adapter.ProductModelToDTO(product))
+	}
+	return productsDTO
+}
+
+// IndexProductEvent keep the search index in sync with a product.created, product.updated or product.deleted event.
+// Active products are indexed, others are removed, the index ignores versions older than the indexed one.
+func (s *ProductService) IndexProductEvent(ctx context.Context, msg *kafka.Message) error {
+	var event outbox.ProductKafkaEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		s.ZapLogger.Error("failed to unmarshal event", zap.Error(err))
+		return err
+	}
+	var product outbox.ProductSnapshot
+	if err := json.Unmarshal(event.Product, &product); err != nil {
+		s.ZapLogger.Error("failed to unmarshal product of event", zap.Uint64("eventID", event.EventID), zap.Error(err))
+		return err
+	}
+
+	if product.Status != model.ProductStatusActive {
+		if err := s.SearchIndex.Delete(ctx, product.ID, product.Version); err != nil {
+			s.ZapLogger.Warn("ProductService: failed to remove product from search index", zap.Uint64("productID", product.ID), zap.Error(err))
+			return err
+		}
+		return nil
+	}
+	if err := s.SearchIndex.Upsert(ctx, adapter.ProductSnapshotToSearchDocument(&product)); err != nil {
+		s.ZapLogger.Warn("ProductService: failed to index product", zap.Uint64("productID", product.ID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// ReindexProducts rebuild the search index from all active products in DB
+func (s *ProductService) ReindexProducts(ctx context.Context) error {
+	var afterID uint64
+	indexed := 0
+	err := s.SearchIndex.Reindex(ctx, func(ctx context.Context) ([]*search.Document, error) {
+		products, err := s.ProductRepo.GetActiveProductsAfterID(ctx, afterID, reindexBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		documents := make([]*search.Document, 0, len(products))
+		for _, product := range products {
+			documents = append(documents, adapter.ProductModelToSearchDocument(product))
+			afterID = product.ID
+		}
+		indexed += len(documents)
+		return documents, nil
+	})
+	if err != nil {
+		s.ZapLogger.Warn("ProductService: failed to reindex products", zap.String("index", s.SearchIndex.Name()), zap.Error(err))
+		return err
+	}
+	s.ZapLogger.Info("ProductService: reindexed products", zap.String("index", s.SearchIndex.Name()), zap.Int("products", indexed))
+	return nil
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"product-service/pkg/model"
+	"slices"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+func TestActiveSearchHits(t *testing.T) {
+	products := []*model.Product{
+		{ID: 1, Status: model.ProductStatusActive},
+		{ID: 2, Status: model.ProductStatusArchived},
+		{ID: 3, Status: model.ProductStatusActive, DeletedAt: gorm.DeletedAt{Valid: true}},
+		{ID: 5, Status: model.ProductStatusActive},
+		{ID: 7, Status: model.ProductStatusActive},
+	}
+	tests := []struct {
+		name string
+		hits []uint64
+		want []uint64
+	}{
+		{"order of the hits", []uint64{7, 1, 5}, []uint64{7, 1, 5}},
+		{"inactive left out", []uint64{5, 2, 3, 1}, []uint64{5, 1}},
+		{"missing left out", []uint64{9, 7}, []uint64{7}},
+		{"no hits", []uint64{}, []uint64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []uint64{}
+			for _, product := range activeSearchHits(tt.hits, products) {
+				got = append(got, product.ID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("activeSearchHits() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
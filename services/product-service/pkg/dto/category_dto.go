@@ -0,0 +1,82 @@
+package dto
+
+import "time"
+
+type Category struct {
+	ID         uint64
+	Name       string
+	Attributes []*AttributeDefinition
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type AttributeDefinition struct {
+	Name          string
+	Type          string
+	AllowedValues []string
+	Required      bool
+}
+
+// FieldError is a validation error of one field of a request, e.g. "attributes.color"
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// CreateCategory
+
+type CreateCategoryInput struct {
+	Name       string
+	Attributes []*AttributeDefinition
+}
+type CreateCategoryOutput struct {
+	Message     string
+	Success     bool
+	Category    *Category
+	FieldErrors []*FieldError
+}
+
+// UpdateCategory
+
+type UpdateCategoryInput struct {
+	ID         uint64
+	Name       string
+	Attributes []*AttributeDefinition
+}
+type UpdateCategoryOutput struct {
+	Message     string
+	Success     bool
+	Category    *Category
+	FieldErrors []*FieldError
+}
+
+// DeleteCategory
+
+type DeleteCategoryInput struct {
+	ID uint64
+}
+type DeleteCategoryOutput struct {
+	Message string
+	Success bool
+}
+
+// GetCategoryByID
+
+type GetCategoryByIDInput struct {
+	ID uint64
+}
+type GetCategoryByIDOutput struct {
+	Message  string
+	Success  bool
+	Category *Category
+}
+
+// GetCategories
+
+type GetCategoriesInput struct {
+}
+type GetCategoriesOutput struct {
+	Message    string
+	Success    bool
+	Categories []*Category
+}
@@ -0,0 +1,20 @@
+package dto
+
+import "time"
+
+type ExchangeRate struct {
+	Currency string
+	Rate     float64
+}
+
+// GetExchangeRates
+
+type GetExchangeRatesInput struct {
+}
+type GetExchangeRatesOutput struct {
+	Message   string
+	Success   bool
+	Base      string
+	Rates     []*ExchangeRate
+	UpdatedAt time.Time
+}
@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// ProductRow is one product line in a bulk import or export file
+type ProductRow struct {
+	SKU        string         `json:"sku"`
+	Name       string         `json:"name"`
+	Price      json.Number    `json:"price"` // decimal in major units of Currency
+	Currency   string         `json:"currency,omitempty"`
+	Inventory  int64          `json:"inventory"`
+	Attributes datatypes.JSON `json:"attributes"`
+}
+
+type ImportRowError struct {
+	Row     uint64 `json:"row"`
+	SKU     string `json:"sku"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type ImportJob struct {
+	ID          uint64
+	SellerID    uint64
+	Format      string
+	Status      string
+	TotalRows   uint64
+	SuccessRows uint64
+	FailedRows  uint64
+	Errors      []*ImportRowError
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ImportProducts
+
+type ImportProductsInput struct {
+	SellerID uint64
+	Format   string
+	Data     []byte
+}
+type ImportProductsOutput struct {
+	Message string
+	Success bool
+	JobID   uint64
+}
+
+// GetImportJob
+
+type GetImportJobInput struct {
+	JobID    uint64
+	SellerID uint64
+}
+type GetImportJobOutput struct {
+	Message string
+	Success bool
+	Job     *ImportJob
+}
+
+// ExportProducts
+
+type ExportProductsInput struct {
+	SellerID uint64
+	Format   string
+}
@@ -0,0 +1,45 @@
+package dto
+
+import "time"
+
+type InventoryMovement struct {
+	ID             uint64
+	ProductID      uint64
+	SellerID       uint64
+	Type           string
+	ReferenceID    uint64
+	Quantity       int64
+	InventoryAfter int64
+	Reason         string
+	ActorID        uint64
+	CreatedAt      time.Time
+}
+
+// AdjustInventory
+
+type AdjustInventoryInput struct {
+	ProductID uint64
+	UserID    uint64
+	Quantity  int64
+	Reason    string
+}
+type AdjustInventoryOutput struct {
+	Message   string
+	Success   bool
+	Inventory int64
+}
+
+// GetInventoryMovements
+
+type GetInventoryMovementsInput struct {
+	SellerID  uint64 // user reading the ledger of its store
+	ProductID uint64
+	Type      string
+	Page      uint64
+	PageSize  uint64
+}
+type GetInventoryMovementsOutput struct {
+	Message   string
+	Success   bool
+	Movements []*InventoryMovement
+}
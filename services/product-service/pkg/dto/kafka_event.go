@@ -9,3 +9,9 @@ type CreateOrderKafkaEvent struct {
 	OrderID uint64       `json:"order_id"`
 	Items   []*ItemEvent `json:"items"`
 }
+
+// RestockOrderKafkaEvent is used for both canceled and returned orders, empty Items means every item of the order
+type RestockOrderKafkaEvent struct {
+	OrderID uint64       `json:"order_id"`
+	Items   []*ItemEvent `json:"items"`
+}
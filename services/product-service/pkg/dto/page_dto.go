@@ -0,0 +1,24 @@
+package dto
+
+// Product listing sorts, ties are broken by product ID so every sort is stable
+const (
+	ProductSortNewest    = "NEWEST" // default
+	ProductSortOldest    = "OLDEST"
+	ProductSortPriceAsc  = "PRICE_ASC" // by currency code, then by amount within a currency
+	ProductSortPriceDesc = "PRICE_DESC"
+	ProductSortNameAsc   = "NAME_ASC"
+)
+
+// PageQuery ask for the page after PageToken, the first page when it is empty
+type PageQuery struct {
+	PageSize  uint64
+	PageToken string // NextPageToken of the previous page, only valid with the same Sort
+	Sort      string
+}
+
+// PageInfo describe a returned page
+type PageInfo struct {
+	NextPageToken   string // empty on the last page
+	TotalCount      uint64
+	TotalIsEstimate bool // TotalCount is the planner estimate when counting would be slow
+}
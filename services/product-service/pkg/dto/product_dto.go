@@ -1,28 +1,41 @@
 package dto
 
-import "gorm.io/datatypes"
+import (
+	"product-service/pkg/money"
+
+	"gorm.io/datatypes"
+)
 
 type Product struct {
-	ID         uint64
-	Name       string
-	Price      float64
-	SellerID   uint64
-	Inventory  int64
-	Attributes datatypes.JSON
+	ID                uint64
+	Name              string
+	Price             money.Money
+	SellerID          uint64
+	Inventory         int64
+	Attributes        datatypes.JSON
+	SKU               string
+	LowStockThreshold int64
+	CategoryID        uint64
+	Status            string
+	Version           uint64
 }
 
 // CreateProduct
 
 type CreateProductInput struct {
-	Name       string
-	Price      float64
-	SellerID   uint64
-	Inventory  int64
-	Attributes datatypes.JSON
+	Name              string
+	Price             money.Money // empty currency is DefaultCurrency
+	SellerID          uint64
+	Inventory         int64
+	Attributes        datatypes.JSON
+	SKU               string
+	LowStockThreshold int64
+	CategoryID        uint64
 }
 type CreateProductOutput struct {
-	Message string
-	Success bool
+	Message     string
+	Success     bool
+	FieldErrors []*FieldError
 }
 
 // UpdateProduct
@@ -32,8 +45,9 @@ type UpdateProductInput struct {
 	Product *Product
 }
 type UpdateProductOutput struct {
-	Message string
-	Success bool
+	Message     string
+	Success     bool
+	FieldErrors []*FieldError
 }
 
 // GetProductByID
@@ -62,11 +76,13 @@ type GetProductsByIDOutput struct {
 
 type GetProductsBySellerIDInput struct {
 	SellerID uint64
+	Page     PageQuery
 }
 type GetProductsBySellerIDOutput struct {
 	Message  string
 	Success  bool
 	Products []*Product
+	Page     PageInfo
 }
 
 // GetInventoryByID
@@ -95,11 +111,60 @@ type GetAndDecreaseInventoryByIDOutput struct {
 // Get List Products
 
 type GetProductsInput struct {
-	Page     uint64
-	PageSize uint64
+	Page PageQuery
 }
 type GetProductsOutput struct {
 	Message  string
 	Success  bool
 	Products []*Product
+	Page     PageInfo
+}
+
+// DeleteProduct
+
+type DeleteProductInput struct {
+	ID     uint64
+	UserID uint64
+}
+type DeleteProductOutput struct {
+	Message string
+	Success bool
+}
+
+// ArchiveProduct
+
+type ArchiveProductInput struct {
+	ID     uint64
+	UserID uint64
+}
+type ArchiveProductOutput struct {
+	Message string
+	Success bool
+}
+
+// RestoreProduct
+
+type RestoreProductInput struct {
+	ID     uint64
+	UserID uint64
+}
+type RestoreProductOutput struct {
+	Message string
+	Success bool
+}
+
+// SearchProducts
+
+type SearchProductsInput struct {
+	Query      string
+	SellerID   uint64
+	CategoryID uint64
+	Page       uint64
+	PageSize   uint64
+}
+type SearchProductsOutput struct {
+	Message  string
+	Success  bool
+	Products []*Product
+	Total    uint64
 }
@@ -0,0 +1,109 @@
+package dto
+
+import "time"
+
+type ProductQuestion struct {
+	ID        uint64
+	ProductID uint64
+	BuyerID   uint64
+	Body      string
+	Answers   []*ProductAnswer
+	CreatedAt time.Time
+}
+
+type ProductAnswer struct {
+	ID          uint64
+	QuestionID  uint64
+	ResponderID uint64
+	Body        string
+	CreatedAt   time.Time
+}
+
+// AskQuestion
+
+type AskQuestionInput struct {
+	ProductID uint64
+	BuyerID   uint64
+	Body      string
+}
+type AskQuestionOutput struct {
+	Message  string
+	Success  bool
+	Question *ProductQuestion
+}
+
+// AnswerQuestion
+
+type AnswerQuestionInput struct {
+	QuestionID uint64
+	UserID     uint64
+	Body       string
+}
+type AnswerQuestionOutput struct {
+	Message string
+	Success bool
+	Answer  *ProductAnswer
+}
+
+// GetProductQuestions
+
+type GetProductQuestionsInput struct {
+	ProductID uint64
+	Page      uint64
+	PageSize  uint64
+}
+type GetProductQuestionsOutput struct {
+	Message   string
+	Success   bool
+	Questions []*ProductQuestion
+	Total     int64
+}
+
+// FlagQA
+
+type FlagQAInput struct {
+	TargetType string
+	TargetID   uint64
+	UserID     uint64
+	Reason     string
+}
+type FlagQAOutput struct {
+	Message string
+	Success bool
+}
+
+// ModerateQA
+
+type ModerateQAInput struct {
+	TargetType string
+	TargetID   uint64
+	Status     string
+}
+type ModerateQAOutput struct {
+	Message string
+	Success bool
+}
+
+// GetFlaggedQA
+
+type FlaggedQA struct {
+	TargetType string
+	TargetID   uint64
+	ProductID  uint64
+	QuestionID uint64
+	AuthorID   uint64
+	Body       string
+	FlagCount  int64
+	CreatedAt  time.Time
+}
+type GetFlaggedQAInput struct {
+	TargetType string
+	Page       uint64
+	PageSize   uint64
+}
+type GetFlaggedQAOutput struct {
+	Message string
+	Success bool
+	Items   []*FlaggedQA
+	Total   int64
+}
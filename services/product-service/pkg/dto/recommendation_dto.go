@@ -0,0 +1,13 @@
+package dto
+
+// GetRelatedProducts
+
+type GetRelatedProductsInput struct {
+	ProductID uint64
+	Limit     uint64
+}
+type GetRelatedProductsOutput struct {
+	Message  string
+	Success  bool
+	Products []*Product
+}
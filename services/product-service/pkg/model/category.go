@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// Attribute types of an AttributeDefinition
+const (
+	AttributeTypeString  = "string"
+	AttributeTypeNumber  = "number"
+	AttributeTypeBoolean = "boolean"
+)
+
+// Category groups products that share an attribute schema.
+// Products with CategoryID 0 are uncategorized and their attributes are not validated.
+type Category struct {
+	ID         uint64                 `gorm:"primaryKey;autoIncrement"`
+	Name       string                 `gorm:"not null;uniqueIndex"`
+	Attributes []*AttributeDefinition `gorm:"foreignKey:CategoryID;constraint:OnDelete:CASCADE"`
+	CreatedAt  time.Time              `gorm:"autoCreateTime"`
+	UpdatedAt  time.Time              `gorm:"autoUpdateTime"`
+}
+
+// AttributeDefinition is one attribute of a category schema, AllowedValues is only used by string attributes
+type AttributeDefinition struct {
+	ID            uint64                      `gorm:"primaryKey;autoIncrement"`
+	CategoryID    uint64                      `gorm:"not null;uniqueIndex:idx_category_attribute_name,priority:1"`
+	Name          string                      `gorm:"not null;uniqueIndex:idx_category_attribute_name,priority:2"`
+	Type          string                      `gorm:"not null"`
+	AllowedValues datatypes.JSONSlice[string] `gorm:"not null;default:'[]'"`
+	Required      bool                        `gorm:"not null;default:false"`
+}
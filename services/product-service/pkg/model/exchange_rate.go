@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// ExchangeRate is how many units of Currency one unit of Base buys, the row of Base itself has Rate 1.
+// All rows come from the same refresh of the rate source and share Base and UpdatedAt.
+type ExchangeRate struct {
+	Currency  string    `gorm:"type:char(3);primaryKey"`
+	Base      string    `gorm:"type:char(3);not null"`
+	Rate      float64   `gorm:"not null"`
+	Source    string    `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+}
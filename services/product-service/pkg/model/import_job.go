@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// ImportJob is a bulk product import from a seller, processed asynchronously by the import worker
+type ImportJob struct {
+	ID          uint64         `gorm:"primaryKey;autoIncrement"`
+	SellerID    uint64         `gorm:"not null;index"`
+	Format      string         `gorm:"not null"`                                                                 // csv, jsonl
+	Status      string         `gorm:"not null;default:'PENDING';index:idx_import_status_created_at,priority:1"` // PENDING, RUNNING, COMPLETED, FAILED
+	Payload     []byte         `gorm:"not null"`
+	TotalRows   uint64         `gorm:"not null;default:0"`
+	SuccessRows uint64         `gorm:"not null;default:0"`
+	FailedRows  uint64         `gorm:"not null;default:0"`
+	Errors      datatypes.JSON `gorm:"not null;default:'[]'"`
+	CreatedAt   time.Time      `gorm:"autoCreateTime;index:idx_import_status_created_at,priority:2"`
+	UpdatedAt   time.Time      `gorm:"autoUpdateTime"`
+}
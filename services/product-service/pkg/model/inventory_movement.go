@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// Inventory movement types
+const (
+	MovementInitialStock      = "INITIAL_STOCK"
+	MovementProductUpdate     = "PRODUCT_UPDATE"
+	MovementOrderReservation  = "ORDER_RESERVATION"
+	MovementOrderCancellation = "ORDER_CANCELLATION"
+	MovementOrderReturn       = "ORDER_RETURN"
+	MovementManualAdjustment  = "MANUAL_ADJUSTMENT"
+	MovementImport            = "IMPORT"
+)
+
+// InventoryMovement is one row of the append-only inventory ledger, rows are never updated or deleted.
+// ReferenceID is the OrderID for order movements and the ImportJobID for imports.
+type InventoryMovement struct {
+	ID             uint64    `gorm:"primaryKey;autoIncrement"`
+	ProductID      uint64    `gorm:"not null;index:idx_movement_product_created_at,priority:1"`
+	SellerID       uint64    `gorm:"not null;index:idx_movement_seller_created_at,priority:1"`
+	Type           string    `gorm:"not null;index:idx_movement_type_reference,priority:1"`
+	ReferenceID    uint64    `gorm:"not null;default:0;index:idx_movement_type_reference,priority:2"`
+	Quantity       int64     `gorm:"not null"` // signed change of inventory
+	InventoryAfter int64     `gorm:"not null"`
+	Reason         string    `gorm:"not null;default:''"`
+	ActorID        uint64    `gorm:"not null;default:0"` // user who made the change, 0 for system
+	CreatedAt      time.Time `gorm:"autoCreateTime;index:idx_movement_product_created_at,priority:2;index:idx_movement_seller_created_at,priority:2"`
+}
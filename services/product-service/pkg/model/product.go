@@ -5,12 +5,29 @@ import (
 	"gorm.io/gorm"
 )
 
+// Product statuses, a deleted product keeps its status and has DeletedAt set
+const (
+	ProductStatusActive   = "ACTIVE"
+	ProductStatusArchived = "ARCHIVED"
+	ProductStatusDeleted  = "DELETED" // only used in DTO
+)
+
+// DefaultCurrency is the ISO 4217 currency of products created without one, the currency of init.sql sample data
+const DefaultCurrency = "VND"
+
+// Product listings page by keyset, the composite indexes end with id to match their order
 type Product struct {
-	ID         uint64         `gorm:"primaryKey;autoIncrement"`
-	Name       string         `gorm:"not null"`
-	Price      float64        `gorm:"not null"`
-	SellerID   uint64         `gorm:"not null"`
-	Inventory  int64          `gorm:"not null"`
-	Attributes datatypes.JSON `gorm:"not null"`
-	DeletedAt  gorm.DeletedAt `gorm:"index"`
+	ID                uint64         `gorm:"primaryKey;autoIncrement;index:idx_products_status_name,priority:3;index:idx_products_status_currency_price,priority:4;index:idx_products_seller_id_id,priority:2"`
+	Name              string         `gorm:"not null;index:idx_products_status_name,priority:2"`
+	PriceAmount       int64          `gorm:"not null;default:0;index:idx_products_status_currency_price,priority:3"`                  // in minor units of Currency
+	Currency          string         `gorm:"type:char(3);not null;default:'VND';index:idx_products_status_currency_price,priority:2"` // ISO 4217 code of the price
+	SellerID          uint64         `gorm:"not null;uniqueIndex:idx_seller_sku,priority:1,where:sku <> '';index:idx_products_seller_id_id,priority:1"`
+	Inventory         int64          `gorm:"not null"`
+	Attributes        datatypes.JSON `gorm:"not null"`
+	SKU               string         `gorm:"not null;default:'';uniqueIndex:idx_seller_sku,priority:2"`
+	LowStockThreshold int64          `gorm:"not null;default:0"`       // product.low_stock is published when inventory drops below it, 0 disables it
+	CategoryID        uint64         `gorm:"not null;default:0;index"` // 0 is uncategorized
+	Status            string         `gorm:"not null;default:'ACTIVE';index;index:idx_products_status_name,priority:1;index:idx_products_status_currency_price,priority:1"`
+	Version           uint64         `gorm:"not null;default:1"` // increased with every product event
+	DeletedAt         gorm.DeletedAt `gorm:"index"`
 }
@@ -0,0 +1,59 @@
+package model
+
+import "time"
+
+// Moderation statuses of questions and answers, hidden ones are not listed.
+// Flagged ones are still listed and wait in the moderation queue until a moderator sets another status.
+const (
+	QAStatusVisible = "VISIBLE"
+	QAStatusFlagged = "FLAGGED"
+	QAStatusHidden  = "HIDDEN"
+)
+
+// QAListedStatuses are the statuses of questions and answers shown to buyers
+var QAListedStatuses = []string{QAStatusVisible, QAStatusFlagged}
+
+// Targets of a moderation flag
+const (
+	FlagTargetQuestion = "QUESTION"
+	FlagTargetAnswer   = "ANSWER"
+)
+
+// QAFlagLimit is the number of flags that puts a visible question or answer in the moderation queue.
+// Flags never hide it, only a moderator does, so a few accounts can not take down what they dislike.
+const QAFlagLimit = 3
+
+// ProductQuestion is a question of a buyer about a product, answered by staff of the store owning the product
+type ProductQuestion struct {
+	ID        uint64           `gorm:"primaryKey;autoIncrement"`
+	ProductID uint64           `gorm:"not null;index:idx_question_product_created_at,priority:1"`
+	BuyerID   uint64           `gorm:"not null"`
+	Body      string           `gorm:"not null"`
+	Status    string           `gorm:"not null;default:'VISIBLE'"`
+	FlagCount int64            `gorm:"not null;default:0"`
+	Answers   []*ProductAnswer `gorm:"foreignKey:QuestionID;constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time        `gorm:"autoCreateTime;index:idx_question_product_created_at,priority:2"`
+	UpdatedAt time.Time        `gorm:"autoUpdateTime"`
+}
+
+// ProductAnswer is an answer of store staff to a question
+type ProductAnswer struct {
+	ID          uint64    `gorm:"primaryKey;autoIncrement"`
+	QuestionID  uint64    `gorm:"not null;index"`
+	ResponderID uint64    `gorm:"not null"`
+	Body        string    `gorm:"not null"`
+	Status      string    `gorm:"not null;default:'VISIBLE'"`
+	FlagCount   int64     `gorm:"not null;default:0"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
+
+// QAFlag is a report of a user about a question or an answer, a user flags the same target once
+type QAFlag struct {
+	ID         uint64    `gorm:"primaryKey;autoIncrement"`
+	TargetType string    `gorm:"not null;uniqueIndex:idx_qa_flag_target_user,priority:1"`
+	TargetID   uint64    `gorm:"not null;uniqueIndex:idx_qa_flag_target_user,priority:2"`
+	UserID     uint64    `gorm:"not null;uniqueIndex:idx_qa_flag_target_user,priority:3"`
+	Reason     string    `gorm:"not null;default:''"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
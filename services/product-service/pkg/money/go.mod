@@ -0,0 +1,3 @@
+module product-service/pkg/money
+
+go 1.24
@@ -0,0 +1,238 @@
+// Package money represents amounts exactly, as integer minor units of an ISO 4217 currency,
+// so that sums and discounts do not pick up floating point rounding errors.
+// It is a module of its own so the gateway and the other services use it through a replace directive.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrCurrencyMismatch is returned when amounts of different currencies are added
+	ErrCurrencyMismatch = errors.New("money: currencies do not match")
+
+	// ErrOverflow is returned when a result does not fit in int64 minor units
+	ErrOverflow = errors.New("money: amount overflows")
+
+	// ErrInvalidAmount is returned when a decimal amount can not be parsed exactly
+	ErrInvalidAmount = errors.New("money: invalid amount")
+)
+
+// exponents is the number of minor unit digits of currencies that do not have 2
+var exponents = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+// Money is an amount in minor units of Currency, e.g. {1999, "USD"} is 19.99 USD and {25000, "VND"} is 25000 VND
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// New create Money of amount minor units of currency
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Exponent return the number of minor unit digits of currency, 2 for currencies that are not listed
+func Exponent(currency string) int {
+	if exponent, ok := exponents[currency]; ok {
+		return exponent
+	}
+	return 2
+}
+
+// CurrenciesWithExponent return the listed currencies with exponent minor unit digits, used to migrate float columns in SQL
+func CurrenciesWithExponent(exponent int) []string {
+	var currencies []string
+	for currency, e := range exponents {
+		if e == exponent {
+			currencies = append(currencies, currency)
+		}
+	}
+	sort.Strings(currencies)
+	return currencies
+}
+
+func pow10(exponent int) int64 {
+	result := int64(1)
+	for i := 0; i < exponent; i++ {
+		result *= 10
+	}
+	return result
+}
+
+// FromFloat convert a major unit amount to Money, rounded half away from zero.
+// It is only meant for values that were floats before, like old database columns.
+func FromFloat(amount float64, currency string) (Money, error) {
+	minor := math.Round(amount * float64(pow10(Exponent(currency))))
+	if math.IsNaN(minor) || minor >= math.MaxInt64 || minor < math.MinInt64 {
+		return Money{}, ErrOverflow
+	}
+	return New(int64(minor), currency), nil
+}
+
+// Parse parse a decimal major unit amount like "19.99" exactly, more digits than the currency has are rejected.
+// The amount is digits with an optional leading minus and decimal point, nothing else is accepted.
+func Parse(amount string, currency string) (Money, error) {
+	amount = strings.TrimSpace(amount)
+	sign := ""
+	if rest, ok := strings.CutPrefix(amount, "-"); ok {
+		sign, amount = "-", rest
+	}
+	whole, fraction, hasPoint := strings.Cut(amount, ".")
+	if !isDigits(whole) || (hasPoint && !isDigits(fraction)) {
+		return Money{}, ErrInvalidAmount
+	}
+	exponent := Exponent(currency)
+	fraction = strings.TrimRight(fraction, "0")
+	if len(fraction) > exponent {
+		return Money{}, fmt.Errorf("%w: %s has at most %d decimals", ErrInvalidAmount, currency, exponent)
+	}
+	fraction += strings.Repeat("0", exponent-len(fraction))
+
+	minor, err := strconv.ParseInt(sign+whole+fraction, 10, 64)
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) {
+			return Money{}, ErrOverflow
+		}
+		return Money{}, ErrInvalidAmount
+	}
+	return New(minor, currency), nil
+}
+
+// isDigits report whether s is one or more ASCII digits
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Float return the amount in major units, only for display and exchange rate math
+func (m Money) Float() float64 {
+	return float64(m.Amount) / float64(pow10(Exponent(m.Currency)))
+}
+
+// Decimal return the amount in major units like "19.99", the inverse of Parse
+func (m Money) Decimal() string {
+	exponent := Exponent(m.Currency)
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+	}
+	digits := strconv.FormatUint(absUint64(amount), 10)
+	if exponent == 0 {
+		return sign + digits
+	}
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-exponent] + "." + digits[len(digits)-exponent:]
+}
+
+func (m Money) String() string {
+	return m.Decimal() + " " + m.Currency
+}
+
+func absUint64(amount int64) uint64 {
+	if amount < 0 {
+		return uint64(-(amount + 1)) + 1
+	}
+	return uint64(amount)
+}
+
+// Add return m + other, both must have the same currency
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, other.Currency)
+	}
+	sum := m.Amount + other.Amount
+	if (other.Amount > 0 && sum < m.Amount) || (other.Amount < 0 && sum > m.Amount) {
+		return Money{}, ErrOverflow
+	}
+	return New(sum, m.Currency), nil
+}
+
+// Mul return m multiplied by quantity
+func (m Money) Mul(quantity int64) (Money, error) {
+	product := new(big.Int).Mul(big.NewInt(m.Amount), big.NewInt(quantity))
+	if !product.IsInt64() {
+		return Money{}, ErrOverflow
+	}
+	return New(product.Int64(), m.Currency), nil
+}
+
+// Sum add amounts of currency, an empty list is zero
+func Sum(currency string, amounts ...Money) (Money, error) {
+	total := New(0, currency)
+	for _, amount := range amounts {
+		var err error
+		if total, err = total.Add(amount); err != nil {
+			return Money{}, err
+		}
+	}
+	return total, nil
+}
+
+// PercentOff return m minus basisPoints hundredths of a percent of it, e.g. 1250 is 12.5% off.
+// The discount is rounded half away from zero to the minor unit.
+func (m Money) PercentOff(basisPoints int64) (Money, error) {
+	if basisPoints < 0 || basisPoints > 10000 {
+		return Money{}, fmt.Errorf("money: discount %d basis points is not between 0 and 10000", basisPoints)
+	}
+	discount := divRound(new(big.Int).Mul(big.NewInt(m.Amount), big.NewInt(basisPoints)), big.NewInt(10000))
+	return New(m.Amount-discount.Int64(), m.Currency), nil
+}
+
+// Convert return m in currency, rate is how many units of currency one unit of m.Currency buys.
+// The result is rounded half away from zero to the minor unit of currency.
+func (m Money) Convert(currency string, rate float64) (Money, error) {
+	if rate <= 0 || math.IsNaN(rate) || math.IsInf(rate, 0) {
+		return Money{}, fmt.Errorf("money: invalid exchange rate %v", rate)
+	}
+	if currency == m.Currency {
+		return m, nil
+	}
+	value := new(big.Float).SetInt64(m.Amount)
+	value.Mul(value, big.NewFloat(rate))
+	value.Mul(value, new(big.Float).SetInt64(pow10(Exponent(currency))))
+	value.Quo(value, new(big.Float).SetInt64(pow10(Exponent(m.Currency))))
+	if value.Sign() >= 0 {
+		value.Add(value, big.NewFloat(0.5))
+	} else {
+		value.Sub(value, big.NewFloat(0.5))
+	}
+	converted, accuracy := value.Int64()
+	if accuracy != big.Exact && (converted == math.MaxInt64 || converted == math.MinInt64) {
+		return Money{}, ErrOverflow
+	}
+	return New(converted, currency), nil
+}
+
+// divRound return a / b rounded half away from zero, b must be positive
+func divRound(a, b *big.Int) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(a, b, new(big.Int))
+	if new(big.Int).Abs(new(big.Int).Mul(remainder, big.NewInt(2))).Cmp(b) >= 0 {
+		if a.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	return quotient
+}
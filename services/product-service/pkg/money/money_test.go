@@ -0,0 +1,214 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		amount   string
+		currency string
+		want     int64
+		wantErr  error
+	}{
+		{"19.99", "USD", 1999, nil},
+		{"19.9", "USD", 1990, nil},
+		{"19", "USD", 1900, nil},
+		{" 19.99 ", "USD", 1999, nil},
+		{"19.990", "USD", 1999, nil},
+		{"0.01", "USD", 1, nil},
+		{"-5.25", "USD", -525, nil},
+		{"-0", "USD", 0, nil},
+		{"007.50", "USD", 750, nil},
+		{"25000", "VND", 25000, nil},
+		{"25000.0", "VND", 25000, nil},
+		{"1.234", "KWD", 1234, nil},
+		{"92233720368547758.07", "USD", math.MaxInt64, nil},
+		{"-92233720368547758.08", "USD", math.MinInt64, nil},
+		{"92233720368547758.08", "USD", 0, ErrOverflow},
+		{"19.999", "USD", 0, ErrInvalidAmount},
+		{"0.5", "JPY", 0, ErrInvalidAmount},
+		{"--5", "USD", 0, ErrInvalidAmount},
+		{"-", "USD", 0, ErrInvalidAmount},
+		{"", "USD", 0, ErrInvalidAmount},
+		{"+5", "USD", 0, ErrInvalidAmount},
+		{"5.", "USD", 0, ErrInvalidAmount},
+		{".5", "USD", 0, ErrInvalidAmount},
+		{"5.-1", "USD", 0, ErrInvalidAmount},
+		{"5.+1", "USD", 0, ErrInvalidAmount},
+		{"- 5", "USD", 0, ErrInvalidAmount},
+		{"1.2.3", "USD", 0, ErrInvalidAmount},
+		{"1e3", "USD", 0, ErrInvalidAmount},
+		{"1_000", "USD", 0, ErrInvalidAmount},
+		{"0x10", "USD", 0, ErrInvalidAmount},
+		{"１２", "USD", 0, ErrInvalidAmount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.amount+" "+tt.currency, func(t *testing.T) {
+			got, err := Parse(tt.amount, tt.currency)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got != New(tt.want, tt.currency) {
+				t.Errorf("Parse() = %v, want %d minor units", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		money Money
+		want  string
+	}{
+		{New(1999, "USD"), "19.99"},
+		{New(5, "USD"), "0.05"},
+		{New(0, "USD"), "0.00"},
+		{New(-5, "USD"), "-0.05"},
+		{New(-525, "USD"), "-5.25"},
+		{New(25000, "VND"), "25000"},
+		{New(-25000, "VND"), "-25000"},
+		{New(1234, "KWD"), "1.234"},
+		{New(math.MaxInt64, "USD"), "92233720368547758.07"},
+		{New(math.MinInt64, "USD"), "-92233720368547758.08"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.money.Decimal(); got != tt.want {
+				t.Errorf("Decimal() = %q, want %q", got, tt.want)
+			}
+			parsed, err := Parse(tt.money.Decimal(), tt.money.Currency)
+			if err != nil || parsed != tt.money {
+				t.Errorf("Parse(Decimal()) = %v, %v, want %v", parsed, err, tt.money)
+			}
+		})
+	}
+	if got := New(1999, "USD").String(); got != "19.99 USD" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	if _, err := New(1, "USD").Add(New(1, "EUR")); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Add() of other currency error = %v", err)
+	}
+	if _, err := New(math.MaxInt64, "USD").Add(New(1, "USD")); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Add() past MaxInt64 error = %v", err)
+	}
+	if _, err := New(math.MinInt64, "USD").Add(New(-1, "USD")); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Add() past MinInt64 error = %v", err)
+	}
+	if got, err := New(1999, "USD").Mul(3); err != nil || got != New(5997, "USD") {
+		t.Errorf("Mul() = %v, %v", got, err)
+	}
+	if _, err := New(math.MaxInt64/2+1, "USD").Mul(2); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Mul() past MaxInt64 error = %v", err)
+	}
+	if got, err := Sum("USD"); err != nil || got != New(0, "USD") {
+		t.Errorf("Sum() of nothing = %v, %v", got, err)
+	}
+	if got, err := Sum("USD", New(1, "USD"), New(2, "USD")); err != nil || got != New(3, "USD") {
+		t.Errorf("Sum() = %v, %v", got, err)
+	}
+}
+
+func TestPercentOff(t *testing.T) {
+	tests := []struct {
+		amount      int64
+		basisPoints int64
+		want        int64
+	}{
+		{1000, 1250, 875},
+		{999, 1000, 899}, // 99.9 off rounds to 100
+		{5, 1000, 4},     // 0.5 off rounds away from zero to 1
+		{-5, 1000, -4},   // refunds round the same way
+		{1999, 0, 1999},  // no discount
+		{1999, 10000, 0}, // free
+		{1, 4999, 1},     // 0.4999 off rounds to 0
+		{1, 5000, 0},     // 0.5 off rounds to 1
+		{333, 3333, 222}, // 110.9889 off rounds to 111
+		{-333, 3333, -222},
+	}
+	for _, tt := range tests {
+		got, err := New(tt.amount, "USD").PercentOff(tt.basisPoints)
+		if err != nil || got.Amount != tt.want {
+			t.Errorf("PercentOff(%d) of %d = %v, %v, want %d", tt.basisPoints, tt.amount, got, err, tt.want)
+		}
+	}
+	for _, basisPoints := range []int64{-1, 10001} {
+		if _, err := New(100, "USD").PercentOff(basisPoints); err == nil {
+			t.Errorf("PercentOff(%d) succeeded", basisPoints)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		from     Money
+		currency string
+		rate     float64
+		want     Money
+	}{
+		{New(100, "USD"), "VND", 25000, New(25000, "VND")},
+		{New(25000, "VND"), "USD", 0.00004, New(100, "USD")},
+		{New(1, "USD"), "EUR", 0.5, New(1, "EUR")},   // 0.5 cent rounds away from zero
+		{New(-1, "USD"), "EUR", 0.5, New(-1, "EUR")}, // and so does a negative half
+		{New(1000, "USD"), "KWD", 0.3, New(3000, "KWD")},
+		{New(1999, "USD"), "USD", 2, New(1999, "USD")}, // same currency is not converted
+	}
+	for _, tt := range tests {
+		got, err := tt.from.Convert(tt.currency, tt.rate)
+		if err != nil || got != tt.want {
+			t.Errorf("Convert(%s, %v) of %v = %v, %v, want %v", tt.currency, tt.rate, tt.from, got, err, tt.want)
+		}
+	}
+	for _, rate := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+		if _, err := New(100, "USD").Convert("EUR", rate); err == nil {
+			t.Errorf("Convert() with rate %v succeeded", rate)
+		}
+	}
+	if _, err := New(math.MaxInt64, "JPY").Convert("USD", 1000); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Convert() past MaxInt64 error = %v", err)
+	}
+}
+
+func TestFromFloat(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		currency string
+		want     int64
+	}{
+		{19.99, "USD", 1999},
+		{0.1 + 0.2, "USD", 30},
+		{-5.255, "USD", -526},
+		{25000, "VND", 25000},
+		{1.2345, "KWD", 1235},
+	}
+	for _, tt := range tests {
+		got, err := FromFloat(tt.amount, tt.currency)
+		if err != nil || got != New(tt.want, tt.currency) {
+			t.Errorf("FromFloat(%v, %s) = %v, %v, want %d", tt.amount, tt.currency, got, err, tt.want)
+		}
+	}
+	if _, err := FromFloat(math.NaN(), "USD"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("FromFloat(NaN) error = %v", err)
+	}
+	if _, err := FromFloat(1e18, "USD"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("FromFloat(1e18) error = %v", err)
+	}
+}
+
+func TestExponent(t *testing.T) {
+	for currency, want := range map[string]int{"USD": 2, "EUR": 2, "VND": 0, "JPY": 0, "KWD": 3, "XYZ": 2} {
+		if got := Exponent(currency); got != want {
+			t.Errorf("Exponent(%s) = %d, want %d", currency, got, want)
+		}
+	}
+	for _, currency := range CurrenciesWithExponent(3) {
+		if Exponent(currency) != 3 {
+			t.Errorf("CurrenciesWithExponent(3) has %s", currency)
+		}
+	}
+}
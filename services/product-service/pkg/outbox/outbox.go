@@ -1,6 +1,12 @@
 package outbox
 
-import "time"
+import (
+	"encoding/json"
+	"product-service/pkg/money"
+	"time"
+
+	"gorm.io/datatypes"
+)
 
 type ValidateOrderEvent struct {
 	OrderID   uint64    `gorm:"primary_key"`
@@ -14,3 +20,96 @@ type ValidateOrderKafkaEvent struct {
 	OrderID uint64 `json:"order_id"`
 	Success bool   `json:"success"`
 }
+
+// Stock level event types
+const (
+	StockLevelLow        = "LOW_STOCK"
+	StockLevelOutOfStock = "OUT_OF_STOCK"
+)
+
+// StockLevelEvent is created when inventory of a product drops below its low-stock threshold or reaches zero
+type StockLevelEvent struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement"`
+	Type      string    `gorm:"notnull"`
+	ProductID uint64    `gorm:"notnull"`
+	SellerID  uint64    `gorm:"notnull"`
+	Inventory int64     `gorm:"notnull"`
+	Threshold int64     `gorm:"notnull"`
+	Status    string    `gorm:"notnull;default:'PENDING';index:idx_stock_level_status_created_at,priority:1"`
+	CreatedAt time.Time `gorm:"notnull;index:idx_stock_level_status_created_at,priority:2"`
+}
+
+type StockLevelKafkaEvent struct {
+	EventID   uint64    `json:"event_id"`
+	Type      string    `json:"type"`
+	ProductID uint64    `json:"product_id"`
+	SellerID  uint64    `json:"seller_id"`
+	Inventory int64     `json:"inventory"`
+	Threshold int64     `json:"threshold"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Product event types
+const (
+	ProductCreated = "CREATED"
+	ProductUpdated = "UPDATED"
+	ProductDeleted = "DELETED"
+)
+
+// ProductEvent is created in the same transaction as every change of a product, with a snapshot of the product after it.
+// Inventory-only changes (orders, adjustments) do not create an event, so Inventory of a snapshot may be stale.
+// Events of a product can be published out of order after a retry, consumers keep the highest Version.
+type ProductEvent struct {
+	ID        uint64         `gorm:"primaryKey;autoIncrement"`
+	Type      string         `gorm:"notnull"`
+	ProductID uint64         `gorm:"notnull;index"`
+	Version   uint64         `gorm:"notnull"`
+	Snapshot  datatypes.JSON `gorm:"notnull"`
+	Status    string         `gorm:"notnull;default:'PENDING';index:idx_product_event_status_created_at,priority:1"`
+	CreatedAt time.Time      `gorm:"notnull;index:idx_product_event_status_created_at,priority:2"`
+}
+
+type ProductSnapshot struct {
+	ID                uint64         `json:"id"`
+	Name              string         `json:"name"`
+	Price             money.Money    `json:"price"`
+	SellerID          uint64         `json:"seller_id"`
+	Inventory         int64          `json:"inventory"`
+	Attributes        datatypes.JSON `json:"attributes"`
+	SKU               string         `json:"sku"`
+	LowStockThreshold int64          `json:"low_stock_threshold"`
+	CategoryID        uint64         `json:"category_id"`
+	Status            string         `json:"status"`
+	Version           uint64         `json:"version"`
+}
+
+type ProductKafkaEvent struct {
+	EventID   uint64          `json:"event_id"`
+	Type      string          `json:"type"`
+	ProductID uint64          `json:"product_id"`
+	Version   uint64          `json:"version"`
+	Product   json.RawMessage `json:"product"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// QuestionAnsweredEvent is created with every answer, so that the buyer who asked can be notified
+type QuestionAnsweredEvent struct {
+	ID          uint64    `gorm:"primaryKey;autoIncrement"`
+	QuestionID  uint64    `gorm:"notnull"`
+	AnswerID    uint64    `gorm:"notnull"`
+	ProductID   uint64    `gorm:"notnull"`
+	BuyerID     uint64    `gorm:"notnull"`
+	ResponderID uint64    `gorm:"notnull"`
+	Status      string    `gorm:"notnull;default:'PENDING';index:idx_question_answered_status_created_at,priority:1"`
+	CreatedAt   time.Time `gorm:"notnull;index:idx_question_answered_status_created_at,priority:2"`
+}
+
+type QuestionAnsweredKafkaEvent struct {
+	EventID     uint64    `json:"event_id"`
+	QuestionID  uint64    `json:"question_id"`
+	AnswerID    uint64    `json:"answer_id"`
+	ProductID   uint64    `json:"product_id"`
+	BuyerID     uint64    `json:"buyer_id"`
+	ResponderID uint64    `json:"responder_id"`
+	CreatedAt   time.Time `json:"created_at"`
+}
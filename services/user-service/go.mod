@@ -13,6 +13,7 @@ require (
 	google.golang.org/protobuf v1.36.8
 	gorm.io/driver/postgres v1.6.0
 	gorm.io/gorm v1.30.2
+	product-service/pkg/money v0.0.0
 )
 
 require (
@@ -40,3 +41,5 @@ require (
 	google.golang.org/genproto/googleapis/api v0.0.0-20250707201910-8d1bb00bc6a7 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250707201910-8d1bb00bc6a7 // indirect
 )
+
+replace product-service/pkg/money => ../product-service/pkg/money
@@ -42,9 +42,10 @@ func (a *AuthClient) GetStoreIDRoleByID(ctx context.Context, input *GetStoreIDRo
 	}
 
 	return &GetStoreIDRoleByIDOutput{
-		Message: "Get StoreID, Role successfully",
-		Success: true,
-		Role:    res.GetRole(),
-		StoreID: res.GetStoreId(),
+		Message:     "Get StoreID, Role successfully",
+		Success:     true,
+		Role:        res.GetRole(),
+		StoreID:     res.GetStoreId(),
+		Permissions: res.GetPermissions(),
 	}, nil
 }
@@ -4,8 +4,9 @@ type GetStoreIDRoleByIDInput struct {
 	ID uint64
 }
 type GetStoreIDRoleByIDOutput struct {
-	Message string
-	StoreID uint64
-	Role    string
-	Success bool
+	Message     string
+	StoreID     uint64
+	Role        string
+	Permissions []string // permissions of Role in the store
+	Success     bool
 }
@@ -1 +1,28 @@
 package productclient
+
+import (
+	"product-service/pkg/money"
+	productpb "user-service/pkg/client/productclient"
+)
+
+func ProductsProtoToDTO(products []*productpb.Product) []*ProductDTOClient {
+	var dtoProducts []*ProductDTOClient
+	for _, product := range products {
+		productDTO := ProductProtoToDTO(product)
+		dtoProducts = append(dtoProducts, productDTO)
+	}
+	return dtoProducts
+}
+
+func ProductProtoToDTO(product *productpb.Product) *ProductDTOClient {
+	if product == nil {
+		return nil
+	}
+	return &ProductDTOClient{
+		ID:       product.GetId(),
+		Name:     product.GetName(),
+		Price:    money.New(product.GetPrice().GetAmount(), product.GetPrice().GetCurrency()),
+		SellerID: product.GetSellerId(),
+		Status:   product.GetStatus(),
+	}
+}
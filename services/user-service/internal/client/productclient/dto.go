@@ -1 +1,24 @@
 package productclient
+
+import "product-service/pkg/money"
+
+// ProductStatusActive is the status of products that can be ordered
+const ProductStatusActive = "ACTIVE"
+
+type ProductDTOClient struct {
+	ID       uint64
+	Name     string
+	Price    money.Money
+	SellerID uint64
+	Status   string
+}
+
+type GetProductsByIDInput struct {
+	IDs []uint64
+}
+
+type GetProductsByIDOutput struct {
+	Products []*ProductDTOClient
+	Message  string
+	Success  bool
+}
@@ -3,25 +3,21 @@ package serviceclientmanager
 import (
 	"user-service/internal/client/authclient"
 	"user-service/internal/client/clientmanager"
+	"user-service/internal/client/productclient"
 
 	"go.uber.org/zap"
 )
 
 type ServiceClientManager struct {
-	//ProductServiceClient *productclient.ProductClient
-	AuthServiceClient *authclient.AuthClient
+	ProductServiceClient *productclient.ProductClient
+	AuthServiceClient    *authclient.AuthClient
 }
 
 func NewServiceClientManager(cm *clientmanager.ClientManager, logger *zap.Logger) *ServiceClientManager {
-
-	//productClient := productclient.NewProductClient(nil, cm, logger)
-	//
-	//return &ServiceClientManager{
-	//	ProductServiceClient: productClient,
-	//}
-
+	productClient := productclient.NewProductClient(nil, cm, logger)
 	authClient := authclient.NewAuthClient(nil, cm, logger)
 	return &ServiceClientManager{
-		AuthServiceClient: authClient,
+		ProductServiceClient: productClient,
+		AuthServiceClient:    authClient,
 	}
 }
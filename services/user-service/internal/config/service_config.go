@@ -89,7 +89,7 @@ func initPostgresDB() (*gorm.DB, error) {
 		return nil, err
 	}
 
-	db.AutoMigrate(&model.Seller{}, &model.Buyer{}, &outbox.CreateSellerEvent{}, &outbox.CreateSellerKafkaEvent{})
+	db.AutoMigrate(&model.Seller{}, &model.Buyer{}, &model.WishlistItem{}, &model.WishlistShare{}, &outbox.CreateSellerEvent{}, &outbox.CreateSellerKafkaEvent{})
 
 	fmt.Println("Init Postgres DB successfully!")
 
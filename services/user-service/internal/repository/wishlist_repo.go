@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"user-service/pkg/model"
+
+	"gorm.io/gorm/clause"
+)
+
+// AddWishlistItem save product to wishlist of user, saving a product twice keeps the first CreatedAt
+func (r *UserRepository) AddWishlistItem(ctx context.Context, item *model.WishlistItem) error {
+	return r.DB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(item).Error
+}
+
+// RemoveWishlistItem remove product from wishlist of user, it returns false when the product was not saved
+func (r *UserRepository) RemoveWishlistItem(ctx context.Context, userID, productID uint64) (bool, error) {
+	result := r.DB.WithContext(ctx).Where("user_id = ? AND product_id = ?", userID, productID).Delete(&model.WishlistItem{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetWishlistItems get a page of wishlist of user, newest first, and the number of saved products
+func (r *UserRepository) GetWishlistItems(ctx context.Context, userID, page, pageSize uint64) ([]*model.WishlistItem, int64, error) {
+	var total int64
+	query := r.DB.WithContext(ctx).Model(&model.WishlistItem{}).Where("user_id = ?", userID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var items []*model.WishlistItem
+	offset := int((page - 1) * pageSize)
+	if err := query.Order("created_at DESC, product_id DESC").Limit(int(pageSize)).Offset(offset).Find(&items).Error; err != nil {
+		return nil, 0, err
+	}
+	return items, total, nil
+}
+
+// GetWishlistShareByToken get share of token, it returns gorm.ErrRecordNotFound for unknown or revoked token
+func (r *UserRepository) GetWishlistShareByToken(ctx context.Context, token string) (*model.WishlistShare, error) {
+	var share model.WishlistShare
+	if err := r.DB.WithContext(ctx).Where("token = ?", token).First(&share).Error; err != nil {
+		return nil, err
+	}
+	return &share, nil
+}
+
+// CreateWishlistShare save share token of user. When user already has a token, the existing share is loaded into share
+func (r *UserRepository) CreateWishlistShare(ctx context.Context, share *model.WishlistShare) error {
+	db := r.DB.WithContext(ctx)
+	if err := db.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "user_id"}}, DoNothing: true}).Create(share).Error; err != nil {
+		return err
+	}
+	return db.Where("user_id = ?", share.UserID).First(share).Error
+}
+
+// DelWishlistShare revoke share token of user
+func (r *UserRepository) DelWishlistShare(ctx context.Context, userID uint64) error {
+	return r.DB.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.WishlistShare{}).Error
+}
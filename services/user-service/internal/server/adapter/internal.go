@@ -179,3 +179,121 @@ func DelSelByIDOutputToResponse(output *dto.DelSellerByIDOutput) (*userpb.DelSel
 		Success: output.Success,
 	}, nil
 }
+
+// Adapter for Wishlist
+
+func WishlistItemsDTOToProto(items []*dto.WishlistItem) ([]*userpb.WishlistItem, error) {
+	itemsProto := make([]*userpb.WishlistItem, 0, len(items))
+	for _, item := range items {
+		itemProto := &userpb.WishlistItem{
+			ProductId: item.ProductID,
+			AddedAt:   timestamppb.New(item.AddedAt),
+			Available: item.Available,
+		}
+		if item.Product != nil {
+			itemProto.Product = &userpb.WishlistProduct{
+				Id:   item.Product.ID,
+				Name: item.Product.Name,
+				Price: &userpb.Money{
+					Amount:   item.Product.Price.Amount,
+					Currency: item.Product.Price.Currency,
+				},
+				SellerId: item.Product.SellerID,
+				Status:   item.Product.Status,
+			}
+		}
+		itemsProto = append(itemsProto, itemProto)
+	}
+	return itemsProto, nil
+}
+
+func AddWisIteRequestToInput(req *userpb.AddWishlistItemRequest) (*dto.AddWishlistItemInput, error) {
+	return &dto.AddWishlistItemInput{
+		UserID:    req.GetUserId(),
+		ProductID: req.GetProductId(),
+	}, nil
+}
+func AddWisIteOutputToResponse(output *dto.AddWishlistItemOutput) (*userpb.AddWishlistItemResponse, error) {
+	return &userpb.AddWishlistItemResponse{
+		Message: output.Message,
+		Success: output.Success,
+	}, nil
+}
+
+func RemWisIteRequestToInput(req *userpb.RemoveWishlistItemRequest) (*dto.RemoveWishlistItemInput, error) {
+	return &dto.RemoveWishlistItemInput{
+		UserID:    req.GetUserId(),
+		ProductID: req.GetProductId(),
+	}, nil
+}
+func RemWisIteOutputToResponse(output *dto.RemoveWishlistItemOutput) (*userpb.RemoveWishlistItemResponse, error) {
+	return &userpb.RemoveWishlistItemResponse{
+		Message: output.Message,
+		Success: output.Success,
+	}, nil
+}
+
+func GetWisRequestToInput(req *userpb.GetWishlistRequest) (*dto.GetWishlistInput, error) {
+	return &dto.GetWishlistInput{
+		UserID:   req.GetUserId(),
+		Page:     req.GetPage(),
+		PageSize: req.GetPageSize(),
+	}, nil
+}
+func GetWisOutputToResponse(output *dto.GetWishlistOutput) (*userpb.GetWishlistResponse, error) {
+	items, err := WishlistItemsDTOToProto(output.Items)
+	if err != nil {
+		return nil, err
+	}
+	return &userpb.GetWishlistResponse{
+		Message: output.Message,
+		Success: output.Success,
+		Items:   items,
+		Total:   output.Total,
+	}, nil
+}
+
+func ShaWisRequestToInput(req *userpb.ShareWishlistRequest) (*dto.ShareWishlistInput, error) {
+	return &dto.ShareWishlistInput{
+		UserID: req.GetUserId(),
+	}, nil
+}
+func ShaWisOutputToResponse(output *dto.ShareWishlistOutput) (*userpb.ShareWishlistResponse, error) {
+	return &userpb.ShareWishlistResponse{
+		Message: output.Message,
+		Success: output.Success,
+		Token:   output.Token,
+	}, nil
+}
+
+func UnsWisRequestToInput(req *userpb.UnshareWishlistRequest) (*dto.UnshareWishlistInput, error) {
+	return &dto.UnshareWishlistInput{
+		UserID: req.GetUserId(),
+	}, nil
+}
+func UnsWisOutputToResponse(output *dto.UnshareWishlistOutput) (*userpb.UnshareWishlistResponse, error) {
+	return &userpb.UnshareWishlistResponse{
+		Message: output.Message,
+		Success: output.Success,
+	}, nil
+}
+
+func GetShaWisRequestToInput(req *userpb.GetSharedWishlistRequest) (*dto.GetSharedWishlistInput, error) {
+	return &dto.GetSharedWishlistInput{
+		Token:    req.GetToken(),
+		Page:     req.GetPage(),
+		PageSize: req.GetPageSize(),
+	}, nil
+}
+func GetShaWisOutputToResponse(output *dto.GetSharedWishlistOutput) (*userpb.GetSharedWishlistResponse, error) {
+	items, err := WishlistItemsDTOToProto(output.Items)
+	if err != nil {
+		return nil, err
+	}
+	return &userpb.GetSharedWishlistResponse{
+		Message: output.Message,
+		Success: output.Success,
+		Items:   items,
+		Total:   output.Total,
+	}, nil
+}
@@ -64,3 +64,47 @@ func DelSelByIDFailResponse(message string, err error, code codes.Code) (*userpb
 		Success: false,
 	}, status.Error(code, err.Error())
 }
+
+func AddWisIteFailResponse(message string, err error, code codes.Code) (*userpb.AddWishlistItemResponse, error) {
+	return &userpb.AddWishlistItemResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func RemWisIteFailResponse(message string, err error, code codes.Code) (*userpb.RemoveWishlistItemResponse, error) {
+	return &userpb.RemoveWishlistItemResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func GetWisFailResponse(message string, err error, code codes.Code) (*userpb.GetWishlistResponse, error) {
+	return &userpb.GetWishlistResponse{
+		Message: message,
+		Success: false,
+		Items:   nil,
+	}, status.Error(code, err.Error())
+}
+
+func ShaWisFailResponse(message string, err error, code codes.Code) (*userpb.ShareWishlistResponse, error) {
+	return &userpb.ShareWishlistResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func UnsWisFailResponse(message string, err error, code codes.Code) (*userpb.UnshareWishlistResponse, error) {
+	return &userpb.UnshareWishlistResponse{
+		Message: message,
+		Success: false,
+	}, status.Error(code, err.Error())
+}
+
+func GetShaWisFailResponse(message string, err error, code codes.Code) (*userpb.GetSharedWishlistResponse, error) {
+	return &userpb.GetSharedWishlistResponse{
+		Message: message,
+		Success: false,
+		Items:   nil,
+	}, status.Error(code, err.Error())
+}
@@ -0,0 +1,221 @@
+package server
+
+import (
+	"context"
+	"user-service/internal/server/adapter"
+	userpb "user-service/pkg/pb"
+
+	"buf.build/go/protovalidate"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func (s *UserServer) AddWishlistItem(ctx context.Context, req *userpb.AddWishlistItemRequest) (*userpb.AddWishlistItemResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("UserServer: invalid request for AddWishlistItem", zap.Error(err))
+		return AddWisIteFailResponse("Invalid request for AddWishlistItem", err, codes.InvalidArgument)
+	}
+	input, err := adapter.AddWisIteRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("UserServer: parse AddWishlistItem request to input error", zap.Error(err))
+		return AddWisIteFailResponse("Parse AddWishlistItem request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.UserService.AddWishlistItem(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("UserServer: AddWishlistItem error in UserService", zap.Error(err))
+		return AddWisIteFailResponse("AddWishlistItem error in UserService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.AddWisIteOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Error("UserServer: parse AddWishlistItem output to response error", zap.Error(err))
+		return AddWisIteFailResponse("Parse AddWishlistItem output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("UserServer: invalid response for AddWishlistItem", zap.Error(err))
+		return AddWisIteFailResponse("Invalid response for AddWishlistItem", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+func (s *UserServer) RemoveWishlistItem(ctx context.Context, req *userpb.RemoveWishlistItemRequest) (*userpb.RemoveWishlistItemResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("UserServer: invalid request for RemoveWishlistItem", zap.Error(err))
+		return RemWisIteFailResponse("Invalid request for RemoveWishlistItem", err, codes.InvalidArgument)
+	}
+	input, err := adapter.RemWisIteRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("UserServer: parse RemoveWishlistItem request to input error", zap.Error(err))
+		return RemWisIteFailResponse("Parse RemoveWishlistItem request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.UserService.RemoveWishlistItem(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("UserServer: RemoveWishlistItem error in UserService", zap.Error(err))
+		return RemWisIteFailResponse("RemoveWishlistItem error in UserService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.RemWisIteOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Error("UserServer: parse RemoveWishlistItem output to response error", zap.Error(err))
+		return RemWisIteFailResponse("Parse RemoveWishlistItem output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("UserServer: invalid response for RemoveWishlistItem", zap.Error(err))
+		return RemWisIteFailResponse("Invalid response for RemoveWishlistItem", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+func (s *UserServer) GetWishlist(ctx context.Context, req *userpb.GetWishlistRequest) (*userpb.GetWishlistResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("UserServer: invalid request for GetWishlist", zap.Error(err))
+		return GetWisFailResponse("Invalid request for GetWishlist", err, codes.InvalidArgument)
+	}
+	input, err := adapter.GetWisRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("UserServer: parse GetWishlist request to input error", zap.Error(err))
+		return GetWisFailResponse("Parse GetWishlist request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.UserService.GetWishlist(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("UserServer: GetWishlist error in UserService", zap.Error(err))
+		return GetWisFailResponse("GetWishlist error in UserService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.GetWisOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Error("UserServer: parse GetWishlist output to response error", zap.Error(err))
+		return GetWisFailResponse("Parse GetWishlist output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("UserServer: invalid response for GetWishlist", zap.Error(err))
+		return GetWisFailResponse("Invalid response for GetWishlist", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+func (s *UserServer) ShareWishlist(ctx context.Context, req *userpb.ShareWishlistRequest) (*userpb.ShareWishlistResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("UserServer: invalid request for ShareWishlist", zap.Error(err))
+		return ShaWisFailResponse("Invalid request for ShareWishlist", err, codes.InvalidArgument)
+	}
+	input, err := adapter.ShaWisRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("UserServer: parse ShareWishlist request to input error", zap.Error(err))
+		return ShaWisFailResponse("Parse ShareWishlist request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.UserService.ShareWishlist(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("UserServer: ShareWishlist error in UserService", zap.Error(err))
+		return ShaWisFailResponse("ShareWishlist error in UserService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.ShaWisOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Error("UserServer: parse ShareWishlist output to response error", zap.Error(err))
+		return ShaWisFailResponse("Parse ShareWishlist output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("UserServer: invalid response for ShareWishlist", zap.Error(err))
+		return ShaWisFailResponse("Invalid response for ShareWishlist", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+func (s *UserServer) UnshareWishlist(ctx context.Context, req *userpb.UnshareWishlistRequest) (*userpb.UnshareWishlistResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("UserServer: invalid request for UnshareWishlist", zap.Error(err))
+		return UnsWisFailResponse("Invalid request for UnshareWishlist", err, codes.InvalidArgument)
+	}
+	input, err := adapter.UnsWisRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("UserServer: parse UnshareWishlist request to input error", zap.Error(err))
+		return UnsWisFailResponse("Parse UnshareWishlist request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.UserService.UnshareWishlist(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("UserServer: UnshareWishlist error in UserService", zap.Error(err))
+		return UnsWisFailResponse("UnshareWishlist error in UserService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.UnsWisOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Error("UserServer: parse UnshareWishlist output to response error", zap.Error(err))
+		return UnsWisFailResponse("Parse UnshareWishlist output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("UserServer: invalid response for UnshareWishlist", zap.Error(err))
+		return UnsWisFailResponse("Invalid response for UnshareWishlist", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
+
+func (s *UserServer) GetSharedWishlist(ctx context.Context, req *userpb.GetSharedWishlistRequest) (*userpb.GetSharedWishlistResponse, error) {
+
+	// Validate ServerRequest and parse to ServiceInput
+	if err := protovalidate.Validate(req); err != nil {
+		s.ZapLogger.Warn("UserServer: invalid request for GetSharedWishlist", zap.Error(err))
+		return GetShaWisFailResponse("Invalid request for GetSharedWishlist", err, codes.InvalidArgument)
+	}
+	input, err := adapter.GetShaWisRequestToInput(req)
+	if err != nil {
+		s.ZapLogger.Warn("UserServer: parse GetSharedWishlist request to input error", zap.Error(err))
+		return GetShaWisFailResponse("Parse GetSharedWishlist request to input error", err, codes.InvalidArgument)
+	}
+
+	// Get ServiceOutput
+	output, err := s.UserService.GetSharedWishlist(ctx, input)
+	if err != nil {
+		s.ZapLogger.Warn("UserServer: GetSharedWishlist error in UserService", zap.Error(err))
+		return GetShaWisFailResponse("GetSharedWishlist error in UserService", err, codes.Internal)
+	}
+
+	// Parse ServiceOutput to ServerResponse and validate
+	res, err := adapter.GetShaWisOutputToResponse(output)
+	if err != nil {
+		s.ZapLogger.Error("UserServer: parse GetSharedWishlist output to response error", zap.Error(err))
+		return GetShaWisFailResponse("Parse GetSharedWishlist output to response error", err, codes.Unknown)
+	}
+	if err := protovalidate.Validate(res); err != nil {
+		s.ZapLogger.Warn("UserServer: invalid response for GetSharedWishlist", zap.Error(err))
+		return GetShaWisFailResponse("Invalid response for GetSharedWishlist", err, codes.Internal)
+	}
+
+	// Return valid response
+	return res, nil
+}
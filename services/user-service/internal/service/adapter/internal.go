@@ -1,6 +1,7 @@
 package adapter
 
 import (
+	"user-service/internal/client/productclient"
 	"user-service/pkg/dto"
 	"user-service/pkg/model"
 )
@@ -51,3 +52,25 @@ func SellerModelToDTO(seller *model.Seller) (*dto.Seller, error) {
 		Address:     seller.Address,
 	}, nil
 }
+
+func WishlistItemsModelToDTO(items []*model.WishlistItem, products map[uint64]*productclient.ProductDTOClient) []*dto.WishlistItem {
+	itemsDTO := make([]*dto.WishlistItem, 0, len(items))
+	for _, item := range items {
+		itemDTO := &dto.WishlistItem{
+			ProductID: item.ProductID,
+			AddedAt:   item.CreatedAt,
+		}
+		if product, ok := products[item.ProductID]; ok {
+			itemDTO.Product = &dto.WishlistProduct{
+				ID:       product.ID,
+				Name:     product.Name,
+				Price:    product.Price,
+				SellerID: product.SellerID,
+				Status:   product.Status,
+			}
+			itemDTO.Available = product.Status == productclient.ProductStatusActive
+		}
+		itemsDTO = append(itemsDTO, itemDTO)
+	}
+	return itemsDTO
+}
@@ -3,11 +3,15 @@ package service
 import (
 	"context"
 	"errors"
+	"slices"
 	"user-service/internal/client/authclient"
 	"user-service/internal/service/adapter"
 	"user-service/pkg/dto"
 )
 
+// PermissionSellerWrite is the auth-service permission to create, update and delete the seller profile of a store
+const PermissionSellerWrite = "seller:write"
+
 func (s *UserService) CreateSeller(ctx context.Context, input *dto.CreateSellerInput) (*dto.CreateSellerOutput, error) {
 	buyer, err := adapter.SellerDTOToModel(input.Seller)
 	if err != nil {
@@ -21,8 +25,8 @@ func (s *UserService) CreateSeller(ctx context.Context, input *dto.CreateSellerI
 	if err != nil {
 		return nil, err
 	}
-	if accountOutput.Role != "seller_admin" || accountOutput.StoreID != 0 {
-		return nil, errors.New("this user_id is not seller_admin or already have seller")
+	if !slices.Contains(accountOutput.Permissions, PermissionSellerWrite) || accountOutput.StoreID != 0 {
+		return nil, errors.New("this user_id can not create seller or already have seller")
 	}
 
 	// Create seller
@@ -65,8 +69,8 @@ func (s *UserService) UpdateSellerByID(ctx context.Context, input *dto.UpdateSel
 	if err != nil {
 		return nil, err
 	}
-	if accountOutput.Role != "seller_admin" {
-		return nil, errors.New("this user_id is not seller_admin")
+	if !slices.Contains(accountOutput.Permissions, PermissionSellerWrite) {
+		return nil, errors.New("this user_id can not update seller")
 	}
 
 	// Update
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"user-service/internal/client/productclient"
+	"user-service/internal/service/adapter"
+	"user-service/pkg/dto"
+	"user-service/pkg/model"
+
+	"gorm.io/gorm"
+)
+
+func (s *UserService) AddWishlistItem(ctx context.Context, input *dto.AddWishlistItemInput) (*dto.AddWishlistItemOutput, error) {
+
+	// Only active products can be saved
+	productOutput, err := s.SCM.ProductServiceClient.GetProductsByID(ctx, &productclient.GetProductsByIDInput{
+		IDs: []uint64{input.ProductID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(productOutput.Products) == 0 || productOutput.Products[0].Status != productclient.ProductStatusActive {
+		return &dto.AddWishlistItemOutput{
+			Message: "Product not found or not available",
+			Success: false,
+		}, nil
+	}
+
+	if err := s.UserRepo.AddWishlistItem(ctx, &model.WishlistItem{
+		UserID:    input.UserID,
+		ProductID: input.ProductID,
+	}); err != nil {
+		return nil, err
+	}
+	return &dto.AddWishlistItemOutput{
+		Message: "Product added to wishlist successfully",
+		Success: true,
+	}, nil
+}
+
+func (s *UserService) RemoveWishlistItem(ctx context.Context, input *dto.RemoveWishlistItemInput) (*dto.RemoveWishlistItemOutput, error) {
+	removed, err := s.UserRepo.RemoveWishlistItem(ctx, input.UserID, input.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if !removed {
+		return &dto.RemoveWishlistItemOutput{
+			Message: "Product is not in wishlist",
+			Success: false,
+		}, nil
+	}
+	return &dto.RemoveWishlistItemOutput{
+		Message: "Product removed from wishlist successfully",
+		Success: true,
+	}, nil
+}
+
+func (s *UserService) GetWishlist(ctx context.Context, input *dto.GetWishlistInput) (*dto.GetWishlistOutput, error) {
+	items, total, err := s.getWishlistItems(ctx, input.UserID, input.Page, input.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.GetWishlistOutput{
+		Message: "Get wishlist successfully",
+		Success: true,
+		Items:   items,
+		Total:   total,
+	}, nil
+}
+
+func (s *UserService) ShareWishlist(ctx context.Context, input *dto.ShareWishlistInput) (*dto.ShareWishlistOutput, error) {
+
+	// Sharing again returns the token already given out
+	token, err := newShareToken()
+	if err != nil {
+		return nil, err
+	}
+	share := &model.WishlistShare{
+		UserID: input.UserID,
+		Token:  token,
+	}
+	if err := s.UserRepo.CreateWishlistShare(ctx, share); err != nil {
+		return nil, err
+	}
+	return &dto.ShareWishlistOutput{
+		Message: "Share wishlist successfully",
+		Success: true,
+		Token:   share.Token,
+	}, nil
+}
+
+func (s *UserService) UnshareWishlist(ctx context.Context, input *dto.UnshareWishlistInput) (*dto.UnshareWishlistOutput, error) {
+	if err := s.UserRepo.DelWishlistShare(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+	return &dto.UnshareWishlistOutput{
+		Message: "Unshare wishlist successfully",
+		Success: true,
+	}, nil
+}
+
+func (s *UserService) GetSharedWishlist(ctx context.Context, input *dto.GetSharedWishlistInput) (*dto.GetSharedWishlistOutput, error) {
+	share, err := s.UserRepo.GetWishlistShareByToken(ctx, input.Token)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &dto.GetSharedWishlistOutput{
+			Message: "Shared wishlist not found",
+			Success: false,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	items, total, err := s.getWishlistItems(ctx, share.UserID, input.Page, input.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.GetSharedWishlistOutput{
+		Message: "Get shared wishlist successfully",
+		Success: true,
+		Items:   items,
+		Total:   total,
+	}, nil
+}
+
+// getWishlistItems get a page of wishlist of user enriched with product data from product-service.
+// Products that were deleted or archived since they were saved stay in the list but are not available.
+func (s *UserService) getWishlistItems(ctx context.Context, userID, page, pageSize uint64) ([]*dto.WishlistItem, int64, error) {
+	items, total, err := s.UserRepo.GetWishlistItems(ctx, userID, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(items) == 0 {
+		return []*dto.WishlistItem{}, total, nil
+	}
+
+	productIDs := make([]uint64, len(items))
+	for i, item := range items {
+		productIDs[i] = item.ProductID
+	}
+	productOutput, err := s.SCM.ProductServiceClient.GetProductsByID(ctx, &productclient.GetProductsByIDInput{
+		IDs: productIDs,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	products := make(map[uint64]*productclient.ProductDTOClient, len(productOutput.Products))
+	for _, product := range productOutput.Products {
+		products[product.ID] = product
+	}
+
+	return adapter.WishlistItemsModelToDTO(items, products), total, nil
+}
+
+// newShareToken generate a random URL safe token for a shared wishlist
+func newShareToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,93 @@
+package dto
+
+import (
+	"product-service/pkg/money"
+	"time"
+)
+
+type WishlistProduct struct {
+	ID       uint64
+	Name     string
+	Price    money.Money
+	SellerID uint64
+	Status   string
+}
+
+// WishlistItem is a saved product, Product is nil when product-service does not know the product anymore
+type WishlistItem struct {
+	ProductID uint64
+	AddedAt   time.Time
+	Product   *WishlistProduct
+	Available bool
+}
+
+// AddWishlistItem
+
+type AddWishlistItemInput struct {
+	UserID    uint64
+	ProductID uint64
+}
+type AddWishlistItemOutput struct {
+	Message string
+	Success bool
+}
+
+// RemoveWishlistItem
+
+type RemoveWishlistItemInput struct {
+	UserID    uint64
+	ProductID uint64
+}
+type RemoveWishlistItemOutput struct {
+	Message string
+	Success bool
+}
+
+// GetWishlist
+
+type GetWishlistInput struct {
+	UserID   uint64
+	Page     uint64
+	PageSize uint64
+}
+type GetWishlistOutput struct {
+	Items   []*WishlistItem
+	Total   int64
+	Message string
+	Success bool
+}
+
+// ShareWishlist
+
+type ShareWishlistInput struct {
+	UserID uint64
+}
+type ShareWishlistOutput struct {
+	Token   string
+	Message string
+	Success bool
+}
+
+// UnshareWishlist
+
+type UnshareWishlistInput struct {
+	UserID uint64
+}
+type UnshareWishlistOutput struct {
+	Message string
+	Success bool
+}
+
+// GetSharedWishlist
+
+type GetSharedWishlistInput struct {
+	Token    string
+	Page     uint64
+	PageSize uint64
+}
+type GetSharedWishlistOutput struct {
+	Items   []*WishlistItem
+	Total   int64
+	Message string
+	Success bool
+}
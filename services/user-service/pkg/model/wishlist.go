@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// WishlistItem is one product saved to the wishlist of a buyer, a product is saved at most once per buyer
+type WishlistItem struct {
+	UserID    uint64    `gorm:"primaryKey;autoIncrement:false"`
+	ProductID uint64    `gorm:"primaryKey;autoIncrement:false"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// WishlistShare is the public token that lets anyone view the wishlist of a buyer, a buyer has at most one token
+type WishlistShare struct {
+	UserID    uint64    `gorm:"primaryKey;autoIncrement:false"`
+	Token     string    `gorm:"not null;uniqueIndex"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}